@@ -20,6 +20,41 @@ func checkRestrictedArgumentUsage(x tree.Expression) error {
 	return ar.result
 }
 
+// checkComparisonOperand allows an argument directly on the side of a comparison, or a full argument masked
+// with a value that doesn't depend on any arguments. The full argument splitter knows how to deal with both cases.
+func checkComparisonOperand(x tree.Expression) error {
+	if _, ok := x.(tree.Argument); ok {
+		return nil
+	}
+	if a, ok := x.(tree.Arithmetic); ok && a.Op == tree.BINAND {
+		if arg, ok := a.Left.(tree.Argument); ok && arg.Type == tree.Full && !containsArgument(a.Right) {
+			return nil
+		}
+		if arg, ok := a.Right.(tree.Argument); ok && arg.Type == tree.Full && !containsArgument(a.Left) {
+			return nil
+		}
+	}
+	return checkRestrictedArgumentUsage(x)
+}
+
+type argumentFinder struct {
+	tree.EmptyTransformer
+	found bool
+}
+
+// AcceptArgument implements Visitor
+func (af *argumentFinder) AcceptArgument(v tree.Argument) {
+	af.found = true
+	af.Result = v
+}
+
+func containsArgument(x tree.Expression) bool {
+	af := &argumentFinder{}
+	af.RealSelf = af
+	af.Transform(x)
+	return af.found
+}
+
 // AcceptAnd implements Visitor
 func (ar *argumentRestrictions) AcceptAnd(v tree.And) {
 	ar.register(either(
@@ -58,22 +93,26 @@ func (ar *argumentRestrictions) AcceptCall(v tree.Call) {
 
 // AcceptComparison implements Visitor
 func (ar *argumentRestrictions) AcceptComparison(v tree.Comparison) {
-	_, hasArgL := v.Left.(tree.Argument)
-	_, hasArgR := v.Right.(tree.Argument)
-
-	if !hasArgL {
-		ar.register(checkRestrictedArgumentUsage(v.Left))
-	}
-	if !hasArgR {
-		ar.register(checkRestrictedArgumentUsage(v.Right))
+	switch v.Op {
+	case tree.ALLBITS, tree.ANYBITS, tree.NOBITS, tree.ONLYBITS:
+		// These will be turned into comparisons of the value masked with the mask, so the same rules apply
+		ar.register(checkComparisonOperand(tree.Arithmetic{Op: tree.BINAND, Left: v.Left, Right: v.Right}))
+		return
 	}
+	ar.register(checkComparisonOperand(v.Left))
+	ar.register(checkComparisonOperand(v.Right))
 }
 
 // AcceptInclusion implements Visitor
 func (ar *argumentRestrictions) AcceptInclusion(v tree.Inclusion) {
-	ar.register(checkRestrictedArgumentUsage(v.Left))
+	// An inclusion will be turned into comparisons against the left side, so the same rules apply to all parts
+	ar.register(checkComparisonOperand(v.Left))
 	for _, r := range v.Rights {
-		ar.register(checkRestrictedArgumentUsage(r))
+		ar.register(checkComparisonOperand(r))
+	}
+	for _, r := range v.Ranges {
+		ar.register(checkComparisonOperand(r.Low))
+		ar.register(checkComparisonOperand(r.High))
 	}
 }
 
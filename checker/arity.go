@@ -0,0 +1,52 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/twtiger/gosecco/constants"
+	"github.com/twtiger/gosecco/tree"
+)
+
+// argumentCollector records every argument used in an expression
+type argumentCollector struct {
+	tree.EmptyTransformer
+	arguments []tree.Argument
+}
+
+// AcceptArgument implements Visitor
+func (ac *argumentCollector) AcceptArgument(v tree.Argument) {
+	ac.arguments = append(ac.arguments, v)
+	ac.EmptyTransformer.AcceptArgument(v)
+}
+
+func argumentsIn(x tree.Expression) []tree.Argument {
+	ac := &argumentCollector{}
+	ac.RealSelf = ac
+	ac.Transform(x)
+	return ac.arguments
+}
+
+func describeArity(n int) string {
+	switch n {
+	case 0:
+		return "no arguments"
+	case 1:
+		return "1 argument"
+	}
+	return fmt.Sprintf("%d arguments", n)
+}
+
+// checkArity makes sure the expression only uses arguments the syscall actually takes. Syscalls without a known
+// signature can use all arguments, and the instruction pointer can always be used.
+func checkArity(arch *constants.Arch, syscall string, x tree.Expression) error {
+	signature, ok := arch.GetSyscallSignature(syscall)
+	if !ok {
+		return nil
+	}
+	for _, a := range argumentsIn(x) {
+		if a.Index != tree.InstructionPointer && a.Index >= len(signature) {
+			return fmt.Errorf("syscall takes %s, but %s is used: %s", describeArity(len(signature)), tree.ExpressionString(a), tree.ExpressionString(x))
+		}
+	}
+	return nil
+}
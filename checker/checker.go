@@ -3,8 +3,10 @@ package checker
 import (
 	"errors"
 	"fmt"
+	"reflect"
 
 	"github.com/twtiger/gosecco/constants"
+	"github.com/twtiger/gosecco/simplifier"
 	"github.com/twtiger/gosecco/tree"
 )
 
@@ -19,18 +21,25 @@ import (
 // all the syscalls with rules are defined.
 // Further, we will also ensure that the usage of Arguments matches the behavior we are interested in
 // Specifically, full Arguments can only appear directly on the side of comparisons, never inside
-// arithmetic expressions.
+// arithmetic expressions. The widths of values, the arguments used and divisions are checked as well,
+// and Warnings reports things that are probably mistakes.
 
 // EnsureValid takes a policy and returns all the errors encounterered for the given rules
 // If everything is valid, the return will be empty
 func EnsureValid(p tree.Policy) []error {
-	v := &validityChecker{rules: p.Rules, seen: make(map[string]*tree.Rule)}
+	arch, ok := constants.GetArch(p.Arch)
+	if !ok {
+		return []error{fmt.Errorf("unknown architecture '%s'", p.Arch)}
+	}
+	v := &validityChecker{arch: arch, rules: p.Rules, seen: make(map[uint32]*tree.Rule), rejectRuntimeDivision: p.RejectRuntimeDivision}
 	return v.check()
 }
 
 type validityChecker struct {
-	rules []*tree.Rule
-	seen  map[string]*tree.Rule
+	arch                  *constants.Arch
+	rules                 []*tree.Rule
+	seen                  map[uint32]*tree.Rule
+	rejectRuntimeDivision bool
 }
 
 type ruleError struct {
@@ -42,28 +51,36 @@ func (e *ruleError) Error() string {
 	return fmt.Sprintf("[%s] %s", e.syscallName, e.err)
 }
 
-func checkValidSyscall(r *tree.Rule) error {
-	if _, ok := constants.GetSyscall(r.Name); !ok {
+// checkValidSyscall makes sure the rule is for a syscall known on the architecture, and that no earlier rule with
+// a different definition is for the same syscall - whether it is referred to by name or by number
+func (v *validityChecker) checkValidSyscall(r *tree.Rule) error {
+	nr, ok := v.arch.GetSyscall(r.Name)
+	if !ok {
 		return errors.New("invalid syscall")
 	}
+	oldR, ok := v.seen[nr]
+	v.seen[nr] = r
+	if ok && (r.PositiveAction != oldR.PositiveAction ||
+		r.NegativeAction != oldR.NegativeAction ||
+		!reflect.DeepEqual(r.Body, oldR.Body) ||
+		!reflect.DeepEqual(r.Outcomes, oldR.Outcomes)) {
+		return duplicateRuleError(r, oldR)
+	}
 	return nil
 }
 
+func duplicateRuleError(r, oldR *tree.Rule) error {
+	if r.Position.IsSet() && oldR.Position.IsSet() {
+		return fmt.Errorf("duplicate definition of syscall rule at %s, previously defined at %s", r.Position, oldR.Position)
+	}
+	return errors.New("duplicate definition of syscall rule")
+}
+
 func (v *validityChecker) check() []error {
 	result := []error{}
 
 	for _, r := range v.rules {
-		var res error
-		oldR, ok := v.seen[r.Name]
-		if ok && (r.PositiveAction != oldR.PositiveAction ||
-			r.NegativeAction != oldR.NegativeAction ||
-			r.Body != oldR.Body) {
-			res = errors.New("duplicate definition of syscall rule")
-		}
-		v.seen[r.Name] = r
-		if res == nil {
-			res = checkValidSyscall(r)
-		}
+		res := v.checkValidSyscall(r)
 		if res == nil {
 			res = v.checkRule(r)
 		}
@@ -83,8 +100,57 @@ func either(e1, e2 error) error {
 }
 
 func (v *validityChecker) checkRule(r *tree.Rule) error {
-	return either(
-		typeCheckExpectingBoolean(r.Body),
-		checkRestrictedArgumentUsage(r.Body))
+	if len(r.Outcomes) > 0 {
+		for _, o := range r.Outcomes {
+			if err := v.checkExpression(r.Name, o.Condition); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return v.checkExpression(r.Name, r.Body)
+}
+
+func (v *validityChecker) checkExpression(syscall string, x tree.Expression) error {
+	res := either(
+		typeCheckExpectingBoolean(x),
+		either(
+			checkRestrictedArgumentUsage(x),
+			checkArity(v.arch, syscall, x)))
+	if res != nil {
+		return res
+	}
+	_, res = checkWidths(x)
+	if res == nil && v.rejectRuntimeDivision {
+		res = checkRuntimeDivisions(x)
+	}
+	return res
+}
+
+// Warnings returns warnings for arithmetic in the rules that might overflow the 32 bit values BPF works with at runtime,
+// and for constants compared with arguments that take constants from another family.
+// It assumes the policy is valid according to EnsureValid
+func Warnings(p tree.Policy) []tree.Warning {
+	arch, _ := constants.GetArch(p.Arch)
+	result := []tree.Warning{}
+	for _, r := range p.Rules {
+		if len(r.Outcomes) > 0 {
+			for ix, o := range r.Outcomes {
+				for _, w := range expressionWarnings(arch, r.Name, o.Condition) {
+					result = append(result, tree.Warning{Position: r.Position, Message: fmt.Sprintf("%s in outcome %d of rule for '%s'", w, ix+1, r.Name)})
+				}
+			}
+			continue
+		}
+		for _, w := range expressionWarnings(arch, r.Name, r.Body) {
+			result = append(result, tree.Warning{Position: r.Position, Message: fmt.Sprintf("%s in rule for '%s'", w, r.Name)})
+		}
+	}
+	return result
+}
 
+func expressionWarnings(arch *constants.Arch, syscall string, x tree.Expression) []string {
+	ws, _ := checkWidths(x)
+	ws = append(ws, simplifier.WidthDifferences(x)...)
+	return append(ws, checkFamilies(arch, syscall, x)...)
 }
@@ -3,6 +3,7 @@ package checker
 import (
 	"testing"
 
+	"github.com/twtiger/gosecco/constants"
 	"github.com/twtiger/gosecco/tree"
 
 	. "gopkg.in/check.v1"
@@ -342,6 +343,38 @@ func (s *CheckerSuite) Test_duplicateRules(c *C) {
 	c.Assert(val[1], ErrorMatches, "\\[write\\] duplicate definition of syscall rule")
 }
 
+func (s *CheckerSuite) Test_duplicateRulesReportPositions(c *C) {
+	body := tree.Inclusion{Positive: true, Left: tree.NumericLiteral{1}, Rights: []tree.Numeric{tree.NumericLiteral{1}, tree.NumericLiteral{2}}}
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: body, Position: tree.Position{File: "policy", Line: 3}},
+		&tree.Rule{Name: "pread64", Body: body, Position: tree.Position{File: "policy", Line: 3}},
+		&tree.Rule{Name: "read", Body: tree.BooleanLiteral{false}, Position: tree.Position{File: "policy", Line: 5}},
+		&tree.Rule{Name: "pread64", Body: body, Position: tree.Position{File: "policy", Line: 7}},
+	}}
+
+	val := EnsureValid(toCheck)
+
+	c.Assert(len(val), Equals, 1)
+	c.Assert(val[0], ErrorMatches, "\\[read\\] duplicate definition of syscall rule at policy:5, previously defined at policy:3")
+}
+
+func (s *CheckerSuite) Test_duplicateRulesForTheSameSyscallNumber(c *C) {
+	isArg0 := func(k uint64) tree.Expression {
+		return tree.Comparison{Op: tree.EQL, Left: tree.Argument{Type: tree.Full, Index: 0}, Right: tree.NumericLiteral{k}}
+	}
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: isArg0(1), Position: tree.Position{File: "policy", Line: 1}},
+		&tree.Rule{Name: "syscall(0)", Body: isArg0(2), Position: tree.Position{File: "policy", Line: 2}},
+		&tree.Rule{Name: "syscall(1)", Body: isArg0(1)},
+		&tree.Rule{Name: "write", Body: isArg0(1)},
+	}}
+
+	val := EnsureValid(toCheck)
+
+	c.Assert(len(val), Equals, 1)
+	c.Assert(val[0], ErrorMatches, "\\[syscall\\(0\\)\\] duplicate definition of syscall rule at policy:2, previously defined at policy:1")
+}
+
 func (s *CheckerSuite) Test_duplicateRulesWithSameValue(c *C) {
 	toCheck := tree.Policy{Rules: []*tree.Rule{
 		&tree.Rule{Name: "read", Body: tree.BooleanLiteral{true}},
@@ -356,6 +389,43 @@ func (s *CheckerSuite) Test_duplicateRulesWithSameValue(c *C) {
 	c.Assert(len(val), Equals, 0)
 }
 
+func (s *CheckerSuite) Test_checksEveryOutcomeCondition(c *C) {
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Outcomes: []tree.Outcome{
+			tree.Outcome{Condition: tree.Comparison{Op: tree.EQL, Left: tree.Argument{Type: tree.Low, Index: 0}, Right: tree.NumericLiteral{1}}, Action: "EPERM"},
+			tree.Outcome{Condition: tree.NumericLiteral{42}, Action: "allow"},
+		}},
+	}}
+
+	val := EnsureValid(toCheck)
+
+	c.Assert(len(val), Equals, 1)
+	c.Assert(val[0], ErrorMatches, "\\[read\\] expected boolean expression but found: 42")
+}
+
+func (s *CheckerSuite) Test_duplicateRulesWithDifferentOutcomes(c *C) {
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Outcomes: []tree.Outcome{tree.Outcome{Condition: tree.BooleanLiteral{true}, Action: "EPERM"}}},
+		&tree.Rule{Name: "read", Outcomes: []tree.Outcome{tree.Outcome{Condition: tree.BooleanLiteral{true}, Action: "EPERM"}}},
+		&tree.Rule{Name: "read", Outcomes: []tree.Outcome{tree.Outcome{Condition: tree.BooleanLiteral{true}, Action: "EACCES"}}},
+	}}
+
+	val := EnsureValid(toCheck)
+
+	c.Assert(len(val), Equals, 1)
+	c.Assert(val[0], ErrorMatches, "\\[read\\] duplicate definition of syscall rule")
+}
+
+func (s *CheckerSuite) Test_syscallNumber(c *C) {
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "syscall(435)", Body: tree.BooleanLiteral{true}},
+	}}
+
+	val := EnsureValid(toCheck)
+
+	c.Assert(len(val), Equals, 0)
+}
+
 func (s *CheckerSuite) Test_invalidSyscall(c *C) {
 	toCheck := tree.Policy{Rules: []*tree.Rule{
 		&tree.Rule{Name: "fluffipuff", Body: tree.BooleanLiteral{true}},
@@ -367,6 +437,50 @@ func (s *CheckerSuite) Test_invalidSyscall(c *C) {
 	c.Assert(val[0], ErrorMatches, "\\[fluffipuff\\] invalid syscall")
 }
 
+func (s *CheckerSuite) Test_argument_inInclusionWithRanges(c *C) {
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: tree.Inclusion{Positive: true,
+			Left:   tree.Arithmetic{Op: tree.BINAND, Left: tree.Argument{Type: tree.Full, Index: 2}, Right: tree.NumericLiteral{3}},
+			Rights: []tree.Numeric{tree.NumericLiteral{1}},
+			Ranges: []tree.Range{tree.Range{Low: tree.NumericLiteral{1}, High: tree.Argument{Type: tree.Full, Index: 1}}},
+		}}}}
+
+	val := EnsureValid(toCheck)
+
+	c.Assert(len(val), Equals, 0)
+}
+
+func (s *CheckerSuite) Test_argument_maskedWithArgument_fails(c *C) {
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: tree.Comparison{Op: tree.EQL,
+			Left:  tree.Arithmetic{Op: tree.BINAND, Left: tree.Argument{Type: tree.Full, Index: 2}, Right: tree.Argument{Type: tree.Low, Index: 1}},
+			Right: tree.NumericLiteral{42}}}}}
+
+	val := EnsureValid(toCheck)
+
+	c.Assert(len(val), Equals, 1)
+	c.Assert(val[0], ErrorMatches, "\\[read\\] full argument cannot be used in arithmetic expressions - use the 32bit accessors instead: arg2")
+}
+
+func (s *CheckerSuite) Test_argument_inBitPredicate(c *C) {
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: tree.Comparison{Op: tree.ONLYBITS, Left: tree.Argument{Type: tree.Full, Index: 2}, Right: tree.NumericLiteral{42}}}}}
+
+	val := EnsureValid(toCheck)
+
+	c.Assert(len(val), Equals, 0)
+}
+
+func (s *CheckerSuite) Test_argument_inBitPredicateMask_fails(c *C) {
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: tree.Comparison{Op: tree.ANYBITS, Left: tree.Argument{Type: tree.Full, Index: 2}, Right: tree.Argument{Type: tree.Full, Index: 1}}}}}
+
+	val := EnsureValid(toCheck)
+
+	c.Assert(len(val), Equals, 1)
+	c.Assert(val[0], ErrorMatches, "\\[read\\] full argument cannot be used in arithmetic expressions - use the 32bit accessors instead: arg2")
+}
+
 func (s *CheckerSuite) Test_argument_leftSide_directComparison(c *C) {
 	toCheck := tree.Policy{Rules: []*tree.Rule{
 		&tree.Rule{Name: "read", Body: tree.Comparison{Op: tree.EQL, Left: tree.Argument{Type: tree.Full, Index: 2}, Right: tree.NumericLiteral{42}}}}}
@@ -403,3 +517,287 @@ func (s *CheckerSuite) Test_hiargument_inExpression_succeeds(c *C) {
 
 	c.Assert(len(val), Equals, 0)
 }
+
+func (s *CheckerSuite) Test_checksComparisonsWithValuesLargerThan32Bits(c *C) {
+	low := tree.Argument{Type: tree.Low, Index: 0}
+	large := tree.NumericLiteral{0x100000000}
+	for _, x := range []struct {
+		body tree.Expression
+		err  string
+	}{
+		{tree.Comparison{Op: tree.EQL, Left: low, Right: large}, "\\[read\\] argL0 is a 32 bit value, but is compared with 0x100000000: \\(eq argL0 4294967296\\)"},
+		{tree.Comparison{Op: tree.GT, Left: large, Right: tree.Argument{Type: tree.Hi, Index: 1}}, "\\[read\\] argH1 is a 32 bit value, but is compared with 0x100000000: \\(gt 4294967296 argH1\\)"},
+		{tree.Comparison{Op: tree.NEQL,
+			Left:  tree.Arithmetic{Op: tree.BINAND, Left: low, Right: tree.NumericLiteral{0xFF}},
+			Right: tree.Arithmetic{Op: tree.LSH, Left: tree.NumericLiteral{1}, Right: tree.NumericLiteral{40}}},
+			"\\[read\\] \\(binand argL0 255\\) is a 32 bit value, but is compared with 0x10000000000: .*"},
+		{tree.Inclusion{Positive: true, Left: low, Rights: []tree.Numeric{tree.NumericLiteral{1}, large}}, "\\[read\\] argL0 is a 32 bit value, but is compared with 0x100000000: \\(in argL0 1 4294967296\\)"},
+	} {
+		val := EnsureValid(tree.Policy{Rules: []*tree.Rule{&tree.Rule{Name: "read", Body: x.body}}})
+		c.Assert(len(val), Equals, 1)
+		c.Assert(val[0], ErrorMatches, x.err)
+	}
+
+	for _, body := range []tree.Expression{
+		tree.Comparison{Op: tree.EQL, Left: tree.Argument{Type: tree.Full, Index: 0}, Right: large},
+		tree.Comparison{Op: tree.SLT, Left: low, Right: tree.NumericLiteral{0xFFFFFFFFFFFFFFFF}},
+		tree.Comparison{Op: tree.EQL, Left: low, Right: tree.Arithmetic{Op: tree.RSH, Left: large, Right: tree.NumericLiteral{1}}},
+	} {
+		c.Assert(EnsureValid(tree.Policy{Rules: []*tree.Rule{&tree.Rule{Name: "read", Body: body}}}), HasLen, 0)
+	}
+}
+
+func (s *CheckerSuite) Test_checksShiftsOf32BitsOrMore(c *C) {
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: tree.Comparison{Op: tree.EQL,
+			Left:  tree.Arithmetic{Op: tree.RSH, Left: tree.Argument{Type: tree.Low, Index: 0}, Right: tree.NumericLiteral{32}},
+			Right: tree.NumericLiteral{0}}},
+	}}
+
+	val := EnsureValid(toCheck)
+
+	c.Assert(len(val), Equals, 1)
+	c.Assert(val[0], ErrorMatches, "\\[read\\] shifts of 32 bits or more are not possible in BPF, since it only has 32 bit values: \\(rsh argL0 32\\)")
+
+	toCheck = tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: tree.Comparison{Op: tree.EQL,
+			Left:  tree.Argument{Type: tree.Full, Index: 0},
+			Right: tree.Arithmetic{Op: tree.LSH, Left: tree.NumericLiteral{1}, Right: tree.NumericLiteral{56}}}},
+	}}
+
+	c.Assert(EnsureValid(toCheck), HasLen, 0)
+}
+
+func (s *CheckerSuite) Test_checksLiteralsLargerThan32BitsInCalculationsDoneAtRuntime(c *C) {
+	masked := func(x tree.Numeric, m tree.Numeric) tree.Expression {
+		return tree.Comparison{Op: tree.EQL, Left: tree.Arithmetic{Op: tree.BINAND, Left: x, Right: m}, Right: tree.NumericLiteral{1}}
+	}
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: masked(tree.Argument{Type: tree.Low, Index: 0}, tree.NumericLiteral{0x1FFFFFFFF})},
+	}}
+
+	val := EnsureValid(toCheck)
+
+	c.Assert(len(val), Equals, 1)
+	c.Assert(val[0], ErrorMatches, "\\[read\\] 0x1FFFFFFFF doesn't fit in the 32 bits of a calculation done at runtime: \\(binand argL0 8589934591\\)")
+
+	toCheck = tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: masked(tree.Argument{Type: tree.Full, Index: 0}, tree.NumericLiteral{0x1FFFFFFFF})},
+		&tree.Rule{Name: "write", Body: masked(tree.Argument{Type: tree.Low, Index: 0}, tree.BinaryNegation{tree.NumericLiteral{0x80000}})},
+	}}
+
+	c.Assert(EnsureValid(toCheck), HasLen, 0)
+}
+
+func (s *CheckerSuite) Test_allowsNegativeValuesComparedWith32BitValues(c *C) {
+	minus := func(x uint64) tree.Numeric {
+		return tree.Arithmetic{Op: tree.MINUS, Left: tree.NumericLiteral{0}, Right: tree.NumericLiteral{x}}
+	}
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "mmap", Body: tree.Comparison{Op: tree.EQL, Left: tree.Argument{Type: tree.Low, Index: 4}, Right: minus(1)}},
+		&tree.Rule{Name: "openat", Body: tree.Inclusion{Positive: true, Left: tree.Argument{Type: tree.Low, Index: 0}, Rights: []tree.Numeric{minus(100)}}},
+		&tree.Rule{Name: "read", Body: tree.Comparison{Op: tree.GT, Left: minus(0x80000000), Right: tree.Argument{Type: tree.Low, Index: 0}}},
+	}}
+
+	c.Assert(EnsureValid(toCheck), HasLen, 0)
+
+	toCheck = tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: tree.Comparison{Op: tree.EQL, Left: tree.Argument{Type: tree.Low, Index: 0}, Right: minus(0x80000001)}},
+	}}
+
+	val := EnsureValid(toCheck)
+
+	c.Assert(len(val), Equals, 1)
+	c.Assert(val[0], ErrorMatches, "\\[read\\] argL0 is a 32 bit value, but is compared with 0xFFFFFFFF7FFFFFFF: .*")
+}
+
+func (s *CheckerSuite) Test_checksDivisionsByZero(c *C) {
+	zero := tree.Arithmetic{Op: tree.MINUS, Left: tree.NumericLiteral{3}, Right: tree.NumericLiteral{3}}
+	low := tree.Argument{Type: tree.Low, Index: 0}
+	comparedWithZero := func(x tree.Numeric) tree.Expression {
+		return tree.Comparison{Op: tree.EQL, Left: x, Right: tree.NumericLiteral{0}}
+	}
+
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: comparedWithZero(tree.Arithmetic{Op: tree.DIV, Left: tree.NumericLiteral{10}, Right: zero})},
+		&tree.Rule{Name: "write", Body: comparedWithZero(tree.Arithmetic{Op: tree.MOD, Left: low, Right: tree.NumericLiteral{0}})},
+		&tree.Rule{Name: "close", Body: comparedWithZero(tree.Arithmetic{Op: tree.DIV, Left: low, Right: tree.NumericLiteral{2}})},
+	}}
+
+	val := EnsureValid(toCheck)
+
+	c.Assert(len(val), Equals, 2)
+	c.Assert(val[0], ErrorMatches, "\\[read\\] division by zero: \\(div 10 \\(minus 3 3\\)\\)")
+	c.Assert(val[1], ErrorMatches, "\\[write\\] modulo by zero: \\(mod argL0 0\\)")
+}
+
+func (s *CheckerSuite) Test_checksDivisionsByRuntimeValuesOnlyWhenAsked(c *C) {
+	low := func(ix int) tree.Argument { return tree.Argument{Type: tree.Low, Index: ix} }
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: tree.Comparison{Op: tree.EQL,
+			Left:  tree.Arithmetic{Op: tree.MOD, Left: low(0), Right: low(1)},
+			Right: tree.NumericLiteral{0}}},
+		&tree.Rule{Name: "write", Body: tree.Comparison{Op: tree.EQL,
+			Left:  tree.Arithmetic{Op: tree.DIV, Left: low(0), Right: tree.NumericLiteral{4}},
+			Right: tree.NumericLiteral{0}}},
+	}}
+
+	c.Assert(EnsureValid(toCheck), HasLen, 0)
+
+	toCheck.RejectRuntimeDivision = true
+	val := EnsureValid(toCheck)
+
+	c.Assert(len(val), Equals, 1)
+	c.Assert(val[0], ErrorMatches, "\\[read\\] division by a value that is only known at runtime is not allowed: \\(mod argL0 argL1\\)")
+}
+
+func (s *CheckerSuite) Test_warnsAboutArithmeticThatMightOverflow(c *C) {
+	low := tree.Argument{Type: tree.Low, Index: 0}
+	masked := func(m uint64) tree.Numeric {
+		return tree.Arithmetic{Op: tree.BINAND, Left: low, Right: tree.NumericLiteral{m}}
+	}
+	comparedWithZero := func(x tree.Numeric) tree.Expression {
+		return tree.Comparison{Op: tree.EQL, Left: x, Right: tree.NumericLiteral{0}}
+	}
+
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Position: tree.Position{File: "policy", Line: 3},
+			Body: comparedWithZero(tree.Arithmetic{Op: tree.PLUS, Left: masked(0xFF), Right: tree.NumericLiteral{0xFFFFFF01}})},
+		&tree.Rule{Name: "write", Body: tree.And{
+			Left:  comparedWithZero(tree.Arithmetic{Op: tree.MINUS, Left: tree.NumericLiteral{1}, Right: masked(0xF)}),
+			Right: comparedWithZero(tree.Arithmetic{Op: tree.LSH, Left: masked(0xFFFF), Right: tree.NumericLiteral{20}})}},
+		&tree.Rule{Name: "open", Outcomes: []tree.Outcome{
+			tree.Outcome{Condition: comparedWithZero(tree.Arithmetic{Op: tree.MULT, Left: masked(0x1FF), Right: tree.NumericLiteral{0x1000000}}), Action: "EPERM"}}},
+		&tree.Rule{Name: "close", Body: tree.And{
+			Left: comparedWithZero(tree.Arithmetic{Op: tree.PLUS,
+				Left:  masked(0xFF),
+				Right: tree.NumericLiteral{0xFFFFFF00}}),
+			Right: comparedWithZero(tree.Arithmetic{Op: tree.LSH,
+				Left:  tree.Arithmetic{Op: tree.RSH, Left: low, Right: tree.NumericLiteral{4}},
+				Right: tree.NumericLiteral{4}})}},
+		&tree.Rule{Name: "fstat", Body: tree.And{
+			Left: tree.And{
+				Left:  comparedWithZero(tree.Arithmetic{Op: tree.PLUS, Left: low, Right: tree.NumericLiteral{1}}),
+				Right: comparedWithZero(tree.Arithmetic{Op: tree.MINUS, Left: tree.NumericLiteral{0}, Right: low})},
+			Right: tree.And{
+				Left:  comparedWithZero(tree.Arithmetic{Op: tree.LSH, Left: low, Right: tree.NumericLiteral{4}}),
+				Right: comparedWithZero(tree.Arithmetic{Op: tree.MULT, Left: low, Right: tree.NumericLiteral{2}})}}},
+	}}
+
+	c.Assert(EnsureValid(toCheck), HasLen, 0)
+
+	result := []string{}
+	for _, w := range Warnings(toCheck) {
+		result = append(result, w.String())
+	}
+	c.Assert(result, DeepEquals, []string{
+		"policy:3: Arithmetic may overflow 32 bits at runtime: (plus (binand argL0 255) 4294967041) in rule for 'read'",
+		"Arithmetic may overflow 32 bits at runtime: (minus 1 (binand argL0 15)) in rule for 'write'",
+		"Arithmetic may overflow 32 bits at runtime: (lsh (binand argL0 65535) 20) in rule for 'write'",
+		"Arithmetic may overflow 32 bits at runtime: (mul (binand argL0 511) 16777216) in outcome 1 of rule for 'open'",
+	})
+}
+
+func (s *CheckerSuite) Test_warnsAboutStaticCalculationsThatWrapAroundAtRuntime(c *C) {
+	low := tree.Argument{Type: tree.Low, Index: 0}
+	comparedWithZero := func(x tree.Numeric) tree.Expression {
+		return tree.Comparison{Op: tree.EQL, Left: x, Right: tree.NumericLiteral{0}}
+	}
+
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: comparedWithZero(tree.Arithmetic{Op: tree.BINAND, Left: low,
+			Right: tree.Arithmetic{Op: tree.LSH, Left: tree.NumericLiteral{1}, Right: tree.NumericLiteral{32}}})},
+		&tree.Rule{Name: "write", Body: comparedWithZero(tree.Arithmetic{Op: tree.BINOR, Left: low,
+			Right: tree.BinaryNegation{tree.NumericLiteral{0x10}}})},
+		&tree.Rule{Name: "close", Body: comparedWithZero(tree.Arithmetic{Op: tree.PLUS,
+			Left:  tree.Arithmetic{Op: tree.BINAND, Left: low, Right: tree.NumericLiteral{0xFF}},
+			Right: tree.Arithmetic{Op: tree.MULT, Left: tree.NumericLiteral{0xFFFFFFFF}, Right: tree.NumericLiteral{2}}})},
+	}}
+
+	c.Assert(EnsureValid(toCheck), HasLen, 0)
+
+	result := []string{}
+	for _, w := range Warnings(toCheck) {
+		result = append(result, w.String())
+	}
+	c.Assert(result, DeepEquals, []string{
+		"Calculation wraps around to 0x0 with the 32 bits BPF uses, instead of 0x100000000: (lsh 1 32) in rule for 'read'",
+		"Calculation wraps around to 0xFFFFFFFE with the 32 bits BPF uses, instead of 0x1FFFFFFFE: (mul 4294967295 2) in rule for 'close'",
+	})
+}
+
+func (s *CheckerSuite) Test_checksArgumentsAgainstTheArityOfTheSyscall(c *C) {
+	arg := func(ix int) tree.Argument { return tree.Argument{Type: tree.Full, Index: ix} }
+	isZero := func(a tree.Argument) tree.Expression {
+		return tree.Comparison{Op: tree.EQL, Left: a, Right: tree.NumericLiteral{0}}
+	}
+
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "dup", Body: isZero(arg(0))},
+		&tree.Rule{Name: "write", Body: tree.Or{Left: isZero(arg(2)), Right: isZero(arg(3))}},
+		&tree.Rule{Name: "getpid", Body: isZero(tree.Argument{Type: tree.Low, Index: 0})},
+		&tree.Rule{Name: "openat", Outcomes: []tree.Outcome{
+			tree.Outcome{Condition: isZero(arg(3)), Action: "EPERM"},
+			tree.Outcome{Condition: isZero(arg(5)), Action: "EACCES"}}},
+		&tree.Rule{Name: "mmap", Body: isZero(arg(5))},
+		&tree.Rule{Name: "exit", Body: isZero(tree.Argument{Type: tree.Full, Index: tree.InstructionPointer})},
+		&tree.Rule{Name: "syscall(3)", Body: isZero(arg(1))},
+		&tree.Rule{Name: "syscall(400)", Body: isZero(arg(5))},
+	}}
+
+	result := EnsureValid(toCheck)
+
+	c.Assert(result, HasLen, 4)
+	c.Assert(result[0], ErrorMatches, "\\[write\\] syscall takes 3 arguments, but arg3 is used: \\(or \\(eq arg2 0\\) \\(eq arg3 0\\)\\)")
+	c.Assert(result[1], ErrorMatches, "\\[getpid\\] syscall takes no arguments, but argL0 is used: \\(eq argL0 0\\)")
+	c.Assert(result[2], ErrorMatches, "\\[openat\\] syscall takes 4 arguments, but arg5 is used: \\(eq arg5 0\\)")
+	c.Assert(result[3], ErrorMatches, "\\[syscall\\(3\\)\\] syscall takes 1 argument, but arg1 is used: \\(eq arg1 0\\)")
+}
+
+func (s *CheckerSuite) Test_warnsAboutConstantsFromTheWrongFamily(c *C) {
+	low := func(ix int) tree.Argument { return tree.Argument{Type: tree.Low, Index: ix} }
+	constant := func(name string) tree.Constant {
+		v, _ := constants.GetConstant(name)
+		return tree.Constant{Name: name, Value: v}
+	}
+
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "socket", Position: tree.Position{File: "policy", Line: 2}, Body: tree.And{
+			Left:  tree.Comparison{Op: tree.EQL, Left: low(0), Right: constant("O_RDONLY")},
+			Right: tree.Inclusion{Positive: true, Left: low(1), Rights: []tree.Numeric{constant("SOCK_STREAM"), constant("SOCK_DGRAM")}}}},
+		&tree.Rule{Name: "mmap", Outcomes: []tree.Outcome{
+			tree.Outcome{Condition: tree.Comparison{Op: tree.BITSET, Left: low(2), Right: tree.Arithmetic{Op: tree.BINOR, Left: constant("PROT_EXEC"), Right: constant("MAP_SHARED")}}, Action: "EPERM"}}},
+		&tree.Rule{Name: "openat", Body: tree.Or{
+			Left:  tree.Comparison{Op: tree.EQL, Left: low(0), Right: constant("AT_FDCWD")},
+			Right: tree.Comparison{Op: tree.EQL, Left: tree.Arithmetic{Op: tree.BINAND, Left: low(2), Right: constant("O_ACCMODE")}, Right: constant("O_RDONLY")}}},
+		&tree.Rule{Name: "write", Body: tree.Comparison{Op: tree.EQL, Left: low(0), Right: constant("AF_INET")}},
+		&tree.Rule{Name: "clone", Body: tree.Comparison{Op: tree.EQL, Left: tree.Argument{Type: tree.Full, Index: 0}, Right: tree.Arithmetic{Op: tree.BINOR, Left: constant("CLONE_VM"), Right: constant("SIGCHLD")}}},
+	}}
+
+	c.Assert(EnsureValid(toCheck), HasLen, 0)
+
+	result := []string{}
+	for _, w := range Warnings(toCheck) {
+		result = append(result, w.String())
+	}
+	c.Assert(result, DeepEquals, []string{
+		"policy:2: O_RDONLY belongs to O_*, but domain takes AF_*: (eq argL0 0) in rule for 'socket'",
+		"MAP_SHARED belongs to MAP_*, but prot takes PROT_*: (bitset argL2 (binor 4 1)) in outcome 1 of rule for 'mmap'",
+	})
+}
+
+func (s *CheckerSuite) Test_checksSyscallsForTheArchitecture(c *C) {
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "socketcall", Body: tree.BooleanLiteral{true}},
+	}}
+
+	c.Assert(len(EnsureValid(toCheck)), Equals, 1)
+
+	toCheck.Arch = "i386"
+	c.Assert(EnsureValid(toCheck), DeepEquals, []error{})
+
+	toCheck.Arch = "vax"
+	val := EnsureValid(toCheck)
+	c.Assert(len(val), Equals, 1)
+	c.Assert(val[0], ErrorMatches, "unknown architecture 'vax'")
+}
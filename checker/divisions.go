@@ -0,0 +1,40 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/twtiger/gosecco/tree"
+)
+
+// divisionCollector records every division and modulo in an expression
+type divisionCollector struct {
+	tree.EmptyTransformer
+	divisions []tree.Arithmetic
+}
+
+// AcceptArithmetic implements Visitor
+func (dc *divisionCollector) AcceptArithmetic(v tree.Arithmetic) {
+	if v.Op == tree.DIV || v.Op == tree.MOD {
+		dc.divisions = append(dc.divisions, v)
+	}
+	dc.EmptyTransformer.AcceptArithmetic(v)
+}
+
+func divisionsIn(x tree.Expression) []tree.Arithmetic {
+	dc := &divisionCollector{}
+	dc.RealSelf = dc
+	dc.Transform(x)
+	return dc.divisions
+}
+
+// checkRuntimeDivisions returns an error for a division or modulo by a value that is only known at runtime.
+// If such a value is zero, BPF returns 0 from the whole filter, which kills the thread. The compiler guards
+// against that by making the rule take its negative action instead, but the divisions can also be rejected.
+func checkRuntimeDivisions(x tree.Expression) error {
+	for _, d := range divisionsIn(x) {
+		if _, ok := staticValue(d.Right); !ok {
+			return fmt.Errorf("division by a value that is only known at runtime is not allowed: %s", tree.ExpressionString(d))
+		}
+	}
+	return nil
+}
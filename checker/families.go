@@ -0,0 +1,97 @@
+package checker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/twtiger/gosecco/constants"
+	"github.com/twtiger/gosecco/tree"
+)
+
+// comparisonsIn returns all the comparisons and inclusions in a boolean expression
+func comparisonsIn(x tree.Expression) []tree.Expression {
+	switch v := x.(type) {
+	case tree.And:
+		return append(comparisonsIn(v.Left), comparisonsIn(v.Right)...)
+	case tree.Or:
+		return append(comparisonsIn(v.Left), comparisonsIn(v.Right)...)
+	case tree.Negation:
+		return comparisonsIn(v.Operand)
+	case tree.Comparison, tree.Inclusion:
+		return []tree.Expression{x}
+	}
+	return nil
+}
+
+// constantsIn returns all the named constants used in an expression. Visitors see constants as numeric literals,
+// so the tree is walked explicitly
+func constantsIn(x tree.Expression) []tree.Constant {
+	switch v := x.(type) {
+	case tree.Constant:
+		return []tree.Constant{v}
+	case tree.Arithmetic:
+		return append(constantsIn(v.Left), constantsIn(v.Right)...)
+	case tree.BinaryNegation:
+		return constantsIn(v.Operand)
+	case tree.Comparison:
+		return append(constantsIn(v.Left), constantsIn(v.Right)...)
+	case tree.Inclusion:
+		result := constantsIn(v.Left)
+		for _, r := range v.Rights {
+			result = append(result, constantsIn(r)...)
+		}
+		for _, r := range v.Ranges {
+			result = append(result, constantsIn(r.Low)...)
+			result = append(result, constantsIn(r.High)...)
+		}
+		return result
+	}
+	return nil
+}
+
+// comparedArgument returns the argument used in a comparison, if there is exactly one
+func comparedArgument(x tree.Expression) (tree.Argument, bool) {
+	args := argumentsIn(x)
+	for _, a := range args {
+		if a.Index != args[0].Index {
+			return tree.Argument{}, false
+		}
+	}
+	if len(args) == 0 {
+		return tree.Argument{}, false
+	}
+	return args[0], true
+}
+
+// checkFamilies returns warnings for constants compared with an argument of the syscall that takes constants from
+// other families, such as socket: domain == O_RDONLY
+func checkFamilies(arch *constants.Arch, syscall string, x tree.Expression) []string {
+	var result []string
+	for _, c := range comparisonsIn(x) {
+		arg, ok := comparedArgument(c)
+		if !ok {
+			continue
+		}
+		families, ok := arch.GetArgumentFamilies(syscall, arg.Index)
+		if !ok {
+			continue
+		}
+		for _, k := range constantsIn(c) {
+			if f, ok := constants.FamilyOf(k.Name); ok && !contains(families, f) {
+				sig, _ := arch.GetSyscallSignature(syscall)
+				result = append(result, fmt.Sprintf("%s belongs to %s, but %s takes %s: %s",
+					strings.ToUpper(k.Name), f, sig[arg.Index].Name, strings.Join(families, " or "), tree.ExpressionString(c)))
+			}
+		}
+	}
+	return result
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
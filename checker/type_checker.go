@@ -115,6 +115,14 @@ func (t *typeChecker) AcceptInclusion(v tree.Inclusion) {
 			res = res2
 		}
 	}
+	for _, r := range v.Ranges {
+		res2 := either(
+			typeCheckExpectingNumeric(r.Low),
+			typeCheckExpectingNumeric(r.High))
+		if res == nil {
+			res = res2
+		}
+	}
 
 	if res != nil {
 		t.result = res
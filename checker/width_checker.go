@@ -0,0 +1,299 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/twtiger/gosecco/simplifier"
+	"github.com/twtiger/gosecco/tree"
+)
+
+const (
+	max32 = uint64(0xFFFFFFFF)
+	max64 = uint64(0xFFFFFFFFFFFFFFFF)
+	// min32Negative is the smallest negative 32 bit value, extended to 64 bits
+	min32Negative = uint64(0xFFFFFFFF80000000)
+)
+
+// valueRange contains the smallest and largest values an expression can have when it is evaluated.
+// The range of a full argument, or anything calculated from one, covers all 64 bit values - everything else
+// is calculated by BPF using 32 bit values. A range is unknown if nothing but the width of the value limits it,
+// like for argL0 - as opposed to argL0 & 0xFF.
+type valueRange struct {
+	low, high uint64
+	static    bool
+	full      bool
+	unknown   bool
+}
+
+var runtime32 = valueRange{low: 0, high: max32, unknown: true}
+
+// lowForOverflow and highForOverflow return the bounds to check for overflow with. An unknown value is assumed to be
+// one that doesn't overflow, since otherwise every calculation with an argument would be reported.
+func (r valueRange) lowForOverflow() uint64 {
+	if r.unknown {
+		return r.high
+	}
+	return r.low
+}
+
+func (r valueRange) highForOverflow() uint64 {
+	if r.unknown {
+		return r.low
+	}
+	return r.high
+}
+
+// widthChecker makes sure the values in an expression fit in the 32 bits BPF works with. It reports an error for
+// things that can't be compiled correctly, and warnings for arithmetic that might give a different result at runtime
+// than what the simplifier would calculate using 64 bits.
+type widthChecker struct {
+	result   error
+	warnings []string
+	r        valueRange
+}
+
+func (w *widthChecker) register(e error) {
+	w.result = either(w.result, e)
+}
+
+func (w *widthChecker) warn(format string, args ...interface{}) {
+	w.warnings = append(w.warnings, fmt.Sprintf(format, args...))
+}
+
+// checkWidths returns an error for values that can't be used with the 32 bits BPF works with - shifts of 32 bits or
+// more, and 32 bit values compared or calculated with larger values - and warnings for arithmetic that might overflow
+func checkWidths(x tree.Expression) ([]string, error) {
+	w := &widthChecker{}
+	x.Accept(w)
+	return w.warnings, w.result
+}
+
+func (w *widthChecker) rangeOf(x tree.Expression) valueRange {
+	w.r = runtime32
+	x.Accept(w)
+	return w.r
+}
+
+// staticValue returns the value of an expression that doesn't depend on anything at runtime
+func staticValue(x tree.Expression) (uint64, bool) {
+	v, ok := simplifier.Simplify(x).(tree.NumericLiteral)
+	return v.Value, ok
+}
+
+// checkComparedWith returns an error if the runtime value x is compared with a static value that doesn't fit in 32 bits,
+// since the result of such a comparison would be decided by the truncation of the value. Negative values such as -1
+// are allowed, since the simplifier cuts them down to the 32 bit value with the same meaning.
+func checkComparedWith(x tree.Expression, r valueRange, v tree.Expression, in tree.Expression) error {
+	if r.static || r.full {
+		return nil
+	}
+	if k, ok := staticValue(v); ok && k > max32 && k < min32Negative {
+		return fmt.Errorf("%s is a 32 bit value, but is compared with 0x%X: %s", tree.ExpressionString(x), k, tree.ExpressionString(in))
+	}
+	return nil
+}
+
+// runtimeRange returns the range of a static value that is part of a calculation done at runtime, where the simplifier
+// wraps the calculation of it around at 32 bits. It also returns whether the value wrapped around, since the simplifier
+// already reports that.
+func runtimeRange(x tree.Expression, r valueRange) (valueRange, bool) {
+	if !r.static {
+		return r, false
+	}
+	if v, ok := simplifier.SimplifyRuntime(x).(tree.NumericLiteral); ok {
+		return valueRange{low: v.Value, high: v.Value, static: true}, v.Value != r.low
+	}
+	return r, false
+}
+
+func addRanges(a, b valueRange) (valueRange, bool) {
+	overflow := a.highForOverflow() > max32-b.highForOverflow()
+	if a.high > max64-b.high {
+		return runtime32, overflow
+	}
+	return valueRange{low: a.low + b.low, high: a.high + b.high, unknown: a.unknown || b.unknown}, overflow
+}
+
+func multiplyRanges(a, b valueRange) (valueRange, bool) {
+	overflow := b.highForOverflow() != 0 && a.highForOverflow() > max32/b.highForOverflow()
+	if b.high != 0 && a.high > max64/b.high {
+		return runtime32, overflow
+	}
+	return valueRange{low: a.low * b.low, high: a.high * b.high, unknown: a.unknown || b.unknown}, overflow
+}
+
+// allBitsBelow returns the smallest number with all of its bits set that is at least as large as x
+func allBitsBelow(x uint64) uint64 {
+	result := uint64(0)
+	for result < x {
+		result = result<<1 | 1
+	}
+	return result
+}
+
+// AcceptAnd implements Visitor
+func (w *widthChecker) AcceptAnd(v tree.And) {
+	v.Left.Accept(w)
+	v.Right.Accept(w)
+}
+
+// AcceptArgument implements Visitor
+func (w *widthChecker) AcceptArgument(v tree.Argument) {
+	if v.Type == tree.Full {
+		w.r = valueRange{low: 0, high: max64, full: true}
+		return
+	}
+	w.r = runtime32
+}
+
+// AcceptArithmetic implements Visitor
+func (w *widthChecker) AcceptArithmetic(v tree.Arithmetic) {
+	if k, ok := staticValue(v); ok {
+		w.r = valueRange{low: k, high: k, static: true}
+		return
+	}
+
+	l := w.rangeOf(v.Left)
+	r := w.rangeOf(v.Right)
+	if l.full || r.full {
+		w.r = valueRange{low: 0, high: max64, full: true}
+		return
+	}
+	l, lwrapped := runtimeRange(v.Left, l)
+	r, rwrapped := runtimeRange(v.Right, r)
+	for _, side := range []valueRange{l, r} {
+		if side.static && side.low > max32 {
+			w.register(fmt.Errorf("0x%X doesn't fit in the 32 bits of a calculation done at runtime: %s", side.low, tree.ExpressionString(v)))
+			return
+		}
+	}
+
+	overflow := false
+	result := runtime32
+	switch v.Op {
+	case tree.PLUS:
+		result, overflow = addRanges(l, r)
+	case tree.MINUS:
+		overflow = l.lowForOverflow() < r.highForOverflow()
+		if l.low >= r.high {
+			result = valueRange{low: l.low - r.high, high: l.high - r.low, unknown: l.unknown || r.unknown}
+		}
+	case tree.MULT:
+		result, overflow = multiplyRanges(l, r)
+	case tree.DIV:
+		if r.static && r.low == 0 {
+			w.register(fmt.Errorf("division by zero: %s", tree.ExpressionString(v)))
+			return
+		}
+		if r.low > 0 {
+			result = valueRange{low: l.low / r.high, high: l.high / r.low, unknown: l.unknown}
+		} else {
+			result = valueRange{low: 0, high: l.high, unknown: l.unknown}
+		}
+	case tree.MOD:
+		if r.static && r.low == 0 {
+			w.register(fmt.Errorf("modulo by zero: %s", tree.ExpressionString(v)))
+			return
+		}
+		result = valueRange{low: 0, high: l.high, unknown: l.unknown}
+		if r.high > 0 && r.high-1 < l.high {
+			result = valueRange{low: 0, high: r.high - 1, unknown: r.unknown}
+		}
+	case tree.BINAND:
+		result = valueRange{low: 0, high: l.high, unknown: l.unknown}
+		if r.high < l.high {
+			result = valueRange{low: 0, high: r.high, unknown: r.unknown}
+		}
+	case tree.BINOR, tree.BINXOR:
+		result = valueRange{low: 0, high: allBitsBelow(l.high | r.high), unknown: l.unknown || r.unknown}
+	case tree.LSH, tree.RSH:
+		if r.static && r.low >= 32 {
+			w.register(fmt.Errorf("shifts of 32 bits or more are not possible in BPF, since it only has 32 bit values: %s", tree.ExpressionString(v)))
+			return
+		}
+		if !r.static {
+			break
+		}
+		if v.Op == tree.RSH {
+			result = valueRange{low: l.low >> r.low, high: l.high >> r.low, unknown: l.unknown}
+		} else {
+			result = valueRange{low: l.low << r.low, high: l.high << r.low, unknown: l.unknown}
+			overflow = l.highForOverflow() > max32>>r.low
+		}
+	}
+
+	if overflow && !lwrapped && !rwrapped {
+		w.warn("Arithmetic may overflow 32 bits at runtime: %s", tree.ExpressionString(v))
+	}
+	if overflow || result.high > max32 {
+		result = runtime32
+	}
+	w.r = result
+}
+
+// AcceptBinaryNegation implements Visitor
+func (w *widthChecker) AcceptBinaryNegation(v tree.BinaryNegation) {
+	if k, ok := staticValue(v); ok {
+		w.r = valueRange{low: k, high: k, static: true}
+		return
+	}
+	w.rangeOf(v.Operand)
+	w.r = runtime32
+}
+
+// AcceptBooleanLiteral implements Visitor
+func (w *widthChecker) AcceptBooleanLiteral(v tree.BooleanLiteral) {}
+
+// AcceptCall implements Visitor
+func (w *widthChecker) AcceptCall(v tree.Call) {
+	// Ignore - type checker will find this
+}
+
+// AcceptComparison implements Visitor
+func (w *widthChecker) AcceptComparison(v tree.Comparison) {
+	l := w.rangeOf(v.Left)
+	r := w.rangeOf(v.Right)
+	switch v.Op {
+	case tree.EQL, tree.NEQL, tree.GT, tree.GTE, tree.LT, tree.LTE, tree.BITSET:
+		w.register(either(
+			checkComparedWith(v.Left, l, v.Right, v),
+			checkComparedWith(v.Right, r, v.Left, v)))
+	}
+}
+
+// AcceptInclusion implements Visitor
+func (w *widthChecker) AcceptInclusion(v tree.Inclusion) {
+	l := w.rangeOf(v.Left)
+	for _, r := range v.Rights {
+		w.rangeOf(r)
+		w.register(checkComparedWith(v.Left, l, r, v))
+	}
+	for _, r := range v.Ranges {
+		w.rangeOf(r.Low)
+		w.rangeOf(r.High)
+		w.register(either(
+			checkComparedWith(v.Left, l, r.Low, v),
+			checkComparedWith(v.Left, l, r.High, v)))
+	}
+}
+
+// AcceptNegation implements Visitor
+func (w *widthChecker) AcceptNegation(v tree.Negation) {
+	v.Operand.Accept(w)
+}
+
+// AcceptNumericLiteral implements Visitor
+func (w *widthChecker) AcceptNumericLiteral(v tree.NumericLiteral) {
+	w.r = valueRange{low: v.Value, high: v.Value, static: true}
+}
+
+// AcceptOr implements Visitor
+func (w *widthChecker) AcceptOr(v tree.Or) {
+	v.Left.Accept(w)
+	v.Right.Accept(w)
+}
+
+// AcceptVariable implements Visitor
+func (w *widthChecker) AcceptVariable(v tree.Variable) {
+	// Ignore - type checker will find this
+}
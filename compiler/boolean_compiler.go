@@ -61,7 +61,11 @@ func (s *booleanCompilerVisitor) AcceptBinaryNegation(v tree.BinaryNegation) {
 // AcceptBooleanLiteral implements Visitor
 func (s *booleanCompilerVisitor) AcceptBooleanLiteral(v tree.BooleanLiteral) {
 	if s.topLevel {
-		s.ctx.unconditionalJumpTo(s.jt)
+		if v.Value {
+			s.ctx.unconditionalJumpTo(s.jt)
+		} else {
+			s.ctx.unconditionalJumpTo(s.jf)
+		}
 	} else {
 		s.err = errors.New("a boolean literal was found in an expression - this is likely a programmer error")
 	}
@@ -111,6 +111,16 @@ func (s *CompilerSuite) Test_topLevelBoolean(c *C) {
 	)
 }
 
+func (s *CompilerSuite) Test_topLevelFalseBooleanJumpsToNegative(c *C) {
+	p := tree.BooleanLiteral{false}
+	ctx := createCompilerContext()
+	compileBoolean(ctx, p, true, "pos", "neg")
+
+	c.Assert(ctx.uconds, DeepEquals, jumpMapFrom(map[label][]int{
+		"neg": []int{0},
+	}))
+}
+
 func (s *BooleanCompilerSuite) Test_compilationOfSimpleAnd(c *C) {
 	p := tree.And{
 		Left:  tree.Comparison{Op: tree.EQL, Left: tree.NumericLiteral{42}, Right: tree.NumericLiteral{1}},
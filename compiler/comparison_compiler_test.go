@@ -28,12 +28,11 @@ func (s *ComparisonCompilerSuite) Test_SingleComparisons(c *C) {
 	res, _ := ctx.compile(p)
 	c.Assert(asm.Dump(res), Equals, ""+
 		"ld_abs\t4\n"+
-		"jeq_k\t00\t06\tC000003E\n"+
+		"jeq_k\t00\t05\tC000003E\n"+
 		"ld_abs\t0\n"+
-		"jeq_k\t00\t02\t1\n"+
+		"jeq_k\t00\t03\t1\n"+
 		"ld_imm\t2A\n"+
-		"jeq_k\t01\t02\t1\n"+
-		"jmp\t1\n"+
+		"jeq_k\t00\t01\t1\n"+
 		"ret_k\t7FFF0000\n"+
 		"ret_k\t0\n")
 }
@@ -56,12 +55,11 @@ func (s *ComparisonCompilerSuite) Test_SingleComparisonsArgumentToNumeric(c *C)
 	res, _ := ctx.compile(p)
 	c.Assert(asm.Dump(res), Equals, ""+
 		"ld_abs\t4\n"+
-		"jeq_k\t00\t06\tC000003E\n"+
+		"jeq_k\t00\t05\tC000003E\n"+
 		"ld_abs\t0\n"+
-		"jeq_k\t00\t02\t1\n"+
+		"jeq_k\t00\t03\t1\n"+
 		"ld_abs\t10\n"+
-		"jeq_k\t01\t02\t1\n"+
-		"jmp\t1\n"+
+		"jeq_k\t00\t01\t1\n"+
 		"ret_k\t7FFF0000\n"+
 		"ret_k\t0\n")
 }
@@ -25,6 +25,7 @@ func Compile(policy tree.Policy) ([]unix.SockFilter, error) {
 type label string
 
 type compilerContext struct {
+	arch                                            *constants.Arch
 	result                                          []unix.SockFilter
 	currentlyLoaded                                 int
 	stackTop                                        uint32
@@ -35,19 +36,25 @@ type compilerContext struct {
 	labelCounter                                    int
 	defaultPositive, defaultNegative, defaultPolicy string
 	actions                                         map[string]label
-	maxJumpSize                                     int // this will always be 0xFF in production, but can be injected for testing.
+	maxJumpSize                                     int         // this will always be 0xFF in production, but can be injected for testing.
+	optimizers                                      []optimizer // this will always be all of them in production, but can be injected for testing.
 	currentlyCompilingSyscall                       string
 	currentlyCompilingExpression                    tree.Expression
+	divisionByZeroAction                            string     // the negative action of the rule being compiled, taken when a divisor is zero at runtime
+	live                                            []liveness // what liveAfter found, until the optimizer rewrites the code
+	loadsInA                                        []loadInA  // what loadsInABefore found, until the optimizer rewrites the code
 }
 
 func createCompilerContext() *compilerContext {
 	return &compilerContext{
+		arch:            constants.Native,
 		jts:             createJumpMap(),
 		jfs:             createJumpMap(),
 		uconds:          createJumpMap(),
 		labels:          createLabelMap(),
 		actions:         make(map[string]label),
 		maxJumpSize:     255,
+		optimizers:      optimizers,
 		currentlyLoaded: -1,
 	}
 }
@@ -88,17 +95,34 @@ func (c *compilerContext) sortedActions() []string {
 }
 
 func (c *compilerContext) compile(policy tree.Policy) ([]unix.SockFilter, error) {
+	arch, ok := constants.GetArch(policy.Arch)
+	if !ok {
+		return nil, fmt.Errorf("unknown architecture '%s'", policy.Arch)
+	}
+	c.arch = arch
 	c.setDefaults(policy.DefaultPositiveAction, policy.DefaultNegativeAction, policy.DefaultPolicyAction)
 	c.compileAuditArchCheck(policy.ActionOnAuditFailure)
 	c.compileX32ABICheck(policy.ActionOnX32)
 
-	for _, r := range policy.Rules {
-		if err := c.compileRule(r); err != nil {
+	defaultPolicy := c.getOrCreateAction(c.defaultPolicy)
+	groups := c.groupRulesWithSameBody(policy.Rules)
+	for i, rules := range groups {
+		// The last rule goes straight to the default policy if it doesn't apply
+		next := defaultPolicy
+		if i < len(groups)-1 {
+			next = c.newLabel()
+		}
+		if err := c.compileRules(rules, next); err != nil {
 			return nil, err
 		}
+		if next != defaultPolicy {
+			c.labelHere(next)
+		}
 	}
 
-	c.unconditionalJumpTo(c.getOrCreateAction(c.defaultPolicy))
+	if len(groups) == 0 {
+		c.unconditionalJumpTo(defaultPolicy)
+	}
 
 	for _, k := range c.sortedActions() {
 		c.labelHere(c.actions[k])
@@ -134,38 +158,92 @@ func (c *compilerContext) loadCurrentSyscall() {
 	c.loadAt(syscallNameIndex)
 }
 
-func (c *compilerContext) checkCorrectSyscall(name string, next label) {
-	sys, ok := constants.GetSyscall(name)
-	if !ok {
-		panic("This shouldn't happen - analyzer should have caught it before compiler tries to compile it")
-	}
-
+// checkCorrectSyscall jumps on to the code following it if the current syscall is one of the given ones, and to next otherwise
+func (c *compilerContext) checkCorrectSyscall(names []string, next label) {
 	c.loadCurrentSyscall()
-	goesNowhere := c.newLabel()
-	c.opWithJumps(OP_JEQ_K, sys, goesNowhere, next)
-	c.labelHere(goesNowhere)
+	matched := c.newLabel()
+	for i, name := range names {
+		sys, ok := c.arch.GetSyscall(name)
+		if !ok {
+			panic("This shouldn't happen - analyzer should have caught it before compiler tries to compile it")
+		}
+
+		notThis := next
+		if i < len(names)-1 {
+			notThis = c.newLabel()
+		}
+		c.opWithJumps(OP_JEQ_K, sys, matched, notThis)
+		if notThis != next {
+			c.labelHere(notThis)
+		}
+	}
+	c.labelHere(matched)
 }
 
-func (c *compilerContext) compileRule(r *tree.Rule) error {
-	next := c.newLabel()
+// compileRules compiles rules that all have the same body and actions - usually just one rule.
+// If the syscall is not one of theirs, it jumps to next
+func (c *compilerContext) compileRules(rules []*tree.Rule, next label) error {
+	r := rules[0]
+	names := []string{}
+	for _, rr := range rules {
+		names = append(names, rr.Name)
+	}
+
+	if len(r.Outcomes) > 0 {
+		c.checkCorrectSyscall(names, next)
+		c.currentlyCompilingSyscall = r.Name
+		c.divisionByZeroAction = c.negativeActionOf(r)
+		if err := c.compileOutcomes(r.Outcomes, r.NegativeAction); err != nil {
+			return err
+		}
+		return nil
+	}
 
 	pos, neg := c.compileActions(r.PositiveAction, r.NegativeAction)
+	c.divisionByZeroAction = c.negativeActionOf(r)
 
-	c.checkCorrectSyscall(r.Name, next)
+	c.checkCorrectSyscall(names, next)
 
 	// These are useful for debugging and helpful error messages
 	c.currentlyCompilingSyscall = r.Name
 	c.currentlyCompilingExpression = r.Body
 
-	if err := c.compileExpression(r.Body, pos, neg); err != nil {
-		return err
-	}
+	return c.compileExpression(r.Body, pos, neg)
+}
 
-	c.labelHere(next)
+// compileOutcomes generates a chain of conditions, where each one will either jump to the action for that
+// outcome, or on to the next condition. If no condition matches, the negative action will be used.
+func (c *compilerContext) compileOutcomes(outcomes []tree.Outcome, negativeAction string) error {
+	for _, o := range outcomes {
+		action := c.getOrCreateAction(o.Action)
+		if v, ok := o.Condition.(tree.BooleanLiteral); ok && v.Value {
+			// Nothing after this outcome can ever be reached
+			c.unconditionalJumpTo(action)
+			return nil
+		}
+
+		next := c.newLabel()
+		c.currentlyCompilingExpression = o.Condition
+		if err := compileBoolean(c, o.Condition, true, action, next); err != nil {
+			return err
+		}
+		c.labelHere(next)
+	}
 
+	if negativeAction == "" {
+		negativeAction = c.defaultNegative
+	}
+	c.unconditionalJumpTo(c.getOrCreateAction(negativeAction))
 	return nil
 }
 
+func (c *compilerContext) negativeActionOf(r *tree.Rule) string {
+	if r.NegativeAction == "" {
+		return c.defaultNegative
+	}
+	return r.NegativeAction
+}
+
 func (c *compilerContext) compileActions(positiveAction string, negativeAction string) (label, label) {
 	if positiveAction == "" {
 		positiveAction = c.defaultPositive
@@ -33,6 +33,27 @@ func (s *CompilerSuite) Test_simplestCompilation(c *C) {
 		"ret_k	0\n")
 }
 
+func (s *CompilerSuite) Test_compilationOfSyscallNumber(c *C) {
+	p := tree.Policy{
+		DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill",
+		Rules: []*tree.Rule{
+			&tree.Rule{
+				Name: "syscall(435)",
+				Body: tree.BooleanLiteral{true},
+			},
+		},
+	}
+
+	res, _ := Compile(p)
+	c.Assert(asm.Dump(res), Equals, ""+
+		"ld_abs\t4\n"+
+		"jeq_k\t00\t03\tC000003E\n"+
+		"ld_abs	0\n"+
+		"jeq_k	00	01	1B3\n"+
+		"ret_k	7FFF0000\n"+
+		"ret_k	0\n")
+}
+
 func (s *CompilerSuite) Test_nextSimplestCompilation(c *C) {
 	p := tree.Policy{
 		DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill",
@@ -92,15 +113,106 @@ func (s *CompilerSuite) Test_compilationOfRuleWithDefinedNegativeAction(c *C) {
 	res, _ := Compile(p)
 	c.Assert(asm.Dump(res), Equals, ""+
 		"ld_abs\t4\n"+
-		"jeq_k\t00\t06\tC000003E\n"+
+		"jeq_k\t00\t05\tC000003E\n"+
 		"ld_abs\t0\n"+
-		"jeq_k	00	02	1\n"+
-		"ld_imm	2A\n"+
-		"jeq_k\t01\t03\t1\n"+
-		"jmp\t1\n"+
-		"ret_k	7FFF0000\n"+
+		"jeq_k\t00\t03\t1\n"+
+		"ld_imm\t2A\n"+
+		"jeq_k\t00\t02\t1\n"+
+		"ret_k\t7FFF0000\n"+
 		"ret_k\t0\n"+
-		"ret_k	7FF00000\n")
+		"ret_k\t7FF00000\n")
+}
+
+func (s *CompilerSuite) Test_compilationOfDivisionByRuntimeValueTakesNegativeActionOnZero(c *C) {
+	p := tree.Policy{
+		DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill",
+		Rules: []*tree.Rule{
+			&tree.Rule{
+				Name:           "write",
+				NegativeAction: "trace",
+				Body: tree.Comparison{Op: tree.EQL,
+					Left:  tree.Arithmetic{Op: tree.DIV, Left: tree.Argument{Type: tree.Low, Index: 0}, Right: tree.Argument{Type: tree.Low, Index: 1}},
+					Right: tree.NumericLiteral{2}},
+			},
+		},
+	}
+
+	res, _ := Compile(p)
+	c.Assert(asm.Dump(res), Equals, ""+
+		"ld_abs\t4\n"+
+		"jeq_k\t00\t0D\tC000003E\n"+
+		"ld_abs\t0\n"+
+		"jeq_k\t00\t0B\t1\n"+
+		"ld_imm\t2\n"+
+		"st\t0\n"+
+		"ld_abs\t18\n"+
+		"jeq_k\t08\t00\t0\n"+
+		"st\t1\n"+
+		"ld_abs\t10\n"+
+		"ldx_mem\t1\n"+
+		"div_x\n"+
+		"ldx_mem\t0\n"+
+		"jeq_x\t00\t02\n"+
+		"ret_k\t7FFF0000\n"+
+		"ret_k\t0\n"+
+		"ret_k\t7FF00000\n")
+}
+
+func (s *CompilerSuite) Test_compilationOfRuleWithOutcomes(c *C) {
+	p := tree.Policy{
+		DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill",
+		Rules: []*tree.Rule{
+			&tree.Rule{
+				Name: "write",
+				Outcomes: []tree.Outcome{
+					tree.Outcome{Condition: tree.Comparison{Op: tree.EQL, Left: tree.Argument{Type: tree.Low, Index: 0}, Right: tree.NumericLiteral{1}}, Action: "EACCES"},
+					tree.Outcome{Condition: tree.Comparison{Op: tree.GT, Left: tree.Argument{Type: tree.Low, Index: 1}, Right: tree.NumericLiteral{5}}, Action: "trace"},
+				},
+			},
+		},
+	}
+
+	res, _ := Compile(p)
+	c.Assert(asm.Dump(res), Equals, ""+
+		"ld_abs\t4\n"+
+		"jeq_k\t00\t07\tC000003E\n"+
+		"ld_abs\t0\n"+
+		"jeq_k\t00\t05\t1\n"+
+		"ld_abs\t10\n"+
+		"jeq_k\t02\t00\t1\n"+
+		"ld_abs\t18\n"+
+		"jgt_k\t02\t01\t5\n"+
+		"ret_k\t5000D\n"+
+		"ret_k\t0\n"+
+		"ret_k\t7FF00000\n")
+}
+
+func (s *CompilerSuite) Test_compilationOfRuleWithElseOutcome(c *C) {
+	p := tree.Policy{
+		DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill",
+		Rules: []*tree.Rule{
+			&tree.Rule{
+				Name:           "write",
+				NegativeAction: "trace",
+				Outcomes: []tree.Outcome{
+					tree.Outcome{Condition: tree.Comparison{Op: tree.EQL, Left: tree.Argument{Type: tree.Low, Index: 0}, Right: tree.NumericLiteral{1}}, Action: "EACCES"},
+					tree.Outcome{Condition: tree.BooleanLiteral{true}, Action: "allow"},
+				},
+			},
+		},
+	}
+
+	res, _ := Compile(p)
+	c.Assert(asm.Dump(res), Equals, ""+
+		"ld_abs\t4\n"+
+		"jeq_k\t00\t06\tC000003E\n"+
+		"ld_abs\t0\n"+
+		"jeq_k\t00\t04\t1\n"+
+		"ld_abs\t10\n"+
+		"jeq_k	00	01	1\n"+
+		"ret_k\t5000D\n"+
+		"ret_k\t7FFF0000\n"+
+		"ret_k\t0\n")
 }
 
 func (s *CompilerSuite) Test_policyWithDefaultAction(c *C) {
@@ -181,12 +293,11 @@ func (s *CompilerSuite) Test_policyWithNegativeDefaultAction(c *C) {
 	res, _ := Compile(p)
 	c.Assert(asm.Dump(res), Equals, ""+
 		"ld_abs\t4\n"+
-		"jeq_k\t00\t06\tC000003E\n"+
+		"jeq_k\t00\t05\tC000003E\n"+
 		"ld_abs\t0\n"+
-		"jeq_k\t00\t02\t1\n"+
+		"jeq_k\t00\t03\t1\n"+
 		"ld_imm\t2A\n"+
-		"jeq_k\t01\t03\t1\n"+
-		"jmp\t1\n"+
+		"jeq_k\t00\t02\t1\n"+
 		"ret_k\t7FFF0000\n"+
 		"ret_k\t0\n"+
 		"ret_k\t7FF00000\n")
@@ -12,6 +12,7 @@ const OP_LOAD_VAL = syscall.BPF_LD | syscall.BPF_IMM
 const OP_LOAD = syscall.BPF_LD | syscall.BPF_W | syscall.BPF_ABS
 const OP_LOAD_MEM = syscall.BPF_LD | syscall.BPF_MEM
 const OP_LOAD_MEM_X = syscall.BPF_LDX | syscall.BPF_MEM
+const OP_LOAD_VAL_X = syscall.BPF_LDX | syscall.BPF_IMM
 
 const OP_STORE = syscall.BPF_ST
 const OP_STORE_X = syscall.BPF_STX
@@ -39,3 +40,5 @@ const OP_JGE_X = syscall.BPF_JMP | syscall.BPF_JGE | syscall.BPF_X
 const OP_JMP_K = syscall.BPF_JMP | syscall.BPF_JA
 
 const OP_RET_K = syscall.BPF_RET | syscall.BPF_K
+
+const OP_TAX = syscall.BPF_MISC | syscall.BPF_TAX
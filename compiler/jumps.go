@@ -46,16 +46,10 @@ func (c *longJumpContext) fixupLongJumps() {
 	for currentIndex > -1 {
 		current := c.result[currentIndex]
 
-		if isConditionalJump(current) && hasLongJump(currentIndex, c.jtLongJumps, c.jfLongJumps) {
-			hadJt := c.handleJTLongJumpAt(currentIndex)
-			c.handleJFLongJumpAt(currentIndex, hadJt)
-		} else {
-			if isUnconditionalJump(current) {
-				c.result[currentIndex].K = uint32(fixupWithShifts(currentIndex, int(c.result[currentIndex].K), c.shifts))
-			} else {
-				hadJt := c.shiftJt(currentIndex)
-				c.shiftJf(hadJt, currentIndex)
-			}
+		if isConditionalJump(current) {
+			c.fixupConditionalJumpAt(currentIndex)
+		} else if isUnconditionalJump(current) {
+			c.result[currentIndex].K = uint32(fixupWithShifts(currentIndex, int(c.result[currentIndex].K), c.shifts))
 		}
 		currentIndex--
 	}
@@ -99,77 +93,45 @@ func (c *compilerContext) fixupJumps() {
 	(&longJumpContext{c, maxIndexWithLongJump, jtLongJumps, jfLongJumps, nil}).fixupLongJumps()
 }
 
-func (c *longJumpContext) handleJTLongJumpAt(currentIndex int) bool {
-	if jmpLen, ok := c.jtLongJumps[currentIndex]; ok {
-		jmpLen = fixupWithShifts(currentIndex, jmpLen, c.shifts)
-
-		newJf := int(c.result[currentIndex].Jf) + 1
-		if c.isLongJump(newJf) {
-			// Simple case, we can just add it to the long jumps for JF:
-			c.jfLongJumps[currentIndex] = newJf
-		} else {
-			c.result[currentIndex].Jf = uint8(newJf)
-		}
-
-		c.insertJumps(currentIndex, jmpLen, 0)
-
-		return true
+// fixupConditionalJumpAt sets the jumps of the conditional jump at the index, taking into account the unconditional
+// jumps inserted after it. If a jump is too long, an unconditional jump to the target is inserted right after it - first
+// the one for jt, then the one for jf - and the conditional jump jumps to that one instead.
+func (c *longJumpContext) fixupConditionalJumpAt(currentIndex int) {
+	jt, ok := c.jtLongJumps[currentIndex]
+	if !ok {
+		jt = int(c.result[currentIndex].Jt)
 	}
-	return false
-}
-
-func (c *longJumpContext) handleJFLongJumpAt(currentIndex int, hadJt bool) {
-	if jmpLen, ok := c.jfLongJumps[currentIndex]; ok {
-		jmpLen = fixupWithShifts(currentIndex, jmpLen, c.shifts)
-		incr, jmpLen := c.incrementJt(hadJt, jmpLen, currentIndex)
-		c.insertJumps(currentIndex, jmpLen, incr)
+	jf, ok := c.jfLongJumps[currentIndex]
+	if !ok {
+		jf = int(c.result[currentIndex].Jf)
 	}
-}
-
-func (c *longJumpContext) incrementJt(hadJt bool, jmpLen, currentIndex int) (int, int) {
-	incr := 0
-	if hadJt {
-		c.result[currentIndex+1].K++
-		incr++
-		jmpLen--
-	} else {
-		newJt := int(c.result[currentIndex].Jt) + 1
-		if c.isLongJump(newJt) {
-			// incr in this case doesn't seem to do much, all tests pass when it is changed to 0
-			c.insertJumps(currentIndex, newJt, incr)
-			incr++
-		} else {
-			c.result[currentIndex].Jt = uint8(newJt)
-		}
+	jt = fixupWithShifts(currentIndex, jt, c.shifts)
+	jf = fixupWithShifts(currentIndex, jf, c.shifts)
+
+	switch {
+	case !c.isLongJump(jt) && !c.isLongJump(jf):
+		c.setConditionalJumps(currentIndex, jt, jf)
+	case c.isLongJump(jt) && !c.isLongJump(jf+1):
+		c.insertJumpAfter(currentIndex, 1, jt)
+		c.setConditionalJumps(currentIndex, 0, jf+1)
+	case c.isLongJump(jf) && !c.isLongJump(jt+1):
+		c.insertJumpAfter(currentIndex, 1, jf)
+		c.setConditionalJumps(currentIndex, jt+1, 0)
+	default:
+		c.insertJumpAfter(currentIndex, 1, jt+1)
+		c.insertJumpAfter(currentIndex, 2, jf)
+		c.setConditionalJumps(currentIndex, 0, 1)
 	}
-	return incr, jmpLen
 }
 
-func (c *longJumpContext) shiftJf(hadJt bool, currentIndex int) {
-	newJf := fixupWithShifts(currentIndex, int(c.result[currentIndex].Jf), c.shifts)
-	if c.isLongJump(newJf) {
-		incr, _ := c.incrementJt(hadJt, 0, currentIndex)
-		c.insertJumps(currentIndex, newJf, incr)
-	} else {
-		c.result[currentIndex].Jf = uint8(newJf)
-	}
-}
-
-func (c *longJumpContext) shiftJt(currentIndex int) bool {
-	newJt := fixupWithShifts(currentIndex, int(c.result[currentIndex].Jt), c.shifts)
-	if c.isLongJump(newJt) {
-		// Jf doesn't need to be modified here, because it will be fixed up with the shifts. Hopefully correctly...
-		c.insertJumps(currentIndex, newJt, 0)
-		return true
-	}
-	c.result[currentIndex].Jt = uint8(newJt)
-	return false
+func (c *longJumpContext) setConditionalJumps(currentIndex, jt, jf int) {
+	c.result[currentIndex].Jt = uint8(jt)
+	c.result[currentIndex].Jf = uint8(jf)
 }
 
-func (c *longJumpContext) insertJumps(currentIndex, pos, incr int) {
-	c.insertUnconditionalJump(currentIndex+1+incr, pos)
-	c.result[currentIndex].Jf = uint8(incr)
-	c.shifts = append(c.shifts, shift(currentIndex+1+incr))
+func (c *longJumpContext) insertJumpAfter(currentIndex, offset, k int) {
+	c.insertUnconditionalJump(currentIndex+offset, k)
+	c.shifts = append(c.shifts, shift(currentIndex+offset))
 }
 
 func insertSockFilter(sfs []unix.SockFilter, ix int, x unix.SockFilter) []unix.SockFilter {
@@ -1,7 +1,11 @@
 package compiler
 
 import (
+	"math/rand"
+
 	"github.com/twtiger/gosecco/asm"
+	"github.com/twtiger/gosecco/data"
+	"github.com/twtiger/gosecco/emulator"
 	"github.com/twtiger/gosecco/tree"
 	. "gopkg.in/check.v1"
 )
@@ -71,10 +75,10 @@ func (s *JumpsSuite) Test_maxSizeJumpSetsMulipleUnconditionalJumpPoint(c *C) {
 		"ld_abs	0\n"+
 		"jeq_k	00	01	1\n"+
 		"jmp	4\n"+
-		"jeq_k	00	02	0\n"+
+		"jeq_k\t01\t00\t0\n"+
+		"jmp\t3\n"+
 		"ld_imm	2A\n"+
-		"jeq_k	01	02	1\n"+
-		"jmp	1\n"+
+		"jeq_k	00	01	1\n"+
 		"ret_k	7FFF0000\n"+
 		"ret_k	0\n")
 }
@@ -92,7 +96,7 @@ func (s *JumpsSuite) Test_maxSizeJumpSetsWithTwoComparisons(c *C) {
 			},
 			&tree.Rule{
 				Name: "read",
-				Body: tree.Comparison{Op: tree.EQL, Left: tree.NumericLiteral{42}, Right: tree.NumericLiteral{1}},
+				Body: tree.Comparison{Op: tree.EQL, Left: tree.NumericLiteral{43}, Right: tree.NumericLiteral{1}},
 			},
 		},
 	}
@@ -109,10 +113,10 @@ func (s *JumpsSuite) Test_maxSizeJumpSetsWithTwoComparisons(c *C) {
 		"jeq_k	00	01	1\n"+
 		"jmp	5\n"+
 		"jmp	5\n"+
-		"jeq_k	00	02	0\n"+
-		"ld_imm	2A\n"+
-		"jeq_k	01	02	1\n"+
-		"jmp	1\n"+
+		"jeq_k\t01\t00\t0\n"+
+		"jmp\t3\n"+
+		"ld_imm\t2B\n"+
+		"jeq_k	00	01	1\n"+
 		"ret_k	7FFF0000\n"+
 		"ret_k	0\n")
 }
@@ -137,10 +141,10 @@ func (s *JumpsSuite) Test_maxSizeJumpSetsWithNotEqual(c *C) {
 		"jeq_k	01	00	C000003E\n"+
 		"jmp	6\n"+
 		"ld_abs	0\n"+
-		"jeq_k	00	02	1\n"+
+		"jeq_k	01	00	1\n"+
+		"jmp\t3\n"+
 		"ld_imm	2A\n"+
-		"jeq_k	02	01	1\n"+
-		"jmp	1\n"+
+		"jeq_k	01	00	1\n"+
 		"ret_k	7FFF0000\n"+
 		"ret_k	0\n")
 }
@@ -158,7 +162,7 @@ func (s *JumpsSuite) Test_maxSizeJumpSetsWithNotEqualWithMoreThanOneRule(c *C) {
 			},
 			&tree.Rule{
 				Name: "read",
-				Body: tree.Comparison{Op: tree.NEQL, Left: tree.NumericLiteral{42}, Right: tree.NumericLiteral{1}},
+				Body: tree.Comparison{Op: tree.NEQL, Left: tree.NumericLiteral{43}, Right: tree.NumericLiteral{1}},
 			},
 		},
 	}
@@ -175,10 +179,76 @@ func (s *JumpsSuite) Test_maxSizeJumpSetsWithNotEqualWithMoreThanOneRule(c *C) {
 		"jeq_k	00	01	1\n"+
 		"jmp	6\n"+
 		"jmp	4\n"+
-		"jeq_k	00	02	0\n"+
-		"ld_imm	2A\n"+
-		"jeq_k	02	01	1\n"+
-		"jmp	1\n"+
+		"jeq_k\t01\t00\t0\n"+
+		"jmp\t3\n"+
+		"ld_imm\t2B\n"+
+		"jeq_k	01	00	1\n"+
 		"ret_k	7FFF0000\n"+
 		"ret_k	0\n")
 }
+
+func randomBooleanExpression(r *rand.Rand, depth int) tree.Boolean {
+	if depth == 0 || r.Intn(3) == 0 {
+		argType := []tree.ArgumentType{tree.Low, tree.Hi}[r.Intn(2)]
+		return tree.Comparison{
+			Op:    []tree.ComparisonType{tree.EQL, tree.NEQL, tree.GT, tree.GTE}[r.Intn(4)],
+			Left:  tree.Argument{Type: argType, Index: r.Intn(2)},
+			Right: tree.NumericLiteral{uint64(r.Intn(4))},
+		}
+	}
+	switch r.Intn(3) {
+	case 0:
+		return tree.And{Left: randomBooleanExpression(r, depth-1), Right: randomBooleanExpression(r, depth-1)}
+	case 1:
+		return tree.Or{Left: randomBooleanExpression(r, depth-1), Right: randomBooleanExpression(r, depth-1)}
+	}
+	return tree.Negation{Operand: randomBooleanExpression(r, depth-1)}
+}
+
+func randomPolicy(r *rand.Rand) tree.Policy {
+	actions := []string{"", "trace", "EPERM"}
+	bodies := []tree.Expression{}
+	for i := 0; i < 3; i++ {
+		bodies = append(bodies, randomBooleanExpression(r, 3))
+	}
+	p := tree.Policy{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill"}
+	for _, name := range []string{"read", "write", "open", "close", "stat", "fstat"} {
+		if r.Intn(4) == 0 {
+			continue
+		}
+		p.Rules = append(p.Rules, &tree.Rule{
+			Name:           name,
+			Body:           bodies[r.Intn(len(bodies))],
+			PositiveAction: actions[r.Intn(len(actions))],
+			NegativeAction: actions[r.Intn(len(actions))],
+		})
+	}
+	return p
+}
+
+func (s *JumpsSuite) Test_longJumpsDoNotChangeTheResult(c *C) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 300; i++ {
+		p := randomPolicy(r)
+		expected, err := Compile(p)
+		c.Assert(err, IsNil)
+
+		for maxJumpSize := 2; maxJumpSize < 8; maxJumpSize++ {
+			ctx := createCompilerContext()
+			ctx.maxJumpSize = maxJumpSize
+			res, err := ctx.compile(p)
+			c.Assert(err, IsNil)
+
+			for nr := int32(0); nr < 7; nr++ {
+				for a := uint64(0); a < 4; a++ {
+					for b := uint64(0); b < 4; b++ {
+						d := data.SeccompWorkingMemory{NR: nr, Arch: 0xC000003E, Args: [6]uint64{a | b<<32, b | a<<32}}
+						if emulator.Emulate(d, res) != emulator.Emulate(d, expected) {
+							c.Fatalf("maximum jump size %d changes the result for %v with:\n%s\ninstead of:\n%s", maxJumpSize, d, asm.Dump(res), asm.Dump(expected))
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package compiler
+
+import (
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// liveness is a set of the registers and scratch memory locations that hold a value that might still be used.
+// The scratch memory locations use the lowest bits, followed by A and X.
+type liveness uint32
+
+const (
+	liveA liveness = 1 << (syscall.BPF_MEMWORDS + iota)
+	liveX
+	liveEverything = ^liveness(0)
+)
+
+func liveMemory(n uint32) liveness {
+	if n >= syscall.BPF_MEMWORDS {
+		return liveEverything
+	}
+	return 1 << n
+}
+
+// usesAndDefinitions returns what the instruction reads, and what it overwrites
+func usesAndDefinitions(s unix.SockFilter) (uses, defs liveness) {
+	switch bpfClass(s.Code) {
+	case syscall.BPF_LD:
+		if bpfMode(s.Code) == syscall.BPF_MEM {
+			return liveMemory(s.K), liveA
+		}
+		return 0, liveA
+	case syscall.BPF_LDX:
+		if bpfMode(s.Code) == syscall.BPF_MEM {
+			return liveMemory(s.K), liveX
+		}
+		return 0, liveX
+	case syscall.BPF_ST:
+		return liveA, liveMemory(s.K)
+	case syscall.BPF_STX:
+		return liveX, liveMemory(s.K)
+	case syscall.BPF_ALU:
+		if hasX(s) && bpfOp(s.Code) != syscall.BPF_NEG {
+			return liveA | liveX, liveA
+		}
+		return liveA, liveA
+	case syscall.BPF_JMP:
+		if isUnconditionalJump(s) {
+			return 0, 0
+		}
+		if hasX(s) {
+			return liveA | liveX, 0
+		}
+		return liveA, 0
+	case syscall.BPF_RET:
+		switch s.Code & 0x18 {
+		case syscall.BPF_K:
+			return 0, 0
+		case syscall.BPF_A:
+			return liveA, 0
+		}
+	case syscall.BPF_MISC:
+		if bpfMiscOp(s.Code) == syscall.BPF_TAX {
+			return liveA, liveX
+		}
+		return liveX, liveA
+	}
+	return liveEverything, 0
+}
+
+// positionOfJumpFrom returns where the jump from the given index in the jump map ends up
+func (c *compilerContext) positionOfJumpFrom(jm *jumpMap, from int) (int, bool) {
+	l, ok := jm.positionToLabel[from]
+	if !ok {
+		return 0, false
+	}
+	to, ok := c.labels.labelToPosition[l]
+	return to, ok && to > from && to <= len(c.result)
+}
+
+// successorsOf returns the instructions that can be executed right after the given one. It returns false if they
+// are not known, which should never happen
+func (c *compilerContext) successorsOf(ix int) ([]int, bool) {
+	s := c.result[ix]
+	switch {
+	case bpfClass(s.Code) == syscall.BPF_RET:
+		return nil, true
+	case isUnconditionalJump(s):
+		to, ok := c.positionOfJumpFrom(c.uconds, ix)
+		return []int{to}, ok
+	case isConditionalJump(s):
+		jt, jtOk := c.positionOfJumpFrom(c.jts, ix)
+		jf, jfOk := c.positionOfJumpFrom(c.jfs, ix)
+		return []int{jt, jf}, jtOk && jfOk
+	}
+	return []int{ix + 1}, true
+}
+
+// liveAfter returns what might be used after each instruction has been executed. Since BPF only jumps forward,
+// one pass from the end of the program is enough to find it. If anything about the program is unexpected,
+// everything is assumed to be used.
+func (c *compilerContext) liveAfter() []liveness {
+	liveIn := make([]liveness, len(c.result)+1)
+	liveOut := make([]liveness, len(c.result))
+	liveIn[len(c.result)] = liveEverything
+
+	for ix := len(c.result) - 1; ix >= 0; ix-- {
+		successors, ok := c.successorsOf(ix)
+		if !ok {
+			liveOut[ix] = liveEverything
+		}
+		for _, s := range successors {
+			liveOut[ix] |= liveIn[s]
+		}
+
+		uses, defs := usesAndDefinitions(c.result[ix])
+		liveIn[ix] = uses | (liveOut[ix] &^ defs)
+	}
+
+	return liveOut
+}
+
+// isConstantLoad returns true if the instruction loads a value into A that is the same every time it is executed
+func isConstantLoad(s unix.SockFilter) bool {
+	if bpfClass(s.Code) != syscall.BPF_LD {
+		return false
+	}
+	switch bpfMode(s.Code) {
+	case syscall.BPF_ABS, syscall.BPF_IMM, syscall.BPF_LEN:
+		return true
+	}
+	return false
+}
+
+// loadInA is a constant load whose value A is known to hold
+type loadInA struct {
+	load  unix.SockFilter
+	known bool
+}
+
+func mergeLoadsInA(left, right loadInA) loadInA {
+	if left.known && right.known && left.load == right.load {
+		return left
+	}
+	return loadInA{}
+}
+
+// loadsInABefore returns the constant load, if any, whose value A holds right before each instruction is executed,
+// no matter how it is reached. Just like liveAfter, this only needs one pass, starting from the beginning.
+func (c *compilerContext) loadsInABefore() []loadInA {
+	result := make([]loadInA, len(c.result)+1)
+	reached := make([]bool, len(c.result)+1)
+	reached[0] = true
+
+	for ix, s := range c.result {
+		successors, ok := c.successorsOf(ix)
+		if !ok {
+			return make([]loadInA, len(c.result)+1)
+		}
+
+		after := result[ix]
+		if isConstantLoad(s) {
+			after = loadInA{load: s, known: true}
+		} else if _, defs := usesAndDefinitions(s); defs&liveA != 0 {
+			after = loadInA{}
+		}
+
+		for _, to := range successors {
+			if reached[to] {
+				result[to] = mergeLoadsInA(result[to], after)
+			} else {
+				result[to] = after
+				reached[to] = true
+			}
+		}
+	}
+
+	return result
+}
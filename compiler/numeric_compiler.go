@@ -78,6 +78,10 @@ func (s *numericCompilerVisitor) AcceptArithmetic(v tree.Arithmetic) {
 			return
 		}
 
+		if v.Op == tree.DIV || v.Op == tree.MOD {
+			s.ctx.guardAgainstDivisionByZero()
+		}
+
 		if err := s.ctx.pushAToStack(); err != nil {
 			s.err = err
 			return
@@ -109,6 +113,14 @@ func (s *numericCompilerVisitor) AcceptArithmetic(v tree.Arithmetic) {
 	s.ctx.op(arithOp, val)
 }
 
+// guardAgainstDivisionByZero makes the rule take its negative action if the divisor in A is zero, since
+// BPF would return 0 from the whole filter for a division by zero
+func (c *compilerContext) guardAgainstDivisionByZero() {
+	nonZero := c.newLabel()
+	c.opWithJumps(OP_JEQ_K, 0, c.getOrCreateAction(c.divisionByZeroAction), nonZero)
+	c.labelHere(nonZero)
+}
+
 // AcceptBinaryNegation implements Visitor
 func (s *numericCompilerVisitor) AcceptBinaryNegation(v tree.BinaryNegation) {
 	s.err = errors.New("a binary negation was found in an expression - this is likely a programmer error")
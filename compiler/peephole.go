@@ -7,25 +7,24 @@ import (
 	"golang.org/x/sys/unix"
 )
 
-// This file contains space for implementing peephole optimization
-// For now it doesn't do anything, but it could once we know what
-// patterns show up
+// This file contains the peephole optimizations. Most of them clean up after
+// the stack based compiler, which always pushes the right hand side of an
+// operation to scratch memory and pops it into X afterwards.
 
-// One common pattern we likely will want to fix is:
-// [ST n, LDX n]
-// and rewrite it into [TAX]
+// [ST n, LDX n] is rewritten into [ST n, TAX], and [LD_IMM v, ST n, LDX n]
+// into [LD_IMM v, ST n, LDX_IMM v]. The store and the immediate load are then
+// removed as dead code if nothing uses them anymore.
 
-// We might also see [LD_IMM v, ST n, LDX n]
-// This should be rewritten into [LDX_IMM v]
+// [LD_IMM v, ST n, ... LDX n, <op>] is rewritten to use the _K variant of the
+// operation, both for arithmetic and comparisons. If the constant is on the
+// left, [ST n, LD_IMM v, LDX n, <op>], the same is done for commutative
+// operations - and for comparisons that can be turned around.
 
 // Some patterns look amenable to optimization but in practice won't be
 // - it's important that we are wary of trying to fix up jumps too much.
-
-// We will see a lot of things like [LD_IMM v, ST n, ... LDX n, <op>]
-// These, both arithmetic and comparison ones should be rewritten to use the _K
-// variants. However, these is easier done in the actual compiler at the moment
-// These optimizations should also check if they operate on commutative
-// operators and try to put the constant to the right, where K can be used.
+// No instruction is removed from the middle of a pattern if something jumps
+// to it, and instructions are only removed if what they leave behind in
+// A, X or scratch memory is not used afterwards.
 
 func (c *compilerContext) optimizeCode() {
 	// We run optimizations over and over until we can't apply anymore
@@ -36,6 +35,7 @@ func (c *compilerContext) optimizeCode() {
 func (c *compilerContext) optimizeCycle() bool {
 	optimized := false
 	index := 0
+	c.forgetAnalyses()
 
 	// Do not pull out the length calculation here, since the length
 	// can change during optimization
@@ -56,16 +56,45 @@ var optimizers = []optimizer{
 	jumpAfterConditionalJumpOptimizer,
 	loadAndCompareWithImmediate,
 	loadAndPerformArithmeticWithImmediateOptimizer,
+	constantOnTheLeftOptimizer,
+	immediateLoadIntoXOptimizer,
+	storeAndLoadIntoXOptimizer,
+	deadStoreOptimizer,
+	deadLoadOptimizer,
+	redundantLoadOptimizer,
 }
 
+// optimizeAt stops at the first optimization that applies, since the instructions
+// from the index onward might have been removed
 func (c *compilerContext) optimizeAt(i int) bool {
-	optimized := false
-	for _, o := range optimizers {
+	for _, o := range c.optimizers {
 		if o(c, i) {
-			optimized = true
+			c.forgetAnalyses()
+			return true
 		}
 	}
-	return optimized
+	return false
+}
+
+// forgetAnalyses throws away the liveness and loads found for the code, so they are recalculated
+// the next time an optimizer needs them
+func (c *compilerContext) forgetAnalyses() {
+	c.live = nil
+	c.loadsInA = nil
+}
+
+func (c *compilerContext) cachedLiveAfter() []liveness {
+	if c.live == nil {
+		c.live = c.liveAfter()
+	}
+	return c.live
+}
+
+func (c *compilerContext) cachedLoadsInABefore() []loadInA {
+	if c.loadsInA == nil {
+		c.loadsInA = c.loadsInABefore()
+	}
+	return c.loadsInA
 }
 
 func isJump(s unix.SockFilter) bool {
@@ -132,10 +161,13 @@ func (c *compilerContext) removeInstructionAt(index int) {
 // is zero. It will make sure that no other jump points end up on the specific JMP instruction
 // before removing it. It will also make sure the resulting jump is not too large.
 // An example of a fragment that would be changed would be this:
-//    jeq_k	00	01	3D
-//    jmp	13
+//
+//	jeq_k	00	01	3D
+//	jmp	13
+//
 // This can be optimized to:
-//    jeq_k	13	00	3D
+//
+//	jeq_k	13	00	3D
 func jumpAfterConditionalJumpOptimizer(c *compilerContext, ix int) bool {
 	optimized := false
 
@@ -203,15 +235,18 @@ func sameStorageLocation(store, load unix.SockFilter) bool {
 // that.
 //
 // Example:
-//     ld_imm	0
-//     st	0
-//     ld_abs	18
-//     ldx_mem	0
-//     jeq_x	4A	4B
+//
+//	ld_imm	0
+//	st	0
+//	ld_abs	18
+//	ldx_mem	0
+//	jeq_x	4A	4B
+//
 // This is not great.
 // It can be reduced to:
-//     ld_abs  18
-//     jeq_k   4A   4B   0
+//
+//	ld_abs  18
+//	jeq_k   4A   4B   0
 func loadAndCompareWithImmediate(c *compilerContext, ix int) bool {
 	return loadStoreOptimizer(c, ix, isConditionalJumpWithX)
 }
@@ -220,36 +255,245 @@ func loadAndPerformArithmeticWithImmediateOptimizer(c *compilerContext, ix int)
 	return loadStoreOptimizer(c, ix, isArithmeticWithX)
 }
 
-func loadStoreOptimizer(c *compilerContext, ix int, f func(unix.SockFilter) bool) bool {
-	optimized := false
+// isJumpTarget returns true if anything jumps to the given index
+func isJumpTarget(c *compilerContext, ix int) bool {
+	return c.jts.countJumpsFromAny(c, ix)+c.jfs.countJumpsFromAny(c, ix)+c.uconds.countJumpsFromAny(c, ix) > 0
+}
+
+// hasJumpTargetBetween returns true if anything jumps to an instruction from the first index to the last one
+func hasJumpTargetBetween(c *compilerContext, first, last int) bool {
+	for ix := first; ix <= last; ix++ {
+		if isJumpTarget(c, ix) {
+			return true
+		}
+	}
+	return false
+}
 
-	if ix+4 < len(c.result) {
-		oneIndex, twoIndex, fourIndex, fiveIndex := ix, ix+1, ix+3, ix+4
-		one, two, four, five := c.result[oneIndex], c.result[twoIndex], c.result[fourIndex], c.result[fiveIndex]
-		if isImmediateLoad(one) &&
-			isStore(two) &&
-			isMemoryLoadIntoX(four) &&
-			f(five) &&
-			sameStorageLocation(two, four) {
-			c.result[fiveIndex].K = one.K
-			c.result[fiveIndex].Code = replaceXWithKIn(c.result[fiveIndex].Code)
+// isUnusedAfter returns true if nothing in the given set is used after the instruction at the index
+func isUnusedAfter(c *compilerContext, ix int, l liveness) bool {
+	return c.cachedLiveAfter()[ix]&l == 0
+}
 
-			c.shiftJumpsBy(oneIndex, -1)
-			c.removeInstructionAt(oneIndex)
+// removeInstructionsAt removes instructions that are not jumps, starting from the last one,
+// so the indices of the others stay the same
+func (c *compilerContext) removeInstructionsAt(indices ...int) {
+	for i := len(indices) - 1; i >= 0; i-- {
+		c.shiftJumpsBy(indices[i], -1)
+		c.removeInstructionAt(indices[i])
+	}
+}
 
-			c.shiftJumpsBy(oneIndex, -1)
-			c.removeInstructionAt(oneIndex)
+// loadStoreOptimizer finds an immediate load that is stored in scratch memory, and later loaded into X
+// for the operation f matches. The instructions in between calculate the other side of the operation,
+// and can't jump, touch the same scratch memory or use the value in A.
+func loadStoreOptimizer(c *compilerContext, ix int, f func(unix.SockFilter) bool) bool {
+	if ix+1 >= len(c.result) || !isImmediateLoad(c.result[ix]) || !isStore(c.result[ix+1]) {
+		return false
+	}
 
-			c.shiftJumpsBy(oneIndex+1, -1)
-			c.removeInstructionAt(oneIndex + 1)
+	store := c.result[ix+1]
+	location := liveMemory(storeLocationOf(store))
+	aIsCalculated := false
+	for loadIndex := ix + 2; loadIndex+1 < len(c.result); loadIndex++ {
+		load := c.result[loadIndex]
+		if isMemoryLoadIntoX(load) && sameStorageLocation(store, load) {
+			opIndex := loadIndex + 1
+			if !aIsCalculated ||
+				!f(c.result[opIndex]) ||
+				isDivisionByZero(c.result[opIndex].Code, c.result[ix].K) ||
+				hasJumpTargetBetween(c, ix+1, opIndex) ||
+				!isUnusedAfter(c, opIndex, location|liveX) {
+				return false
+			}
+
+			c.result[opIndex].K = c.result[ix].K
+			c.result[opIndex].Code = replaceXWithKIn(c.result[opIndex].Code)
+			c.removeInstructionsAt(ix, ix+1, loadIndex)
+			return true
+		}
 
-			optimized = true
+		if isJump(load) || bpfClass(load.Code) == syscall.BPF_RET {
+			return false
+		}
+		uses, defs := usesAndDefinitions(load)
+		if (uses|defs)&location != 0 || (!aIsCalculated && uses&liveA != 0) {
+			return false
+		}
+		if defs&liveA != 0 {
+			aIsCalculated = true
 		}
 	}
 
-	return optimized
+	return false
+}
+
+// isDivisionByZero returns true if the operation would divide by the given constant, and it is zero.
+// The kernel refuses to load filters that do that.
+func isDivisionByZero(code uint16, k uint32) bool {
+	op := bpfOp(code)
+	return bpfClass(code) == syscall.BPF_ALU && (op == syscall.BPF_DIV || op == BPF_MOD) && k == 0
 }
 
 func replaceXWithKIn(code uint16) uint16 {
 	return (code & ^uint16(syscall.BPF_X)) | uint16(syscall.BPF_K)
 }
+
+// withConstantOnTheRight returns the operation to use with a constant on the right hand side, instead of on the left,
+// and whether the targets of the jump have to be swapped for it
+func withConstantOnTheRight(code uint16) (uint16, bool, bool) {
+	op := bpfOp(code)
+	switch bpfClass(code) {
+	case syscall.BPF_ALU:
+		switch op {
+		case syscall.BPF_ADD, syscall.BPF_MUL, syscall.BPF_AND, syscall.BPF_OR, BPF_XOR:
+			return replaceXWithKIn(code), false, true
+		}
+	case syscall.BPF_JMP:
+		switch op {
+		case syscall.BPF_JEQ, syscall.BPF_JSET:
+			return replaceXWithKIn(code), false, true
+		case syscall.BPF_JGT:
+			// v > A is the same as !(A >= v)
+			return syscall.BPF_JMP | syscall.BPF_JGE | syscall.BPF_K, true, true
+		case syscall.BPF_JGE:
+			// v >= A is the same as !(A > v)
+			return syscall.BPF_JMP | syscall.BPF_JGT | syscall.BPF_K, true, true
+		}
+	}
+	return 0, false, false
+}
+
+func (c *compilerContext) swapJumpTargetsOf(ix int) {
+	jt, jf := c.jts.jumpTargetOf(ix), c.jfs.jumpTargetOf(ix)
+	c.jts.removeJumpTarget(ix)
+	c.jfs.removeJumpTarget(ix)
+	c.jts.registerJump(jf, ix)
+	c.jfs.registerJump(jt, ix)
+}
+
+// constantOnTheLeftOptimizer rewrites operations where the constant is on the left hand side:
+//
+//	ld_abs	10
+//	st	0
+//	ld_imm	1
+//	ldx_mem	0
+//	jeq_x	01	02
+//
+// It can be reduced to:
+//
+//	ld_abs	10
+//	jeq_k	01	02	1
+func constantOnTheLeftOptimizer(c *compilerContext, ix int) bool {
+	if ix+3 >= len(c.result) {
+		return false
+	}
+
+	store, one, load, op := c.result[ix], c.result[ix+1], c.result[ix+2], c.result[ix+3]
+	if !isStore(store) || !isImmediateLoad(one) || !isMemoryLoadIntoX(load) || !sameStorageLocation(store, load) {
+		return false
+	}
+
+	code, swap, ok := withConstantOnTheRight(op.Code)
+	if !ok || hasJumpTargetBetween(c, ix+1, ix+3) {
+		return false
+	}
+
+	// After the rewrite, A holds the other value instead of the constant
+	unused := liveMemory(storeLocationOf(store)) | liveX
+	if isJump(op) {
+		unused |= liveA
+	}
+	if !isUnusedAfter(c, ix+3, unused) {
+		return false
+	}
+
+	c.result[ix+3].Code = code
+	c.result[ix+3].K = one.K
+	if swap {
+		c.swapJumpTargetsOf(ix + 3)
+	}
+	c.removeInstructionsAt(ix, ix+1, ix+2)
+	return true
+}
+
+// immediateLoadIntoXOptimizer rewrites [LD_IMM v, ST n, LDX n] into [LD_IMM v, ST n, LDX_IMM v]
+func immediateLoadIntoXOptimizer(c *compilerContext, ix int) bool {
+	if ix+2 >= len(c.result) {
+		return false
+	}
+
+	one, store, load := c.result[ix], c.result[ix+1], c.result[ix+2]
+	if !isImmediateLoad(one) || !isStore(store) || !isMemoryLoadIntoX(load) || !sameStorageLocation(store, load) ||
+		hasJumpTargetBetween(c, ix+1, ix+2) {
+		return false
+	}
+
+	c.result[ix+2] = unix.SockFilter{Code: OP_LOAD_VAL_X, K: one.K}
+	return true
+}
+
+// storeAndLoadIntoXOptimizer rewrites [ST n, LDX n] into [ST n, TAX]
+func storeAndLoadIntoXOptimizer(c *compilerContext, ix int) bool {
+	if ix+1 >= len(c.result) {
+		return false
+	}
+
+	store, load := c.result[ix], c.result[ix+1]
+	if !isStore(store) || !isMemoryLoadIntoX(load) || !sameStorageLocation(store, load) || isJumpTarget(c, ix+1) {
+		return false
+	}
+
+	c.result[ix+1] = unix.SockFilter{Code: OP_TAX}
+	return true
+}
+
+// deadStoreOptimizer removes stores to scratch memory that is never read afterwards
+func deadStoreOptimizer(c *compilerContext, ix int) bool {
+	s := c.result[ix]
+	if !(isStore(s) || bpfClass(s.Code) == syscall.BPF_STX) {
+		return false
+	}
+
+	_, defs := usesAndDefinitions(s)
+	if !isUnusedAfter(c, ix, defs) {
+		return false
+	}
+
+	c.removeInstructionsAt(ix)
+	return true
+}
+
+// deadLoadOptimizer removes loads into A or X, and transfers between them, if the value is never used afterwards
+func deadLoadOptimizer(c *compilerContext, ix int) bool {
+	s := c.result[ix]
+	switch bpfClass(s.Code) {
+	case syscall.BPF_LD, syscall.BPF_LDX, syscall.BPF_MISC:
+	default:
+		return false
+	}
+
+	_, defs := usesAndDefinitions(s)
+	if !isUnusedAfter(c, ix, defs) {
+		return false
+	}
+
+	c.removeInstructionsAt(ix)
+	return true
+}
+
+// redundantLoadOptimizer removes loads of values that A already holds, no matter how the load is reached
+func redundantLoadOptimizer(c *compilerContext, ix int) bool {
+	s := c.result[ix]
+	if !isConstantLoad(s) {
+		return false
+	}
+
+	inA := c.cachedLoadsInABefore()[ix]
+	if !inA.known || inA.load != s {
+		return false
+	}
+
+	c.removeInstructionsAt(ix)
+	return true
+}
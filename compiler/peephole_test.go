@@ -1,8 +1,13 @@
 package compiler
 
 import (
+	"math/rand"
+
 	"github.com/twtiger/gosecco/asm"
+	"github.com/twtiger/gosecco/data"
+	"github.com/twtiger/gosecco/emulator"
 	"github.com/twtiger/gosecco/tree"
+	"golang.org/x/sys/unix"
 	. "gopkg.in/check.v1"
 )
 
@@ -66,16 +71,386 @@ func (s *PeepholeSuite) Test_triggeringArithmeticStackPeephole(c *C) {
 	res, _ := Compile(p)
 	c.Assert(asm.Dump(res), Equals, ""+
 		"ld_abs\t4\n"+
-		"jeq_k\t00\t0A\tC000003E\n"+
+		"jeq_k\t00\t06\tC000003E\n"+
 		"ld_abs\t0\n"+
-		"jeq_k\t00\t06\t1\n"+
-		"ld_imm\t2\n"+
-		"st\t0\n"+
+		"jeq_k\t00\t04\t1\n"+
 		"ld_abs\t10\n"+
 		"add_k\t1\n"+
+		"jeq_k\t00\t01\t2\n"+
+		"ret_k\t7FFF0000\n"+
+		"ret_k\t0\n")
+}
+
+// compilePolicyWith compiles the policy, only applying the given optimizers
+func compilePolicyWith(c *C, p tree.Policy, opts ...optimizer) []unix.SockFilter {
+	ctx := createCompilerContext()
+	ctx.optimizers = opts
+	res, err := ctx.compile(p)
+	c.Assert(err, IsNil)
+	return res
+}
+
+// compileProgramWith builds a program by hand, the way the compiler would, and only applies the given optimizers.
+// The program can jump to the labels returned by allow and kill.
+func compileProgramWith(build func(ctx *compilerContext, allow, kill label), opts ...optimizer) []unix.SockFilter {
+	ctx := createCompilerContext()
+	ctx.optimizers = opts
+	allow, kill := ctx.newLabel(), ctx.newLabel()
+	build(ctx, allow, kill)
+	ctx.labelHere(allow)
+	ctx.op(OP_RET_K, 0x7FFF0000)
+	ctx.labelHere(kill)
+	ctx.op(OP_RET_K, 0)
+	ctx.optimizeCode()
+	ctx.fixupJumps()
+	return ctx.result
+}
+
+var interestingArguments = []uint64{0, 1, 2, 3, 4, 5, 0x10, 0x13, 0xFFFFFFFF, 0x100000001}
+
+func assertSameResults(c *C, optimized, unoptimized []unix.SockFilter) {
+	for nr := int32(0); nr < 7; nr++ {
+		for _, a := range interestingArguments {
+			for _, b := range interestingArguments {
+				d := data.SeccompWorkingMemory{NR: nr, Arch: 0xC000003E, Args: [6]uint64{a, b}}
+				if emulator.Emulate(d, optimized) != emulator.Emulate(d, unoptimized) {
+					c.Fatalf("optimizing changes the result for %v with:\n%s\ninstead of:\n%s", d, asm.Dump(optimized), asm.Dump(unoptimized))
+				}
+			}
+		}
+	}
+}
+
+func policyWithBody(body tree.Expression) tree.Policy {
+	return tree.Policy{
+		DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill",
+		Rules: []*tree.Rule{&tree.Rule{Name: "read", Body: body}},
+	}
+}
+
+func argL(index int) tree.Argument {
+	return tree.Argument{Type: tree.Low, Index: index}
+}
+
+func (s *PeepholeSuite) Test_comparisonWithImmediateIsEquivalent(c *C) {
+	p := policyWithBody(tree.Comparison{
+		Op:    tree.GT,
+		Left:  tree.Arithmetic{Op: tree.PLUS, Left: argL(0), Right: argL(1)},
+		Right: tree.NumericLiteral{3},
+	})
+
+	unoptimized := compilePolicyWith(c, p)
+	optimized := compilePolicyWith(c, p, loadAndCompareWithImmediate)
+
+	c.Assert(asm.Dump(optimized), Equals, ""+
+		"ld_abs\t4\n"+
+		"jeq_k\t00\t09\tC000003E\n"+
+		"ld_abs\t0\n"+
+		"jeq_k\t00\t07\t0\n"+
+		"ld_abs\t18\n"+
+		"st\t1\n"+
+		"ld_abs\t10\n"+
+		"ldx_mem\t1\n"+
+		"add_x\n"+
+		"jgt_k\t00\t01\t3\n"+
+		"ret_k\t7FFF0000\n"+
+		"ret_k\t0\n")
+	assertSameResults(c, optimized, unoptimized)
+}
+
+func (s *PeepholeSuite) Test_arithmeticWithImmediateIsEquivalent(c *C) {
+	build := func(ctx *compilerContext, allow, kill label) {
+		ctx.op(OP_LOAD_VAL, 3)
+		ctx.op(OP_STORE, 0)
+		ctx.op(OP_LOAD, 0x10)
+		ctx.op(OP_LOAD_MEM_X, 0)
+		ctx.op(OP_SUB_X, 0)
+		ctx.jumpOnEq(2, allow, kill)
+	}
+
+	unoptimized := compileProgramWith(build)
+	optimized := compileProgramWith(build, loadAndPerformArithmeticWithImmediateOptimizer)
+
+	c.Assert(asm.Dump(optimized), Equals, ""+
+		"ld_abs\t10\n"+
+		"sub_k\t3\n"+
+		"jeq_k\t00\t01\t2\n"+
+		"ret_k\t7FFF0000\n"+
+		"ret_k\t0\n")
+	assertSameResults(c, optimized, unoptimized)
+}
+
+func (s *PeepholeSuite) Test_arithmeticWithImmediateIsNotOptimizedIfAIsUsedBeforeItIsCalculated(c *C) {
+	build := func(ctx *compilerContext, allow, kill label) {
+		ctx.op(OP_LOAD_VAL, 3)
+		ctx.op(OP_STORE, 0)
+		ctx.op(replaceXWithKIn(OP_ADD_X), 1)
+		ctx.op(OP_LOAD_MEM_X, 0)
+		ctx.op(OP_SUB_X, 0)
+		ctx.jumpOnEq(1, allow, kill)
+	}
+
+	optimized := compileProgramWith(build, loadAndPerformArithmeticWithImmediateOptimizer)
+
+	c.Assert(asm.Dump(optimized), Equals, ""+
+		"ld_imm\t3\n"+
+		"st\t0\n"+
+		"add_k\t1\n"+
 		"ldx_mem\t0\n"+
-		"jeq_x\t01\t02\n"+
-		"jmp\t1\n"+
+		"sub_x\n"+
+		"jeq_k\t00\t01\t1\n"+
+		"ret_k\t7FFF0000\n"+
+		"ret_k\t0\n")
+}
+
+func (s *PeepholeSuite) Test_divisionByAnImmediateZeroIsNotOptimized(c *C) {
+	build := func(ctx *compilerContext, allow, kill label) {
+		ctx.op(OP_LOAD_VAL, 0)
+		ctx.op(OP_STORE, 0)
+		ctx.op(OP_LOAD, 0x10)
+		ctx.op(OP_LOAD_MEM_X, 0)
+		ctx.op(OP_DIV_X, 0)
+		ctx.jumpOnEq(1, allow, kill)
+	}
+
+	optimized := compileProgramWith(build, loadAndPerformArithmeticWithImmediateOptimizer)
+
+	c.Assert(len(optimized), Equals, 8)
+}
+
+func (s *PeepholeSuite) Test_constantOnTheLeftIsEquivalent(c *C) {
+	bodies := []tree.Expression{
+		tree.Comparison{Op: tree.EQL, Left: tree.NumericLiteral{1}, Right: argL(0)},
+		tree.Comparison{Op: tree.GT, Left: tree.NumericLiteral{3}, Right: argL(0)},
+		tree.Comparison{Op: tree.GTE, Left: tree.NumericLiteral{3}, Right: argL(0)},
+		tree.Comparison{Op: tree.NEQL, Left: tree.NumericLiteral{2}, Right: argL(1)},
+		tree.Comparison{
+			Op:    tree.EQL,
+			Left:  tree.Arithmetic{Op: tree.MULT, Left: tree.NumericLiteral{2}, Right: argL(0)},
+			Right: tree.NumericLiteral{4},
+		},
+		tree.Comparison{
+			Op:    tree.EQL,
+			Left:  tree.Arithmetic{Op: tree.BINAND, Left: tree.NumericLiteral{0x10}, Right: argL(0)},
+			Right: tree.NumericLiteral{0},
+		},
+	}
+
+	for _, b := range bodies {
+		p := policyWithBody(b)
+		unoptimized := compilePolicyWith(c, p)
+		optimized := compilePolicyWith(c, p, constantOnTheLeftOptimizer)
+
+		c.Assert(len(optimized), Equals, len(unoptimized)-3, Commentf("%s", tree.ExpressionString(b)))
+		assertSameResults(c, optimized, unoptimized)
+	}
+}
+
+func (s *PeepholeSuite) Test_constantOnTheLeftSwapsTheTargetsOfGreaterThan(c *C) {
+	p := policyWithBody(tree.Comparison{Op: tree.GT, Left: tree.NumericLiteral{3}, Right: argL(0)})
+
+	optimized := compilePolicyWith(c, p, constantOnTheLeftOptimizer)
+
+	c.Assert(asm.Dump(optimized), Equals, ""+
+		"ld_abs\t4\n"+
+		"jeq_k\t00\t05\tC000003E\n"+
+		"ld_abs\t0\n"+
+		"jeq_k\t00\t03\t0\n"+
+		"ld_abs\t10\n"+
+		"jge_k\t01\t00\t3\n"+
+		"ret_k\t7FFF0000\n"+
+		"ret_k\t0\n")
+}
+
+func (s *PeepholeSuite) Test_constantOnTheLeftIsNotOptimizedForSubtraction(c *C) {
+	p := policyWithBody(tree.Comparison{
+		Op:    tree.EQL,
+		Left:  tree.Arithmetic{Op: tree.MINUS, Left: tree.NumericLiteral{5}, Right: argL(0)},
+		Right: tree.NumericLiteral{4},
+	})
+
+	c.Assert(len(compilePolicyWith(c, p, constantOnTheLeftOptimizer)), Equals, len(compilePolicyWith(c, p)))
+}
+
+func (s *PeepholeSuite) Test_immediateLoadIntoXIsEquivalent(c *C) {
+	build := func(ctx *compilerContext, allow, kill label) {
+		ctx.op(OP_LOAD_VAL, 3)
+		ctx.op(OP_STORE, 0)
+		ctx.op(OP_LOAD_MEM_X, 0)
+		ctx.op(OP_LOAD, 0x10)
+		ctx.opWithJumps(OP_JGT_X, 0, allow, kill)
+	}
+
+	unoptimized := compileProgramWith(build)
+	optimized := compileProgramWith(build, immediateLoadIntoXOptimizer)
+
+	c.Assert(asm.Dump(optimized), Equals, ""+
+		"ld_imm\t3\n"+
+		"st\t0\n"+
+		"ldx_imm\t3\n"+
+		"ld_abs\t10\n"+
+		"jgt_x\t00\t01\n"+
+		"ret_k\t7FFF0000\n"+
+		"ret_k\t0\n")
+	assertSameResults(c, optimized, unoptimized)
+}
+
+func (s *PeepholeSuite) Test_storeAndLoadIntoXIsEquivalent(c *C) {
+	build := func(ctx *compilerContext, allow, kill label) {
+		ctx.op(OP_LOAD, 0x18)
+		ctx.op(OP_STORE, 0)
+		ctx.op(OP_LOAD_MEM_X, 0)
+		ctx.op(OP_LOAD, 0x10)
+		ctx.opWithJumps(OP_JEQ_X, 0, allow, kill)
+	}
+
+	unoptimized := compileProgramWith(build)
+	optimized := compileProgramWith(build, storeAndLoadIntoXOptimizer)
+
+	c.Assert(asm.Dump(optimized), Equals, ""+
+		"ld_abs\t18\n"+
+		"st\t0\n"+
+		"tax\n"+
+		"ld_abs\t10\n"+
+		"jeq_x\t00\t01\n"+
 		"ret_k\t7FFF0000\n"+
 		"ret_k\t0\n")
+	assertSameResults(c, optimized, unoptimized)
+}
+
+func (s *PeepholeSuite) Test_storeAndLoadIntoXIsNotOptimizedIfTheLoadIsAJumpTarget(c *C) {
+	build := func(ctx *compilerContext, allow, kill label) {
+		load, store := ctx.newLabel(), ctx.newLabel()
+		ctx.op(OP_LOAD_VAL, 1)
+		ctx.op(OP_STORE, 0)
+		ctx.op(OP_LOAD, 0x10)
+		ctx.opWithJumps(OP_JEQ_K, 0, load, store)
+		ctx.labelHere(store)
+		ctx.op(OP_STORE, 0)
+		ctx.labelHere(load)
+		ctx.op(OP_LOAD_MEM_X, 0)
+		ctx.op(OP_LOAD, 0x18)
+		ctx.opWithJumps(OP_JEQ_X, 0, allow, kill)
+	}
+
+	unoptimized := compileProgramWith(build)
+	optimized := compileProgramWith(build, storeAndLoadIntoXOptimizer)
+
+	c.Assert(asm.Dump(optimized), Equals, asm.Dump(unoptimized))
+}
+
+func (s *PeepholeSuite) Test_deadStoresAreRemoved(c *C) {
+	build := func(ctx *compilerContext, allow, kill label) {
+		ctx.op(OP_LOAD, 0x18)
+		ctx.op(OP_STORE, 0)
+		ctx.op(OP_STORE, 1)
+		ctx.op(OP_LOAD_MEM_X, 1)
+		ctx.op(OP_LOAD, 0x10)
+		ctx.op(OP_STORE, 1)
+		ctx.opWithJumps(OP_JEQ_X, 0, allow, kill)
+	}
+
+	unoptimized := compileProgramWith(build)
+	optimized := compileProgramWith(build, deadStoreOptimizer)
+
+	c.Assert(asm.Dump(optimized), Equals, ""+
+		"ld_abs\t18\n"+
+		"st\t1\n"+
+		"ldx_mem\t1\n"+
+		"ld_abs\t10\n"+
+		"jeq_x\t00\t01\n"+
+		"ret_k\t7FFF0000\n"+
+		"ret_k\t0\n")
+	assertSameResults(c, optimized, unoptimized)
+}
+
+func (s *PeepholeSuite) Test_deadLoadsAreRemoved(c *C) {
+	build := func(ctx *compilerContext, allow, kill label) {
+		ctx.op(OP_LOAD_VAL, 1)
+		ctx.op(OP_LOAD_VAL_X, 2)
+		ctx.op(OP_LOAD, 0x18)
+		ctx.op(OP_TAX, 0)
+		ctx.op(OP_LOAD, 0x10)
+		ctx.opWithJumps(OP_JEQ_X, 0, allow, kill)
+	}
+
+	unoptimized := compileProgramWith(build)
+	optimized := compileProgramWith(build, deadLoadOptimizer)
+
+	c.Assert(asm.Dump(optimized), Equals, ""+
+		"ld_abs\t18\n"+
+		"tax\n"+
+		"ld_abs\t10\n"+
+		"jeq_x\t00\t01\n"+
+		"ret_k\t7FFF0000\n"+
+		"ret_k\t0\n")
+	assertSameResults(c, optimized, unoptimized)
+}
+
+func (s *PeepholeSuite) Test_redundantLoadsAreRemovedOnlyIfAllPathsHaveTheValueInA(c *C) {
+	build := func(ctx *compilerContext, allow, kill label) {
+		second, third, fourth := ctx.newLabel(), ctx.newLabel(), ctx.newLabel()
+		ctx.op(OP_LOAD, 0x10)
+		ctx.opWithJumps(OP_JEQ_K, 1, second, third)
+		ctx.labelHere(second)
+		ctx.op(OP_LOAD, 0x10)
+		ctx.opWithJumps(OP_JEQ_K, 2, allow, kill)
+		ctx.labelHere(third)
+		ctx.op(OP_LOAD, 0x10)
+		ctx.opWithJumps(OP_JEQ_K, 3, fourth, kill)
+		ctx.labelHere(fourth)
+		ctx.op(OP_LOAD, 0x18)
+		ctx.opWithJumps(OP_JEQ_K, 4, allow, kill)
+	}
+
+	unoptimized := compileProgramWith(build)
+	optimized := compileProgramWith(build, redundantLoadOptimizer)
+
+	c.Assert(asm.Dump(optimized), Equals, ""+
+		"ld_abs\t10\n"+
+		"jeq_k\t00\t01\t1\n"+
+		"jeq_k\t03\t04\t2\n"+
+		"jeq_k\t00\t03\t3\n"+
+		"ld_abs\t18\n"+
+		"jeq_k\t00\t01\t4\n"+
+		"ret_k\t7FFF0000\n"+
+		"ret_k\t0\n")
+	assertSameResults(c, optimized, unoptimized)
+}
+
+func randomNumericExpression(r *rand.Rand, depth int) tree.Numeric {
+	if depth == 0 || r.Intn(2) == 0 {
+		if r.Intn(2) == 0 {
+			return tree.NumericLiteral{uint64(r.Intn(5))}
+		}
+		return argL(r.Intn(2))
+	}
+	ops := []tree.ArithmeticType{tree.PLUS, tree.MINUS, tree.MULT, tree.BINAND, tree.BINOR, tree.BINXOR, tree.DIV, tree.MOD}
+	return tree.Arithmetic{Op: ops[r.Intn(len(ops))], Left: randomNumericExpression(r, depth-1), Right: randomNumericExpression(r, depth-1)}
+}
+
+func randomArithmeticExpression(r *rand.Rand, depth int) tree.Boolean {
+	if depth == 0 || r.Intn(3) == 0 {
+		return tree.Comparison{
+			Op:    []tree.ComparisonType{tree.EQL, tree.NEQL, tree.GT, tree.GTE}[r.Intn(4)],
+			Left:  randomNumericExpression(r, 2),
+			Right: randomNumericExpression(r, 2),
+		}
+	}
+	if r.Intn(2) == 0 {
+		return tree.And{Left: randomArithmeticExpression(r, depth-1), Right: randomArithmeticExpression(r, depth-1)}
+	}
+	return tree.Or{Left: randomArithmeticExpression(r, depth-1), Right: randomArithmeticExpression(r, depth-1)}
+}
+
+func (s *PeepholeSuite) Test_optimizationsDoNotChangeTheResult(c *C) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 300; i++ {
+		p := randomPolicy(r)
+		for _, rule := range p.Rules {
+			rule.Body = randomArithmeticExpression(r, 2)
+		}
+
+		assertSameResults(c, compilePolicyWith(c, p, optimizers...), compilePolicyWith(c, p))
+	}
 }
@@ -1,6 +1,9 @@
 package compiler
 
-import "github.com/twtiger/gosecco/native"
+import (
+	"github.com/twtiger/gosecco/constants"
+	"github.com/twtiger/gosecco/native"
+)
 
 const archIndex = 4
 
@@ -13,12 +16,13 @@ func (c *compilerContext) compileAuditArchCheck(on string) {
 	correct := c.newLabel()
 
 	c.loadAt(archIndex)
-	c.jumpOnEq(native.AuditArch, correct, failure)
+	c.jumpOnEq(c.arch.AuditArch, correct, failure)
 	c.labelHere(correct)
 }
 
+// compileX32ABICheck checks for the syscalls of the x32 ABI, which use the same audit architecture as x86_64
 func (c *compilerContext) compileX32ABICheck(on string) {
-	if on == "" {
+	if on == "" || c.arch != constants.X86_64 {
 		return
 	}
 
@@ -2,6 +2,7 @@ package compiler
 
 import (
 	"github.com/twtiger/gosecco/asm"
+	"github.com/twtiger/gosecco/constants"
 	. "gopkg.in/check.v1"
 )
 
@@ -24,3 +25,19 @@ func (s *PrefixSuite) Test_compiles32ABICheck(c *C) {
 		"ld_abs\t0\n"+
 		"jset_k\t00\t00\t40000000\n")
 }
+
+func (s *PrefixSuite) Test_compilesAuditArchForTheArchitecture(c *C) {
+	ctx := createCompilerContext()
+	ctx.arch = constants.I386
+	ctx.compileAuditArchCheck("kill")
+	c.Assert(asm.Dump(ctx.result), Equals, ""+
+		"ld_abs\t4\n"+
+		"jeq_k\t00\t00\t40000003\n")
+}
+
+func (s *PrefixSuite) Test_doesNotCompile32ABICheckForOtherArchitectures(c *C) {
+	ctx := createCompilerContext()
+	ctx.arch = constants.I386
+	ctx.compileX32ABICheck("trace")
+	c.Assert(len(ctx.result), Equals, 0)
+}
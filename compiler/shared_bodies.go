@@ -0,0 +1,62 @@
+package compiler
+
+import "github.com/twtiger/gosecco/tree"
+
+// In whitelists, many syscalls share the same body, like `1` or `arg0 == 1`.
+// Instead of compiling the same body once for every rule, the rules with
+// structurally identical bodies and actions are grouped together, and the
+// group is compiled as a set of syscall checks that all jump to one shared body:
+//   read: arg0 == 1
+//   write: arg0 == 1
+// becomes
+//   ld_abs 0
+//   jeq_k read, body, next
+//   jeq_k write, body, next
+// body:
+//   ...
+
+// bodyKey identifies the rules that can share a compiled body
+type bodyKey struct {
+	body               string
+	positive, negative string
+}
+
+func (c *compilerContext) bodyKeyOf(r *tree.Rule) bodyKey {
+	positive := r.PositiveAction
+	if positive == "" {
+		positive = c.defaultPositive
+	}
+	return bodyKey{
+		body:     tree.ExpressionString(r.Body),
+		positive: positive,
+		negative: c.negativeActionOf(r),
+	}
+}
+
+// groupRulesWithSameBody returns the rules grouped by body and actions, in the order the groups first appear.
+// A rule is only moved into an earlier group if no earlier rule is for the same syscall number, since moving it would
+// otherwise change which of the rules is used. Rules with outcomes are never grouped.
+func (c *compilerContext) groupRulesWithSameBody(rules []*tree.Rule) [][]*tree.Rule {
+	groups := [][]*tree.Rule{}
+	groupOf := make(map[bodyKey]int)
+	seen := make(map[uint32]bool)
+
+	for _, r := range rules {
+		nr, _ := c.arch.GetSyscall(r.Name)
+		if len(r.Outcomes) == 0 {
+			key := c.bodyKeyOf(r)
+			if ix, ok := groupOf[key]; ok && !seen[nr] {
+				groups[ix] = append(groups[ix], r)
+				seen[nr] = true
+				continue
+			}
+			if _, ok := groupOf[key]; !ok {
+				groupOf[key] = len(groups)
+			}
+		}
+		groups = append(groups, []*tree.Rule{r})
+		seen[nr] = true
+	}
+
+	return groups
+}
@@ -0,0 +1,108 @@
+package compiler
+
+import (
+	"github.com/twtiger/gosecco/asm"
+	"github.com/twtiger/gosecco/data"
+	"github.com/twtiger/gosecco/emulator"
+	"github.com/twtiger/gosecco/tree"
+	. "gopkg.in/check.v1"
+)
+
+type SharedBodiesSuite struct{}
+
+var _ = Suite(&SharedBodiesSuite{})
+
+func arg0Is(v uint64) tree.Expression {
+	return tree.Comparison{Op: tree.EQL, Left: tree.Argument{Type: tree.Low, Index: 0}, Right: tree.NumericLiteral{v}}
+}
+
+func ruleNames(groups [][]*tree.Rule) [][]string {
+	result := [][]string{}
+	for _, g := range groups {
+		names := []string{}
+		for _, r := range g {
+			names = append(names, r.Name)
+		}
+		result = append(result, names)
+	}
+	return result
+}
+
+func (s *SharedBodiesSuite) Test_groupsRulesWithTheSameBodyAndActions(c *C) {
+	ctx := createCompilerContext()
+	ctx.setDefaults("allow", "kill", "kill")
+
+	groups := ctx.groupRulesWithSameBody([]*tree.Rule{
+		&tree.Rule{Name: "read", Body: arg0Is(1)},
+		&tree.Rule{Name: "write", Body: arg0Is(2)},
+		&tree.Rule{Name: "close", Body: arg0Is(1)},
+		&tree.Rule{Name: "dup", Body: arg0Is(1), NegativeAction: "trace"},
+		&tree.Rule{Name: "fstat", Body: arg0Is(1), PositiveAction: "allow", NegativeAction: "kill"},
+		&tree.Rule{Name: "ioctl", Body: arg0Is(2), Outcomes: []tree.Outcome{tree.Outcome{Condition: arg0Is(2), Action: "trace"}}},
+	})
+
+	c.Assert(ruleNames(groups), DeepEquals, [][]string{
+		[]string{"read", "close", "fstat"},
+		[]string{"write"},
+		[]string{"dup"},
+		[]string{"ioctl"},
+	})
+}
+
+func (s *SharedBodiesSuite) Test_doesNotMoveARuleBeforeAnEarlierRuleForTheSameSyscall(c *C) {
+	ctx := createCompilerContext()
+	ctx.setDefaults("allow", "kill", "kill")
+
+	groups := ctx.groupRulesWithSameBody([]*tree.Rule{
+		&tree.Rule{Name: "read", Body: arg0Is(1)},
+		&tree.Rule{Name: "write", Body: arg0Is(2)},
+		&tree.Rule{Name: "write", Body: arg0Is(1)},
+		&tree.Rule{Name: "fstat", Body: arg0Is(2)},
+		&tree.Rule{Name: "syscall(5)", Body: arg0Is(1)},
+		&tree.Rule{Name: "close", Body: arg0Is(1)},
+	})
+
+	c.Assert(ruleNames(groups), DeepEquals, [][]string{
+		[]string{"read", "close"},
+		[]string{"write", "fstat"},
+		[]string{"write"},
+		[]string{"syscall(5)"},
+	})
+}
+
+func (s *SharedBodiesSuite) Test_compilesIdenticalBodiesOnce(c *C) {
+	p := tree.Policy{
+		DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill",
+		Rules: []*tree.Rule{
+			&tree.Rule{Name: "read", Body: arg0Is(1)},
+			&tree.Rule{Name: "write", Body: arg0Is(2)},
+			&tree.Rule{Name: "close", Body: arg0Is(1)},
+		},
+	}
+
+	res, _ := Compile(p)
+	c.Assert(asm.Dump(res), Equals, ""+
+		"ld_abs\t4\n"+
+		"jeq_k\t00\t09\tC000003E\n"+
+		"ld_abs\t0\n"+
+		"jeq_k\t01\t00\t0\n"+
+		"jeq_k\t00\t02\t3\n"+
+		"ld_abs\t10\n"+
+		"jeq_k\t03\t04\t1\n"+
+		"jeq_k\t00\t03\t1\n"+
+		"ld_abs\t10\n"+
+		"jeq_k\t00\t01\t2\n"+
+		"ret_k\t7FFF0000\n"+
+		"ret_k\t0\n")
+
+	call := func(nr int32, arg0 uint64) uint32 {
+		return emulator.Emulate(data.SeccompWorkingMemory{NR: nr, Arch: 0xC000003E, Args: [6]uint64{arg0}}, res)
+	}
+	c.Assert(call(0, 1), Equals, uint32(0x7FFF0000))
+	c.Assert(call(0, 2), Equals, uint32(0))
+	c.Assert(call(1, 2), Equals, uint32(0x7FFF0000))
+	c.Assert(call(1, 1), Equals, uint32(0))
+	c.Assert(call(3, 1), Equals, uint32(0x7FFF0000))
+	c.Assert(call(3, 2), Equals, uint32(0))
+	c.Assert(call(4, 1), Equals, uint32(0))
+}
@@ -0,0 +1,140 @@
+package constants
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+//go:generate go run ./generate -headers linux -out .
+
+// Arch contains the syscalls, errors and constants of one architecture. The tables are generated from the
+// copy of the kernel headers in the linux directory.
+type Arch struct {
+	// Name is the name the kernel uses for the architecture
+	Name string
+	// AuditArch is the value seccomp reports for system calls made on this architecture
+	AuditArch uint32
+	// KernelVersion is the version of the headers the tables were generated from
+	KernelVersion string
+	Syscalls      []SyscallInfo
+	Errors        []Constant
+	Constants     []Constant
+
+	index          sync.Once
+	syscallIndex   map[string]int
+	constantValues map[string]int64
+}
+
+// SyscallInfo describes one syscall on an architecture
+type SyscallInfo struct {
+	Name   string
+	Number int
+	// Since is the first kernel version where the syscall is available on the architecture
+	Since string
+}
+
+// Constant is a named value from the kernel headers
+type Constant struct {
+	Name  string
+	Value int64
+}
+
+// Architectures contain all the architectures there are tables for, by name
+var Architectures = map[string]*Arch{
+	X86_64.Name: X86_64,
+	I386.Name:   I386,
+}
+
+// Native is the architecture the registered syscalls, errors and constants come from. It is the same architecture
+// the native package installs filters for.
+var Native = X86_64
+
+// GetArch returns the architecture with the given name if there are tables for it. The empty name refers to the
+// native architecture
+func GetArch(name string) (*Arch, bool) {
+	if name == "" {
+		return Native, true
+	}
+	res, ok := Architectures[name]
+	return res, ok
+}
+
+// ArchNames returns the names of all architectures there are tables for
+func ArchNames() []string {
+	result := []string{}
+	for name := range Architectures {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (a *Arch) buildIndex() {
+	a.index.Do(func() {
+		a.syscallIndex = make(map[string]int)
+		for ix, s := range a.Syscalls {
+			a.syscallIndex[s.Name] = ix
+		}
+		a.constantValues = make(map[string]int64)
+		for _, c := range a.Errors {
+			a.constantValues[c.Name] = c.Value
+		}
+		for _, c := range a.Constants {
+			a.constantValues[c.Name] = c.Value
+		}
+	})
+}
+
+// GetSyscallInfo returns the information about the syscall with the given name on the architecture, if it exists there
+func (a *Arch) GetSyscallInfo(name string) (SyscallInfo, bool) {
+	a.buildIndex()
+	ix, ok := a.syscallIndex[strings.ToLower(name)]
+	if !ok {
+		return SyscallInfo{}, false
+	}
+	return a.Syscalls[ix], true
+}
+
+// GetSyscall returns the number of the syscall with the given name on the architecture, if it exists there. Names
+// created by RawSyscallName always exist. The native architecture also has the syscalls registered with RegisterSyscall
+func (a *Arch) GetSyscall(name string) (uint32, bool) {
+	if a == Native {
+		return GetSyscall(name)
+	}
+	if nr, ok := rawSyscallNumber(name); ok {
+		return nr, true
+	}
+	s, ok := a.GetSyscallInfo(name)
+	return uint32(s.Number), ok
+}
+
+// GetConstant returns the constant with the given name on the architecture, in the same way as the GetConstant
+// function. The native architecture also has the errors and constants registered with RegisterError and RegisterConstant
+func (a *Arch) GetConstant(name string) (uint64, bool) {
+	if a == Native {
+		return GetConstant(name)
+	}
+	a.buildIndex()
+	res, ok := a.constantValues[strings.ToUpper(name)]
+	if res < 0 {
+		return uint64(uint32(res)), ok
+	}
+	return uint64(res), ok
+}
+
+// ConstantNames returns the names of all the errors and constants of the architecture
+func (a *Arch) ConstantNames() []string {
+	result := []string{}
+	if a == Native {
+		for name := range AllConstants {
+			result = append(result, name)
+		}
+		return result
+	}
+	a.buildIndex()
+	for name := range a.constantValues {
+		result = append(result, name)
+	}
+	return result
+}
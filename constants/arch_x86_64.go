@@ -0,0 +1,2961 @@
+// Code generated by go run ./generate. DO NOT EDIT.
+
+package constants
+
+// X86_64 contains the syscalls, errors and constants of the x86_64 architecture, from the headers of Linux 6.1
+var X86_64 = &Arch{
+	Name:          "x86_64",
+	AuditArch:     0xC000003E,
+	KernelVersion: "6.1",
+	Syscalls: []SyscallInfo{
+		{"read", 0, "2.6.0"},
+		{"write", 1, "2.6.0"},
+		{"open", 2, "2.6.0"},
+		{"close", 3, "2.6.0"},
+		{"stat", 4, "2.6.0"},
+		{"fstat", 5, "2.6.0"},
+		{"lstat", 6, "2.6.0"},
+		{"poll", 7, "2.6.0"},
+		{"lseek", 8, "2.6.0"},
+		{"mmap", 9, "2.6.0"},
+		{"mprotect", 10, "2.6.0"},
+		{"munmap", 11, "2.6.0"},
+		{"brk", 12, "2.6.0"},
+		{"rt_sigaction", 13, "2.6.0"},
+		{"rt_sigprocmask", 14, "2.6.0"},
+		{"rt_sigreturn", 15, "2.6.0"},
+		{"ioctl", 16, "2.6.0"},
+		{"pread64", 17, "2.6.0"},
+		{"pwrite64", 18, "2.6.0"},
+		{"readv", 19, "2.6.0"},
+		{"writev", 20, "2.6.0"},
+		{"access", 21, "2.6.0"},
+		{"pipe", 22, "2.6.0"},
+		{"select", 23, "2.6.0"},
+		{"sched_yield", 24, "2.6.0"},
+		{"mremap", 25, "2.6.0"},
+		{"msync", 26, "2.6.0"},
+		{"mincore", 27, "2.6.0"},
+		{"madvise", 28, "2.6.0"},
+		{"shmget", 29, "2.6.0"},
+		{"shmat", 30, "2.6.0"},
+		{"shmctl", 31, "2.6.0"},
+		{"dup", 32, "2.6.0"},
+		{"dup2", 33, "2.6.0"},
+		{"pause", 34, "2.6.0"},
+		{"nanosleep", 35, "2.6.0"},
+		{"getitimer", 36, "2.6.0"},
+		{"alarm", 37, "2.6.0"},
+		{"setitimer", 38, "2.6.0"},
+		{"getpid", 39, "2.6.0"},
+		{"sendfile", 40, "2.6.0"},
+		{"socket", 41, "2.6.0"},
+		{"connect", 42, "2.6.0"},
+		{"accept", 43, "2.6.0"},
+		{"sendto", 44, "2.6.0"},
+		{"recvfrom", 45, "2.6.0"},
+		{"sendmsg", 46, "2.6.0"},
+		{"recvmsg", 47, "2.6.0"},
+		{"shutdown", 48, "2.6.0"},
+		{"bind", 49, "2.6.0"},
+		{"listen", 50, "2.6.0"},
+		{"getsockname", 51, "2.6.0"},
+		{"getpeername", 52, "2.6.0"},
+		{"socketpair", 53, "2.6.0"},
+		{"setsockopt", 54, "2.6.0"},
+		{"getsockopt", 55, "2.6.0"},
+		{"clone", 56, "2.6.0"},
+		{"fork", 57, "2.6.0"},
+		{"vfork", 58, "2.6.0"},
+		{"execve", 59, "2.6.0"},
+		{"exit", 60, "2.6.0"},
+		{"wait4", 61, "2.6.0"},
+		{"kill", 62, "2.6.0"},
+		{"uname", 63, "2.6.0"},
+		{"semget", 64, "2.6.0"},
+		{"semop", 65, "2.6.0"},
+		{"semctl", 66, "2.6.0"},
+		{"shmdt", 67, "2.6.0"},
+		{"msgget", 68, "2.6.0"},
+		{"msgsnd", 69, "2.6.0"},
+		{"msgrcv", 70, "2.6.0"},
+		{"msgctl", 71, "2.6.0"},
+		{"fcntl", 72, "2.6.0"},
+		{"flock", 73, "2.6.0"},
+		{"fsync", 74, "2.6.0"},
+		{"fdatasync", 75, "2.6.0"},
+		{"truncate", 76, "2.6.0"},
+		{"ftruncate", 77, "2.6.0"},
+		{"getdents", 78, "2.6.0"},
+		{"getcwd", 79, "2.6.0"},
+		{"chdir", 80, "2.6.0"},
+		{"fchdir", 81, "2.6.0"},
+		{"rename", 82, "2.6.0"},
+		{"mkdir", 83, "2.6.0"},
+		{"rmdir", 84, "2.6.0"},
+		{"creat", 85, "2.6.0"},
+		{"link", 86, "2.6.0"},
+		{"unlink", 87, "2.6.0"},
+		{"symlink", 88, "2.6.0"},
+		{"readlink", 89, "2.6.0"},
+		{"chmod", 90, "2.6.0"},
+		{"fchmod", 91, "2.6.0"},
+		{"chown", 92, "2.6.0"},
+		{"fchown", 93, "2.6.0"},
+		{"lchown", 94, "2.6.0"},
+		{"umask", 95, "2.6.0"},
+		{"gettimeofday", 96, "2.6.0"},
+		{"getrlimit", 97, "2.6.0"},
+		{"getrusage", 98, "2.6.0"},
+		{"sysinfo", 99, "2.6.0"},
+		{"times", 100, "2.6.0"},
+		{"ptrace", 101, "2.6.0"},
+		{"getuid", 102, "2.6.0"},
+		{"syslog", 103, "2.6.0"},
+		{"getgid", 104, "2.6.0"},
+		{"setuid", 105, "2.6.0"},
+		{"setgid", 106, "2.6.0"},
+		{"geteuid", 107, "2.6.0"},
+		{"getegid", 108, "2.6.0"},
+		{"setpgid", 109, "2.6.0"},
+		{"getppid", 110, "2.6.0"},
+		{"getpgrp", 111, "2.6.0"},
+		{"setsid", 112, "2.6.0"},
+		{"setreuid", 113, "2.6.0"},
+		{"setregid", 114, "2.6.0"},
+		{"getgroups", 115, "2.6.0"},
+		{"setgroups", 116, "2.6.0"},
+		{"setresuid", 117, "2.6.0"},
+		{"getresuid", 118, "2.6.0"},
+		{"setresgid", 119, "2.6.0"},
+		{"getresgid", 120, "2.6.0"},
+		{"getpgid", 121, "2.6.0"},
+		{"setfsuid", 122, "2.6.0"},
+		{"setfsgid", 123, "2.6.0"},
+		{"getsid", 124, "2.6.0"},
+		{"capget", 125, "2.6.0"},
+		{"capset", 126, "2.6.0"},
+		{"rt_sigpending", 127, "2.6.0"},
+		{"rt_sigtimedwait", 128, "2.6.0"},
+		{"rt_sigqueueinfo", 129, "2.6.0"},
+		{"rt_sigsuspend", 130, "2.6.0"},
+		{"sigaltstack", 131, "2.6.0"},
+		{"utime", 132, "2.6.0"},
+		{"mknod", 133, "2.6.0"},
+		{"uselib", 134, "2.6.0"},
+		{"personality", 135, "2.6.0"},
+		{"ustat", 136, "2.6.0"},
+		{"statfs", 137, "2.6.0"},
+		{"fstatfs", 138, "2.6.0"},
+		{"sysfs", 139, "2.6.0"},
+		{"getpriority", 140, "2.6.0"},
+		{"setpriority", 141, "2.6.0"},
+		{"sched_setparam", 142, "2.6.0"},
+		{"sched_getparam", 143, "2.6.0"},
+		{"sched_setscheduler", 144, "2.6.0"},
+		{"sched_getscheduler", 145, "2.6.0"},
+		{"sched_get_priority_max", 146, "2.6.0"},
+		{"sched_get_priority_min", 147, "2.6.0"},
+		{"sched_rr_get_interval", 148, "2.6.0"},
+		{"mlock", 149, "2.6.0"},
+		{"munlock", 150, "2.6.0"},
+		{"mlockall", 151, "2.6.0"},
+		{"munlockall", 152, "2.6.0"},
+		{"vhangup", 153, "2.6.0"},
+		{"modify_ldt", 154, "2.6.0"},
+		{"pivot_root", 155, "2.6.0"},
+		{"_sysctl", 156, "2.6.0"},
+		{"prctl", 157, "2.6.0"},
+		{"arch_prctl", 158, "2.6.0"},
+		{"adjtimex", 159, "2.6.0"},
+		{"setrlimit", 160, "2.6.0"},
+		{"chroot", 161, "2.6.0"},
+		{"sync", 162, "2.6.0"},
+		{"acct", 163, "2.6.0"},
+		{"settimeofday", 164, "2.6.0"},
+		{"mount", 165, "2.6.0"},
+		{"umount2", 166, "2.6.0"},
+		{"swapon", 167, "2.6.0"},
+		{"swapoff", 168, "2.6.0"},
+		{"reboot", 169, "2.6.0"},
+		{"sethostname", 170, "2.6.0"},
+		{"setdomainname", 171, "2.6.0"},
+		{"iopl", 172, "2.6.0"},
+		{"ioperm", 173, "2.6.0"},
+		{"create_module", 174, "2.6.0"},
+		{"init_module", 175, "2.6.0"},
+		{"delete_module", 176, "2.6.0"},
+		{"get_kernel_syms", 177, "2.6.0"},
+		{"query_module", 178, "2.6.0"},
+		{"quotactl", 179, "2.6.0"},
+		{"nfsservctl", 180, "2.6.0"},
+		{"getpmsg", 181, "2.6.0"},
+		{"putpmsg", 182, "2.6.0"},
+		{"afs_syscall", 183, "2.6.0"},
+		{"tuxcall", 184, "2.6.0"},
+		{"security", 185, "2.6.0"},
+		{"gettid", 186, "2.6.0"},
+		{"readahead", 187, "2.6.0"},
+		{"setxattr", 188, "2.6.0"},
+		{"lsetxattr", 189, "2.6.0"},
+		{"fsetxattr", 190, "2.6.0"},
+		{"getxattr", 191, "2.6.0"},
+		{"lgetxattr", 192, "2.6.0"},
+		{"fgetxattr", 193, "2.6.0"},
+		{"listxattr", 194, "2.6.0"},
+		{"llistxattr", 195, "2.6.0"},
+		{"flistxattr", 196, "2.6.0"},
+		{"removexattr", 197, "2.6.0"},
+		{"lremovexattr", 198, "2.6.0"},
+		{"fremovexattr", 199, "2.6.0"},
+		{"tkill", 200, "2.6.0"},
+		{"time", 201, "2.6.0"},
+		{"futex", 202, "2.6.0"},
+		{"sched_setaffinity", 203, "2.6.0"},
+		{"sched_getaffinity", 204, "2.6.0"},
+		{"set_thread_area", 205, "2.6.0"},
+		{"io_setup", 206, "2.6.0"},
+		{"io_destroy", 207, "2.6.0"},
+		{"io_getevents", 208, "2.6.0"},
+		{"io_submit", 209, "2.6.0"},
+		{"io_cancel", 210, "2.6.0"},
+		{"get_thread_area", 211, "2.6.0"},
+		{"lookup_dcookie", 212, "2.6.0"},
+		{"epoll_create", 213, "2.6.0"},
+		{"epoll_ctl_old", 214, "2.6.0"},
+		{"epoll_wait_old", 215, "2.6.0"},
+		{"remap_file_pages", 216, "2.6.0"},
+		{"getdents64", 217, "2.6.0"},
+		{"set_tid_address", 218, "2.6.0"},
+		{"restart_syscall", 219, "2.6.0"},
+		{"semtimedop", 220, "2.6.0"},
+		{"fadvise64", 221, "2.6.0"},
+		{"timer_create", 222, "2.6.0"},
+		{"timer_settime", 223, "2.6.0"},
+		{"timer_gettime", 224, "2.6.0"},
+		{"timer_getoverrun", 225, "2.6.0"},
+		{"timer_delete", 226, "2.6.0"},
+		{"clock_settime", 227, "2.6.0"},
+		{"clock_gettime", 228, "2.6.0"},
+		{"clock_getres", 229, "2.6.0"},
+		{"clock_nanosleep", 230, "2.6.0"},
+		{"exit_group", 231, "2.6.0"},
+		{"epoll_wait", 232, "2.6.0"},
+		{"epoll_ctl", 233, "2.6.0"},
+		{"tgkill", 234, "2.6.0"},
+		{"utimes", 235, "2.6.0"},
+		{"vserver", 236, "2.6.0"},
+		{"mbind", 237, "2.6.7"},
+		{"set_mempolicy", 238, "2.6.7"},
+		{"get_mempolicy", 239, "2.6.7"},
+		{"mq_open", 240, "2.6.6"},
+		{"mq_unlink", 241, "2.6.6"},
+		{"mq_timedsend", 242, "2.6.6"},
+		{"mq_timedreceive", 243, "2.6.6"},
+		{"mq_notify", 244, "2.6.6"},
+		{"mq_getsetattr", 245, "2.6.6"},
+		{"kexec_load", 246, "2.6.13"},
+		{"waitid", 247, "2.6.9"},
+		{"add_key", 248, "2.6.10"},
+		{"request_key", 249, "2.6.10"},
+		{"keyctl", 250, "2.6.10"},
+		{"ioprio_set", 251, "2.6.13"},
+		{"ioprio_get", 252, "2.6.13"},
+		{"inotify_init", 253, "2.6.13"},
+		{"inotify_add_watch", 254, "2.6.13"},
+		{"inotify_rm_watch", 255, "2.6.13"},
+		{"migrate_pages", 256, "2.6.16"},
+		{"openat", 257, "2.6.16"},
+		{"mkdirat", 258, "2.6.16"},
+		{"mknodat", 259, "2.6.16"},
+		{"fchownat", 260, "2.6.16"},
+		{"futimesat", 261, "2.6.16"},
+		{"newfstatat", 262, "2.6.16"},
+		{"unlinkat", 263, "2.6.16"},
+		{"renameat", 264, "2.6.16"},
+		{"linkat", 265, "2.6.16"},
+		{"symlinkat", 266, "2.6.16"},
+		{"readlinkat", 267, "2.6.16"},
+		{"fchmodat", 268, "2.6.16"},
+		{"faccessat", 269, "2.6.16"},
+		{"pselect6", 270, "2.6.16"},
+		{"ppoll", 271, "2.6.16"},
+		{"unshare", 272, "2.6.16"},
+		{"set_robust_list", 273, "2.6.17"},
+		{"get_robust_list", 274, "2.6.17"},
+		{"splice", 275, "2.6.17"},
+		{"tee", 276, "2.6.17"},
+		{"sync_file_range", 277, "2.6.17"},
+		{"vmsplice", 278, "2.6.17"},
+		{"move_pages", 279, "2.6.18"},
+		{"utimensat", 280, "2.6.22"},
+		{"epoll_pwait", 281, "2.6.19"},
+		{"signalfd", 282, "2.6.22"},
+		{"timerfd_create", 283, "2.6.25"},
+		{"eventfd", 284, "2.6.22"},
+		{"fallocate", 285, "2.6.23"},
+		{"timerfd_settime", 286, "2.6.25"},
+		{"timerfd_gettime", 287, "2.6.25"},
+		{"accept4", 288, "2.6.28"},
+		{"signalfd4", 289, "2.6.27"},
+		{"eventfd2", 290, "2.6.27"},
+		{"epoll_create1", 291, "2.6.27"},
+		{"dup3", 292, "2.6.27"},
+		{"pipe2", 293, "2.6.27"},
+		{"inotify_init1", 294, "2.6.27"},
+		{"preadv", 295, "2.6.30"},
+		{"pwritev", 296, "2.6.30"},
+		{"rt_tgsigqueueinfo", 297, "2.6.31"},
+		{"perf_event_open", 298, "2.6.31"},
+		{"recvmmsg", 299, "2.6.33"},
+		{"fanotify_init", 300, "2.6.36"},
+		{"fanotify_mark", 301, "2.6.36"},
+		{"prlimit64", 302, "2.6.36"},
+		{"name_to_handle_at", 303, "2.6.39"},
+		{"open_by_handle_at", 304, "2.6.39"},
+		{"clock_adjtime", 305, "2.6.39"},
+		{"syncfs", 306, "2.6.39"},
+		{"sendmmsg", 307, "3.0"},
+		{"setns", 308, "3.0"},
+		{"getcpu", 309, "2.6.19"},
+		{"process_vm_readv", 310, "3.2"},
+		{"process_vm_writev", 311, "3.2"},
+		{"kcmp", 312, "3.5"},
+		{"finit_module", 313, "3.8"},
+		{"sched_setattr", 314, "3.14"},
+		{"sched_getattr", 315, "3.14"},
+		{"renameat2", 316, "3.15"},
+		{"seccomp", 317, "3.17"},
+		{"getrandom", 318, "3.17"},
+		{"memfd_create", 319, "3.17"},
+		{"kexec_file_load", 320, "3.17"},
+		{"bpf", 321, "3.18"},
+		{"execveat", 322, "3.19"},
+		{"userfaultfd", 323, "4.3"},
+		{"membarrier", 324, "4.3"},
+		{"mlock2", 325, "4.4"},
+		{"copy_file_range", 326, "4.5"},
+		{"preadv2", 327, "4.6"},
+		{"pwritev2", 328, "4.6"},
+		{"pkey_mprotect", 329, "4.9"},
+		{"pkey_alloc", 330, "4.9"},
+		{"pkey_free", 331, "4.9"},
+		{"statx", 332, "4.11"},
+		{"io_pgetevents", 333, "4.18"},
+		{"rseq", 334, "4.18"},
+		{"pidfd_send_signal", 424, "5.1"},
+		{"io_uring_setup", 425, "5.1"},
+		{"io_uring_enter", 426, "5.1"},
+		{"io_uring_register", 427, "5.1"},
+		{"open_tree", 428, "5.2"},
+		{"move_mount", 429, "5.2"},
+		{"fsopen", 430, "5.2"},
+		{"fsconfig", 431, "5.2"},
+		{"fsmount", 432, "5.2"},
+		{"fspick", 433, "5.2"},
+		{"pidfd_open", 434, "5.3"},
+		{"clone3", 435, "5.3"},
+		{"close_range", 436, "5.9"},
+		{"openat2", 437, "5.6"},
+		{"pidfd_getfd", 438, "5.6"},
+		{"faccessat2", 439, "5.8"},
+		{"process_madvise", 440, "5.10"},
+		{"epoll_pwait2", 441, "5.11"},
+		{"mount_setattr", 442, "5.12"},
+		{"quotactl_fd", 443, "5.14"},
+		{"landlock_create_ruleset", 444, "5.13"},
+		{"landlock_add_rule", 445, "5.13"},
+		{"landlock_restrict_self", 446, "5.13"},
+		{"memfd_secret", 447, "5.14"},
+		{"process_mrelease", 448, "5.15"},
+		{"futex_waitv", 449, "5.16"},
+		{"set_mempolicy_home_node", 450, "5.17"},
+	},
+	Errors: []Constant{
+		{"E2BIG", 7},
+		{"EACCES", 13},
+		{"EADDRINUSE", 98},
+		{"EADDRNOTAVAIL", 99},
+		{"EADV", 68},
+		{"EAFNOSUPPORT", 97},
+		{"EAGAIN", 11},
+		{"EALREADY", 114},
+		{"EBADE", 52},
+		{"EBADF", 9},
+		{"EBADFD", 77},
+		{"EBADMSG", 74},
+		{"EBADR", 53},
+		{"EBADRQC", 56},
+		{"EBADSLT", 57},
+		{"EBFONT", 59},
+		{"EBUSY", 16},
+		{"ECANCELED", 125},
+		{"ECHILD", 10},
+		{"ECHRNG", 44},
+		{"ECOMM", 70},
+		{"ECONNABORTED", 103},
+		{"ECONNREFUSED", 111},
+		{"ECONNRESET", 104},
+		{"EDEADLK", 35},
+		{"EDEADLOCK", 35},
+		{"EDESTADDRREQ", 89},
+		{"EDOM", 33},
+		{"EDOTDOT", 73},
+		{"EDQUOT", 122},
+		{"EEXIST", 17},
+		{"EFAULT", 14},
+		{"EFBIG", 27},
+		{"EHOSTDOWN", 112},
+		{"EHOSTUNREACH", 113},
+		{"EHWPOISON", 133},
+		{"EIDRM", 43},
+		{"EILSEQ", 84},
+		{"EINPROGRESS", 115},
+		{"EINTR", 4},
+		{"EINVAL", 22},
+		{"EIO", 5},
+		{"EISCONN", 106},
+		{"EISDIR", 21},
+		{"EISNAM", 120},
+		{"EKEYEXPIRED", 127},
+		{"EKEYREJECTED", 129},
+		{"EKEYREVOKED", 128},
+		{"EL2HLT", 51},
+		{"EL2NSYNC", 45},
+		{"EL3HLT", 46},
+		{"EL3RST", 47},
+		{"ELIBACC", 79},
+		{"ELIBBAD", 80},
+		{"ELIBEXEC", 83},
+		{"ELIBMAX", 82},
+		{"ELIBSCN", 81},
+		{"ELNRNG", 48},
+		{"ELOOP", 40},
+		{"EMEDIUMTYPE", 124},
+		{"EMFILE", 24},
+		{"EMLINK", 31},
+		{"EMSGSIZE", 90},
+		{"EMULTIHOP", 72},
+		{"ENAMETOOLONG", 36},
+		{"ENAVAIL", 119},
+		{"ENETDOWN", 100},
+		{"ENETRESET", 102},
+		{"ENETUNREACH", 101},
+		{"ENFILE", 23},
+		{"ENOANO", 55},
+		{"ENOBUFS", 105},
+		{"ENOCSI", 50},
+		{"ENODATA", 61},
+		{"ENODEV", 19},
+		{"ENOENT", 2},
+		{"ENOEXEC", 8},
+		{"ENOKEY", 126},
+		{"ENOLCK", 37},
+		{"ENOLINK", 67},
+		{"ENOMEDIUM", 123},
+		{"ENOMEM", 12},
+		{"ENOMSG", 42},
+		{"ENONET", 64},
+		{"ENOPKG", 65},
+		{"ENOPROTOOPT", 92},
+		{"ENOSPC", 28},
+		{"ENOSR", 63},
+		{"ENOSTR", 60},
+		{"ENOSYS", 38},
+		{"ENOTBLK", 15},
+		{"ENOTCONN", 107},
+		{"ENOTDIR", 20},
+		{"ENOTEMPTY", 39},
+		{"ENOTNAM", 118},
+		{"ENOTRECOVERABLE", 131},
+		{"ENOTSOCK", 88},
+		{"ENOTSUP", 95},
+		{"ENOTTY", 25},
+		{"ENOTUNIQ", 76},
+		{"ENXIO", 6},
+		{"EOPNOTSUPP", 95},
+		{"EOVERFLOW", 75},
+		{"EOWNERDEAD", 130},
+		{"EPERM", 1},
+		{"EPFNOSUPPORT", 96},
+		{"EPIPE", 32},
+		{"EPROTO", 71},
+		{"EPROTONOSUPPORT", 93},
+		{"EPROTOTYPE", 91},
+		{"ERANGE", 34},
+		{"EREMCHG", 78},
+		{"EREMOTE", 66},
+		{"EREMOTEIO", 121},
+		{"ERESTART", 85},
+		{"ERFKILL", 132},
+		{"EROFS", 30},
+		{"ESHUTDOWN", 108},
+		{"ESOCKTNOSUPPORT", 94},
+		{"ESPIPE", 29},
+		{"ESRCH", 3},
+		{"ESRMNT", 69},
+		{"ESTALE", 116},
+		{"ESTRPIPE", 86},
+		{"ETIME", 62},
+		{"ETIMEDOUT", 110},
+		{"ETOOMANYREFS", 109},
+		{"ETXTBSY", 26},
+		{"EUCLEAN", 117},
+		{"EUNATCH", 49},
+		{"EUSERS", 87},
+		{"EWOULDBLOCK", 11},
+		{"EXDEV", 18},
+		{"EXFULL", 54},
+	},
+	Constants: []Constant{
+		{"AF_ALG", 38},
+		{"AF_APPLETALK", 5},
+		{"AF_ASH", 18},
+		{"AF_ATMPVC", 8},
+		{"AF_ATMSVC", 20},
+		{"AF_AX25", 3},
+		{"AF_BLUETOOTH", 31},
+		{"AF_BRIDGE", 7},
+		{"AF_CAIF", 37},
+		{"AF_CAN", 29},
+		{"AF_DECnet", 12},
+		{"AF_ECONET", 19},
+		{"AF_FILE", 1},
+		{"AF_IB", 27},
+		{"AF_IEEE802154", 36},
+		{"AF_INET", 2},
+		{"AF_INET6", 10},
+		{"AF_IPX", 4},
+		{"AF_IRDA", 23},
+		{"AF_ISDN", 34},
+		{"AF_IUCV", 32},
+		{"AF_KCM", 41},
+		{"AF_KEY", 15},
+		{"AF_LLC", 26},
+		{"AF_LOCAL", 1},
+		{"AF_MAX", 46},
+		{"AF_MCTP", 45},
+		{"AF_MPLS", 28},
+		{"AF_NETBEUI", 13},
+		{"AF_NETLINK", 16},
+		{"AF_NETROM", 6},
+		{"AF_NFC", 39},
+		{"AF_PACKET", 17},
+		{"AF_PHONET", 35},
+		{"AF_PPPOX", 24},
+		{"AF_QIPCRTR", 42},
+		{"AF_RDS", 21},
+		{"AF_ROSE", 11},
+		{"AF_ROUTE", 16},
+		{"AF_RXRPC", 33},
+		{"AF_SECURITY", 14},
+		{"AF_SMC", 43},
+		{"AF_SNA", 22},
+		{"AF_TIPC", 30},
+		{"AF_UNIX", 1},
+		{"AF_UNSPEC", 0},
+		{"AF_VSOCK", 40},
+		{"AF_WANPIPE", 25},
+		{"AF_X25", 9},
+		{"AF_XDP", 44},
+		{"ARCH_GET_CPUID", 4113},
+		{"ARCH_GET_FS", 4099},
+		{"ARCH_GET_GS", 4100},
+		{"ARCH_GET_XCOMP_GUEST_PERM", 4132},
+		{"ARCH_GET_XCOMP_PERM", 4130},
+		{"ARCH_GET_XCOMP_SUPP", 4129},
+		{"ARCH_MAP_VDSO_32", 8194},
+		{"ARCH_MAP_VDSO_64", 8195},
+		{"ARCH_MAP_VDSO_X32", 8193},
+		{"ARCH_REQ_XCOMP_GUEST_PERM", 4133},
+		{"ARCH_REQ_XCOMP_PERM", 4131},
+		{"ARCH_SET_CPUID", 4114},
+		{"ARCH_SET_FS", 4098},
+		{"ARCH_SET_GS", 4097},
+		{"ARPHRD_6LOWPAN", 825},
+		{"ARPHRD_ADAPT", 264},
+		{"ARPHRD_APPLETLK", 8},
+		{"ARPHRD_ARCNET", 7},
+		{"ARPHRD_ASH", 781},
+		{"ARPHRD_ATM", 19},
+		{"ARPHRD_AX25", 3},
+		{"ARPHRD_BIF", 775},
+		{"ARPHRD_CAIF", 822},
+		{"ARPHRD_CAN", 280},
+		{"ARPHRD_CHAOS", 5},
+		{"ARPHRD_CISCO", 513},
+		{"ARPHRD_CSLIP", 257},
+		{"ARPHRD_CSLIP6", 259},
+		{"ARPHRD_DDCMP", 517},
+		{"ARPHRD_DLCI", 15},
+		{"ARPHRD_ECONET", 782},
+		{"ARPHRD_EETHER", 2},
+		{"ARPHRD_ETHER", 1},
+		{"ARPHRD_EUI64", 27},
+		{"ARPHRD_FCAL", 785},
+		{"ARPHRD_FCFABRIC", 787},
+		{"ARPHRD_FCPL", 786},
+		{"ARPHRD_FCPP", 784},
+		{"ARPHRD_FDDI", 774},
+		{"ARPHRD_FRAD", 770},
+		{"ARPHRD_HDLC", 513},
+		{"ARPHRD_HIPPI", 780},
+		{"ARPHRD_HWX25", 272},
+		{"ARPHRD_IEEE1394", 24},
+		{"ARPHRD_IEEE802", 6},
+		{"ARPHRD_IEEE80211", 801},
+		{"ARPHRD_IEEE80211_PRISM", 802},
+		{"ARPHRD_IEEE80211_RADIOTAP", 803},
+		{"ARPHRD_IEEE802154", 804},
+		{"ARPHRD_IEEE802154_MONITOR", 805},
+		{"ARPHRD_IEEE802154_PHY", 805},
+		{"ARPHRD_IEEE802_TR", 800},
+		{"ARPHRD_INFINIBAND", 32},
+		{"ARPHRD_IP6GRE", 823},
+		{"ARPHRD_IPDDP", 777},
+		{"ARPHRD_IPGRE", 778},
+		{"ARPHRD_IRDA", 783},
+		{"ARPHRD_LAPB", 516},
+		{"ARPHRD_LOCALTLK", 773},
+		{"ARPHRD_LOOPBACK", 772},
+		{"ARPHRD_MCTP", 290},
+		{"ARPHRD_METRICOM", 23},
+		{"ARPHRD_NETLINK", 824},
+		{"ARPHRD_NETROM", 0},
+		{"ARPHRD_NONE", 65534},
+		{"ARPHRD_PHONET", 820},
+		{"ARPHRD_PHONET_PIPE", 821},
+		{"ARPHRD_PIMREG", 779},
+		{"ARPHRD_PPP", 512},
+		{"ARPHRD_PRONET", 4},
+		{"ARPHRD_RAWHDLC", 518},
+		{"ARPHRD_RAWIP", 519},
+		{"ARPHRD_ROSE", 270},
+		{"ARPHRD_RSRVD", 260},
+		{"ARPHRD_SIT", 776},
+		{"ARPHRD_SKIP", 771},
+		{"ARPHRD_SLIP", 256},
+		{"ARPHRD_SLIP6", 258},
+		{"ARPHRD_TUNNEL", 768},
+		{"ARPHRD_TUNNEL6", 769},
+		{"ARPHRD_VOID", 65535},
+		{"ARPHRD_VSOCKMON", 826},
+		{"ARPHRD_X25", 271},
+		{"AT_EACCESS", 512},
+		{"AT_EMPTY_PATH", 4096},
+		{"AT_FDCWD", -0x64},
+		{"AT_NO_AUTOMOUNT", 2048},
+		{"AT_RECURSIVE", 32768},
+		{"AT_REMOVEDIR", 512},
+		{"AT_STATX_DONT_SYNC", 16384},
+		{"AT_STATX_FORCE_SYNC", 8192},
+		{"AT_STATX_SYNC_AS_STAT", 0},
+		{"AT_STATX_SYNC_TYPE", 24576},
+		{"AT_SYMLINK_FOLLOW", 1024},
+		{"AT_SYMLINK_NOFOLLOW", 256},
+		{"BPF_A", 16},
+		{"BPF_ABS", 32},
+		{"BPF_ADD", 0},
+		{"BPF_ALU", 4},
+		{"BPF_AND", 80},
+		{"BPF_B", 16},
+		{"BPF_DIV", 48},
+		{"BPF_H", 8},
+		{"BPF_IMM", 0},
+		{"BPF_IND", 64},
+		{"BPF_JA", 0},
+		{"BPF_JEQ", 16},
+		{"BPF_JGE", 48},
+		{"BPF_JGT", 32},
+		{"BPF_JMP", 5},
+		{"BPF_JSET", 64},
+		{"BPF_K", 0},
+		{"BPF_LD", 0},
+		{"BPF_LDX", 1},
+		{"BPF_LEN", 128},
+		{"BPF_LL_OFF", -0x200000},
+		{"BPF_LSH", 96},
+		{"BPF_MAJOR_VERSION", 1},
+		{"BPF_MAXINSNS", 4096},
+		{"BPF_MEM", 96},
+		{"BPF_MEMWORDS", 16},
+		{"BPF_MINOR_VERSION", 1},
+		{"BPF_MISC", 7},
+		{"BPF_MOD", 144},
+		{"BPF_MSH", 160},
+		{"BPF_MUL", 32},
+		{"BPF_NEG", 128},
+		{"BPF_NET_OFF", -0x100000},
+		{"BPF_OR", 64},
+		{"BPF_RET", 6},
+		{"BPF_RSH", 112},
+		{"BPF_ST", 2},
+		{"BPF_STX", 3},
+		{"BPF_SUB", 16},
+		{"BPF_TAX", 0},
+		{"BPF_TXA", 128},
+		{"BPF_W", 0},
+		{"BPF_X", 8},
+		{"BPF_XOR", 160},
+		{"CAP_AUDIT_CONTROL", 30},
+		{"CAP_AUDIT_READ", 37},
+		{"CAP_AUDIT_WRITE", 29},
+		{"CAP_BLOCK_SUSPEND", 36},
+		{"CAP_BPF", 39},
+		{"CAP_CHECKPOINT_RESTORE", 40},
+		{"CAP_CHOWN", 0},
+		{"CAP_DAC_OVERRIDE", 1},
+		{"CAP_DAC_READ_SEARCH", 2},
+		{"CAP_FOWNER", 3},
+		{"CAP_FSETID", 4},
+		{"CAP_IPC_LOCK", 14},
+		{"CAP_IPC_OWNER", 15},
+		{"CAP_KILL", 5},
+		{"CAP_LAST_CAP", 40},
+		{"CAP_LEASE", 28},
+		{"CAP_LINUX_IMMUTABLE", 9},
+		{"CAP_MAC_ADMIN", 33},
+		{"CAP_MAC_OVERRIDE", 32},
+		{"CAP_MKNOD", 27},
+		{"CAP_NET_ADMIN", 12},
+		{"CAP_NET_BIND_SERVICE", 10},
+		{"CAP_NET_BROADCAST", 11},
+		{"CAP_NET_RAW", 13},
+		{"CAP_PERFMON", 38},
+		{"CAP_SETFCAP", 31},
+		{"CAP_SETGID", 6},
+		{"CAP_SETPCAP", 8},
+		{"CAP_SETUID", 7},
+		{"CAP_SYSLOG", 34},
+		{"CAP_SYS_ADMIN", 21},
+		{"CAP_SYS_BOOT", 22},
+		{"CAP_SYS_CHROOT", 18},
+		{"CAP_SYS_MODULE", 16},
+		{"CAP_SYS_NICE", 23},
+		{"CAP_SYS_PACCT", 20},
+		{"CAP_SYS_PTRACE", 19},
+		{"CAP_SYS_RAWIO", 17},
+		{"CAP_SYS_RESOURCE", 24},
+		{"CAP_SYS_TIME", 25},
+		{"CAP_SYS_TTY_CONFIG", 26},
+		{"CAP_WAKE_ALARM", 35},
+		{"CLONE_ARGS_SIZE_VER0", 64},
+		{"CLONE_ARGS_SIZE_VER1", 80},
+		{"CLONE_ARGS_SIZE_VER2", 88},
+		{"CLONE_CHILD_CLEARTID", 0x200000},
+		{"CLONE_CHILD_SETTID", 0x1000000},
+		{"CLONE_CLEAR_SIGHAND", 0x100000000},
+		{"CLONE_DETACHED", 0x400000},
+		{"CLONE_FILES", 1024},
+		{"CLONE_FS", 512},
+		{"CLONE_INTO_CGROUP", 0x200000000},
+		{"CLONE_IO", 0x80000000},
+		{"CLONE_NEWCGROUP", 0x2000000},
+		{"CLONE_NEWIPC", 0x8000000},
+		{"CLONE_NEWNET", 0x40000000},
+		{"CLONE_NEWNS", 0x20000},
+		{"CLONE_NEWPID", 0x20000000},
+		{"CLONE_NEWTIME", 128},
+		{"CLONE_NEWUSER", 0x10000000},
+		{"CLONE_NEWUTS", 0x4000000},
+		{"CLONE_PARENT", 32768},
+		{"CLONE_PARENT_SETTID", 0x100000},
+		{"CLONE_PIDFD", 4096},
+		{"CLONE_PTRACE", 8192},
+		{"CLONE_SETTLS", 0x80000},
+		{"CLONE_SIGHAND", 2048},
+		{"CLONE_SYSVSEM", 0x40000},
+		{"CLONE_THREAD", 0x10000},
+		{"CLONE_UNTRACED", 0x800000},
+		{"CLONE_VFORK", 16384},
+		{"CLONE_VM", 256},
+		{"CLOSE_RANGE_CLOEXEC", 4},
+		{"CLOSE_RANGE_UNSHARE", 2},
+		{"DN_ACCESS", 1},
+		{"DN_ATTRIB", 32},
+		{"DN_CREATE", 4},
+		{"DN_DELETE", 8},
+		{"DN_MODIFY", 2},
+		{"DN_MULTISHOT", 0x80000000},
+		{"DN_RENAME", 16},
+		{"DT_BLK", 6},
+		{"DT_CHR", 2},
+		{"DT_DIR", 4},
+		{"DT_FIFO", 1},
+		{"DT_LNK", 10},
+		{"DT_REG", 8},
+		{"DT_SOCK", 12},
+		{"DT_UNKNOWN", 0},
+		{"DT_WHT", 14},
+		{"EPOLLERR", 8},
+		{"EPOLLET", 0x80000000},
+		{"EPOLLEXCLUSIVE", 0x10000000},
+		{"EPOLLHUP", 16},
+		{"EPOLLIN", 1},
+		{"EPOLLMSG", 1024},
+		{"EPOLLNVAL", 32},
+		{"EPOLLONESHOT", 0x40000000},
+		{"EPOLLOUT", 4},
+		{"EPOLLPRI", 2},
+		{"EPOLLRDBAND", 128},
+		{"EPOLLRDHUP", 8192},
+		{"EPOLLRDNORM", 64},
+		{"EPOLLWAKEUP", 0x20000000},
+		{"EPOLLWRBAND", 512},
+		{"EPOLLWRNORM", 256},
+		{"EPOLL_CLOEXEC", 0x80000},
+		{"EPOLL_CTL_ADD", 1},
+		{"EPOLL_CTL_DEL", 2},
+		{"EPOLL_CTL_MOD", 3},
+		{"EPOLL_NONBLOCK", 2048},
+		{"EPOLL_URING_WAKE", 0x8000000},
+		{"ETH_P_1588", 35063},
+		{"ETH_P_8021AD", 34984},
+		{"ETH_P_8021AH", 35047},
+		{"ETH_P_8021Q", 33024},
+		{"ETH_P_80221", 35095},
+		{"ETH_P_802_2", 4},
+		{"ETH_P_802_3", 1},
+		{"ETH_P_802_3_MIN", 1536},
+		{"ETH_P_802_EX1", 34997},
+		{"ETH_P_AARP", 33011},
+		{"ETH_P_AF_IUCV", 64507},
+		{"ETH_P_ALL", 3},
+		{"ETH_P_AOE", 34978},
+		{"ETH_P_ARCNET", 26},
+		{"ETH_P_ARP", 2054},
+		{"ETH_P_ATALK", 32923},
+		{"ETH_P_ATMFATE", 34948},
+		{"ETH_P_ATMMPOA", 34892},
+		{"ETH_P_AX25", 2},
+		{"ETH_P_BATMAN", 17157},
+		{"ETH_P_BPQ", 2303},
+		{"ETH_P_CAIF", 247},
+		{"ETH_P_CAN", 12},
+		{"ETH_P_CANFD", 13},
+		{"ETH_P_CANXL", 14},
+		{"ETH_P_CFM", 35074},
+		{"ETH_P_CONTROL", 22},
+		{"ETH_P_CUST", 24582},
+		{"ETH_P_DDCMP", 6},
+		{"ETH_P_DEC", 24576},
+		{"ETH_P_DIAG", 24581},
+		{"ETH_P_DNA_DL", 24577},
+		{"ETH_P_DNA_RC", 24578},
+		{"ETH_P_DNA_RT", 24579},
+		{"ETH_P_DSA", 27},
+		{"ETH_P_DSA_8021Q", 56027},
+		{"ETH_P_DSA_A5PSW", 57345},
+		{"ETH_P_ECONET", 24},
+		{"ETH_P_EDSA", 56026},
+		{"ETH_P_ERSPAN", 35006},
+		{"ETH_P_ERSPAN2", 8939},
+		{"ETH_P_ETHERCAT", 34980},
+		{"ETH_P_FCOE", 35078},
+		{"ETH_P_FIP", 35092},
+		{"ETH_P_HDLC", 25},
+		{"ETH_P_HSR", 35119},
+		{"ETH_P_IBOE", 35093},
+		{"ETH_P_IEEE802154", 246},
+		{"ETH_P_IEEEPUP", 2560},
+		{"ETH_P_IEEEPUPAT", 2561},
+		{"ETH_P_IFE", 60734},
+		{"ETH_P_IP", 2048},
+		{"ETH_P_IPV6", 34525},
+		{"ETH_P_IPX", 33079},
+		{"ETH_P_IRDA", 23},
+		{"ETH_P_LAT", 24580},
+		{"ETH_P_LINK_CTL", 34924},
+		{"ETH_P_LLDP", 35020},
+		{"ETH_P_LOCALTALK", 9},
+		{"ETH_P_LOOP", 96},
+		{"ETH_P_LOOPBACK", 36864},
+		{"ETH_P_MACSEC", 35045},
+		{"ETH_P_MAP", 249},
+		{"ETH_P_MCTP", 250},
+		{"ETH_P_MOBITEX", 21},
+		{"ETH_P_MPLS_MC", 34888},
+		{"ETH_P_MPLS_UC", 34887},
+		{"ETH_P_MRP", 35043},
+		{"ETH_P_MVRP", 35061},
+		{"ETH_P_NCSI", 35064},
+		{"ETH_P_NSH", 35151},
+		{"ETH_P_PAE", 34958},
+		{"ETH_P_PAUSE", 34824},
+		{"ETH_P_PHONET", 245},
+		{"ETH_P_PPPTALK", 16},
+		{"ETH_P_PPP_DISC", 34915},
+		{"ETH_P_PPP_MP", 8},
+		{"ETH_P_PPP_SES", 34916},
+		{"ETH_P_PREAUTH", 35015},
+		{"ETH_P_PROFINET", 34962},
+		{"ETH_P_PRP", 35067},
+		{"ETH_P_PUP", 512},
+		{"ETH_P_PUPAT", 513},
+		{"ETH_P_QINQ1", 37120},
+		{"ETH_P_QINQ2", 37376},
+		{"ETH_P_QINQ3", 37632},
+		{"ETH_P_RARP", 32821},
+		{"ETH_P_REALTEK", 34969},
+		{"ETH_P_SCA", 24583},
+		{"ETH_P_SLOW", 34825},
+		{"ETH_P_SNAP", 5},
+		{"ETH_P_TDLS", 35085},
+		{"ETH_P_TEB", 25944},
+		{"ETH_P_TIPC", 35018},
+		{"ETH_P_TRAILER", 28},
+		{"ETH_P_TR_802_2", 17},
+		{"ETH_P_TSN", 8944},
+		{"ETH_P_WAN_PPP", 7},
+		{"ETH_P_WCCP", 34878},
+		{"ETH_P_X25", 2053},
+		{"ETH_P_XDSA", 248},
+		{"FD_CLOEXEC", 1},
+		{"FD_SETSIZE", 1024},
+		{"FIOASYNC", 21586},
+		{"FIOCLEX", 21585},
+		{"FIONBIO", 21537},
+		{"FIONCLEX", 21584},
+		{"FIONREAD", 21531},
+		{"FIOQSIZE", 21600},
+		{"FSMOUNT_CLOEXEC", 1},
+		{"FSOPEN_CLOEXEC", 1},
+		{"FSPICK_CLOEXEC", 1},
+		{"FSPICK_EMPTY_PATH", 8},
+		{"FSPICK_NO_AUTOMOUNT", 4},
+		{"FSPICK_SYMLINK_NOFOLLOW", 2},
+		{"FUTEX_32", 2},
+		{"FUTEX_BITSET_MATCH_ANY", 0xFFFFFFFF},
+		{"FUTEX_CLOCK_REALTIME", 256},
+		{"FUTEX_CMD_MASK", -0x181},
+		{"FUTEX_CMP_REQUEUE", 4},
+		{"FUTEX_CMP_REQUEUE_PI", 12},
+		{"FUTEX_CMP_REQUEUE_PI_PRIVATE", 140},
+		{"FUTEX_CMP_REQUEUE_PRIVATE", 132},
+		{"FUTEX_FD", 2},
+		{"FUTEX_LOCK_PI", 6},
+		{"FUTEX_LOCK_PI2", 13},
+		{"FUTEX_LOCK_PI2_PRIVATE", 141},
+		{"FUTEX_LOCK_PI_PRIVATE", 134},
+		{"FUTEX_OP_ADD", 1},
+		{"FUTEX_OP_ANDN", 3},
+		{"FUTEX_OP_CMP_EQ", 0},
+		{"FUTEX_OP_CMP_GE", 5},
+		{"FUTEX_OP_CMP_GT", 4},
+		{"FUTEX_OP_CMP_LE", 3},
+		{"FUTEX_OP_CMP_LT", 2},
+		{"FUTEX_OP_CMP_NE", 1},
+		{"FUTEX_OP_OPARG_SHIFT", 8},
+		{"FUTEX_OP_OR", 2},
+		{"FUTEX_OP_SET", 0},
+		{"FUTEX_OP_XOR", 4},
+		{"FUTEX_OWNER_DIED", 0x40000000},
+		{"FUTEX_PRIVATE_FLAG", 128},
+		{"FUTEX_REQUEUE", 3},
+		{"FUTEX_REQUEUE_PRIVATE", 131},
+		{"FUTEX_TID_MASK", 0x3FFFFFFF},
+		{"FUTEX_TRYLOCK_PI", 8},
+		{"FUTEX_TRYLOCK_PI_PRIVATE", 136},
+		{"FUTEX_UNLOCK_PI", 7},
+		{"FUTEX_UNLOCK_PI_PRIVATE", 135},
+		{"FUTEX_WAIT", 0},
+		{"FUTEX_WAITERS", 0x80000000},
+		{"FUTEX_WAITV_MAX", 128},
+		{"FUTEX_WAIT_BITSET", 9},
+		{"FUTEX_WAIT_BITSET_PRIVATE", 137},
+		{"FUTEX_WAIT_PRIVATE", 128},
+		{"FUTEX_WAIT_REQUEUE_PI", 11},
+		{"FUTEX_WAIT_REQUEUE_PI_PRIVATE", 139},
+		{"FUTEX_WAKE", 1},
+		{"FUTEX_WAKE_BITSET", 10},
+		{"FUTEX_WAKE_BITSET_PRIVATE", 138},
+		{"FUTEX_WAKE_OP", 5},
+		{"FUTEX_WAKE_OP_PRIVATE", 133},
+		{"FUTEX_WAKE_PRIVATE", 129},
+		{"F_ADD_SEALS", 1033},
+		{"F_CANCELLK", 1029},
+		{"F_DUPFD", 0},
+		{"F_DUPFD_CLOEXEC", 1030},
+		{"F_EXLCK", 4},
+		{"F_GETFD", 1},
+		{"F_GETFL", 3},
+		{"F_GETLEASE", 1025},
+		{"F_GETLK", 5},
+		{"F_GETLK64", 5},
+		{"F_GETOWN", 9},
+		{"F_GETOWNER_UIDS", 17},
+		{"F_GETOWN_EX", 16},
+		{"F_GETPIPE_SZ", 1032},
+		{"F_GETSIG", 11},
+		{"F_GET_FILE_RW_HINT", 1037},
+		{"F_GET_RW_HINT", 1035},
+		{"F_GET_SEALS", 1034},
+		{"F_LINUX_SPECIFIC_BASE", 1024},
+		{"F_LOCK", 1},
+		{"F_NOTIFY", 1026},
+		{"F_OFD_GETLK", 36},
+		{"F_OFD_SETLK", 37},
+		{"F_OFD_SETLKW", 38},
+		{"F_OK", 0},
+		{"F_OWNER_PGRP", 2},
+		{"F_OWNER_PID", 1},
+		{"F_OWNER_TID", 0},
+		{"F_RDLCK", 0},
+		{"F_SEAL_FUTURE_WRITE", 16},
+		{"F_SEAL_GROW", 4},
+		{"F_SEAL_SEAL", 1},
+		{"F_SEAL_SHRINK", 2},
+		{"F_SEAL_WRITE", 8},
+		{"F_SETFD", 2},
+		{"F_SETFL", 4},
+		{"F_SETLEASE", 1024},
+		{"F_SETLK", 6},
+		{"F_SETLK64", 6},
+		{"F_SETLKW", 7},
+		{"F_SETLKW64", 7},
+		{"F_SETOWN", 8},
+		{"F_SETOWN_EX", 15},
+		{"F_SETPIPE_SZ", 1031},
+		{"F_SETSIG", 10},
+		{"F_SET_FILE_RW_HINT", 1038},
+		{"F_SET_RW_HINT", 1036},
+		{"F_SHLCK", 8},
+		{"F_TEST", 3},
+		{"F_TLOCK", 2},
+		{"F_ULOCK", 0},
+		{"F_UNLCK", 2},
+		{"F_WRLCK", 1},
+		{"GRND_INSECURE", 4},
+		{"GRND_NONBLOCK", 1},
+		{"GRND_RANDOM", 2},
+		{"ICMPV6_ADDR_UNREACH", 3},
+		{"ICMPV6_ADM_PROHIBITED", 1},
+		{"ICMPV6_DEST_UNREACH", 1},
+		{"ICMPV6_DHAAD_REPLY", 145},
+		{"ICMPV6_DHAAD_REQUEST", 144},
+		{"ICMPV6_ECHO_REPLY", 129},
+		{"ICMPV6_ECHO_REQUEST", 128},
+		{"ICMPV6_ERRMSG_MAX", 127},
+		{"ICMPV6_EXC_FRAGTIME", 1},
+		{"ICMPV6_EXC_HOPLIMIT", 0},
+		{"ICMPV6_EXT_ECHO_REPLY", 161},
+		{"ICMPV6_EXT_ECHO_REQUEST", 160},
+		{"ICMPV6_FILTER", 1},
+		{"ICMPV6_FILTER_BLOCK", 1},
+		{"ICMPV6_FILTER_BLOCKOTHERS", 3},
+		{"ICMPV6_FILTER_PASS", 2},
+		{"ICMPV6_FILTER_PASSONLY", 4},
+		{"ICMPV6_HDR_FIELD", 0},
+		{"ICMPV6_HDR_INCOMP", 3},
+		{"ICMPV6_INFOMSG_MASK", 128},
+		{"ICMPV6_MGM_QUERY", 130},
+		{"ICMPV6_MGM_REDUCTION", 132},
+		{"ICMPV6_MGM_REPORT", 131},
+		{"ICMPV6_MLD2_REPORT", 143},
+		{"ICMPV6_MOBILE_PREFIX_ADV", 147},
+		{"ICMPV6_MOBILE_PREFIX_SOL", 146},
+		{"ICMPV6_MRDISC_ADV", 151},
+		{"ICMPV6_MSG_MAX", 255},
+		{"ICMPV6_NI_QUERY", 139},
+		{"ICMPV6_NI_REPLY", 140},
+		{"ICMPV6_NOROUTE", 0},
+		{"ICMPV6_NOT_NEIGHBOUR", 2},
+		{"ICMPV6_PARAMPROB", 4},
+		{"ICMPV6_PKT_TOOBIG", 2},
+		{"ICMPV6_POLICY_FAIL", 5},
+		{"ICMPV6_PORT_UNREACH", 4},
+		{"ICMPV6_REJECT_ROUTE", 6},
+		{"ICMPV6_ROUTER_PREF_HIGH", 1},
+		{"ICMPV6_ROUTER_PREF_INVALID", 2},
+		{"ICMPV6_ROUTER_PREF_LOW", 3},
+		{"ICMPV6_ROUTER_PREF_MEDIUM", 0},
+		{"ICMPV6_TIME_EXCEED", 3},
+		{"ICMPV6_UNK_NEXTHDR", 1},
+		{"ICMPV6_UNK_OPTION", 2},
+		{"IFA_ADDRESS", 1},
+		{"IFA_ANYCAST", 5},
+		{"IFA_BROADCAST", 4},
+		{"IFA_CACHEINFO", 6},
+		{"IFA_FLAGS", 8},
+		{"IFA_F_DADFAILED", 8},
+		{"IFA_F_DEPRECATED", 32},
+		{"IFA_F_HOMEADDRESS", 16},
+		{"IFA_F_MANAGETEMPADDR", 256},
+		{"IFA_F_MCAUTOJOIN", 1024},
+		{"IFA_F_NODAD", 2},
+		{"IFA_F_NOPREFIXROUTE", 512},
+		{"IFA_F_OPTIMISTIC", 4},
+		{"IFA_F_PERMANENT", 128},
+		{"IFA_F_SECONDARY", 1},
+		{"IFA_F_STABLE_PRIVACY", 2048},
+		{"IFA_F_TEMPORARY", 1},
+		{"IFA_F_TENTATIVE", 64},
+		{"IFA_LABEL", 3},
+		{"IFA_LOCAL", 2},
+		{"IFA_MAX", 11},
+		{"IFA_MULTICAST", 7},
+		{"IFA_PROTO", 11},
+		{"IFA_RT_PRIORITY", 9},
+		{"IFA_TARGET_NETNSID", 10},
+		{"IFA_UNSPEC", 0},
+		{"IFF_ALLMULTI", 512},
+		{"IFF_ATTACH_QUEUE", 512},
+		{"IFF_AUTOMEDIA", 16384},
+		{"IFF_BROADCAST", 2},
+		{"IFF_DEBUG", 4},
+		{"IFF_DETACH_QUEUE", 1024},
+		{"IFF_DORMANT", 0x20000},
+		{"IFF_DYNAMIC", 32768},
+		{"IFF_ECHO", 0x40000},
+		{"IFF_LOOPBACK", 8},
+		{"IFF_LOWER_UP", 0x10000},
+		{"IFF_MASTER", 1024},
+		{"IFF_MULTICAST", 4096},
+		{"IFF_MULTI_QUEUE", 256},
+		{"IFF_NAPI", 16},
+		{"IFF_NAPI_FRAGS", 32},
+		{"IFF_NOARP", 128},
+		{"IFF_NOFILTER", 4096},
+		{"IFF_NOTRAILERS", 32},
+		{"IFF_NO_CARRIER", 64},
+		{"IFF_NO_PI", 4096},
+		{"IFF_ONE_QUEUE", 8192},
+		{"IFF_PERSIST", 2048},
+		{"IFF_POINTOPOINT", 16},
+		{"IFF_PORTSEL", 8192},
+		{"IFF_PROMISC", 256},
+		{"IFF_RUNNING", 64},
+		{"IFF_SLAVE", 2048},
+		{"IFF_TAP", 2},
+		{"IFF_TUN", 1},
+		{"IFF_TUN_EXCL", 32768},
+		{"IFF_UP", 1},
+		{"IFF_VNET_HDR", 16384},
+		{"IFF_VOLATILE", 0x70C5A},
+		{"IFNAMSIZ", 16},
+		{"IN_ACCESS", 1},
+		{"IN_ALL_EVENTS", 4095},
+		{"IN_ATTRIB", 4},
+		{"IN_CLASSA_HOST", 0xFFFFFF},
+		{"IN_CLASSA_MAX", 128},
+		{"IN_CLASSA_NET", 0xFF000000},
+		{"IN_CLASSA_NSHIFT", 24},
+		{"IN_CLASSB_HOST", 65535},
+		{"IN_CLASSB_MAX", 0x10000},
+		{"IN_CLASSB_NET", 0xFFFF0000},
+		{"IN_CLASSB_NSHIFT", 16},
+		{"IN_CLASSC_HOST", 255},
+		{"IN_CLASSC_NET", 0xFFFFFF00},
+		{"IN_CLASSC_NSHIFT", 8},
+		{"IN_CLASSE_NET", 0xFFFFFFFF},
+		{"IN_CLASSE_NSHIFT", 0},
+		{"IN_CLOEXEC", 0x80000},
+		{"IN_CLOSE", 24},
+		{"IN_CLOSE_NOWRITE", 16},
+		{"IN_CLOSE_WRITE", 8},
+		{"IN_CREATE", 256},
+		{"IN_DELETE", 512},
+		{"IN_DELETE_SELF", 1024},
+		{"IN_DONT_FOLLOW", 0x2000000},
+		{"IN_EXCL_UNLINK", 0x4000000},
+		{"IN_IGNORED", 32768},
+		{"IN_ISDIR", 0x40000000},
+		{"IN_LOOPBACKNET", 127},
+		{"IN_MASK_ADD", 0x20000000},
+		{"IN_MASK_CREATE", 0x10000000},
+		{"IN_MODIFY", 2},
+		{"IN_MOVE", 192},
+		{"IN_MOVED_FROM", 64},
+		{"IN_MOVED_TO", 128},
+		{"IN_MOVE_SELF", 2048},
+		{"IN_MULTICAST_NET", 0xE0000000},
+		{"IN_NONBLOCK", 2048},
+		{"IN_ONESHOT", 0x80000000},
+		{"IN_ONLYDIR", 0x1000000},
+		{"IN_OPEN", 32},
+		{"IN_Q_OVERFLOW", 16384},
+		{"IN_UNMOUNT", 8192},
+		{"IPOPT_CLASS_MASK", 96},
+		{"IPOPT_CONTROL", 0},
+		{"IPOPT_COPY", 128},
+		{"IPOPT_DEBMEAS", 64},
+		{"IPOPT_END", 0},
+		{"IPOPT_EOL", 0},
+		{"IPOPT_LSRR", 131},
+		{"IPOPT_MEASUREMENT", 64},
+		{"IPOPT_MINOFF", 4},
+		{"IPOPT_NOOP", 1},
+		{"IPOPT_NOP", 1},
+		{"IPOPT_NUMBER_MASK", 31},
+		{"IPOPT_OFFSET", 2},
+		{"IPOPT_OLEN", 1},
+		{"IPOPT_OPTVAL", 0},
+		{"IPOPT_RA", 148},
+		{"IPOPT_RESERVED1", 32},
+		{"IPOPT_RESERVED2", 96},
+		{"IPOPT_RR", 7},
+		{"IPOPT_SATID", 136},
+		{"IPOPT_SEC", 130},
+		{"IPOPT_SECURITY", 130},
+		{"IPOPT_SECUR_CONFID", 61749},
+		{"IPOPT_SECUR_EFTO", 30874},
+		{"IPOPT_SECUR_MMMM", 48205},
+		{"IPOPT_SECUR_RESTR", 44819},
+		{"IPOPT_SECUR_SECRET", 55176},
+		{"IPOPT_SECUR_TOPSECRET", 27589},
+		{"IPOPT_SECUR_UNCLASS", 0},
+		{"IPOPT_SID", 136},
+		{"IPOPT_SSRR", 137},
+		{"IPOPT_TIMESTAMP", 68},
+		{"IPOPT_TS", 68},
+		{"IPOPT_TS_PRESPEC", 3},
+		{"IPOPT_TS_TSANDADDR", 1},
+		{"IPOPT_TS_TSONLY", 0},
+		{"IPPROTO_AH", 51},
+		{"IPPROTO_BEETPH", 94},
+		{"IPPROTO_COMP", 108},
+		{"IPPROTO_DCCP", 33},
+		{"IPPROTO_DSTOPTS", 60},
+		{"IPPROTO_EGP", 8},
+		{"IPPROTO_ENCAP", 98},
+		{"IPPROTO_ESP", 50},
+		{"IPPROTO_ETHERNET", 143},
+		{"IPPROTO_FRAGMENT", 44},
+		{"IPPROTO_GRE", 47},
+		{"IPPROTO_HOPOPTS", 0},
+		{"IPPROTO_ICMP", 1},
+		{"IPPROTO_ICMPV6", 58},
+		{"IPPROTO_IDP", 22},
+		{"IPPROTO_IGMP", 2},
+		{"IPPROTO_IP", 0},
+		{"IPPROTO_IPIP", 4},
+		{"IPPROTO_IPV6", 41},
+		{"IPPROTO_L2TP", 115},
+		{"IPPROTO_MAX", 263},
+		{"IPPROTO_MH", 135},
+		{"IPPROTO_MPLS", 137},
+		{"IPPROTO_MPTCP", 262},
+		{"IPPROTO_MTP", 92},
+		{"IPPROTO_NONE", 59},
+		{"IPPROTO_PIM", 103},
+		{"IPPROTO_PUP", 12},
+		{"IPPROTO_RAW", 255},
+		{"IPPROTO_ROUTING", 43},
+		{"IPPROTO_RSVP", 46},
+		{"IPPROTO_SCTP", 132},
+		{"IPPROTO_TCP", 6},
+		{"IPPROTO_TP", 29},
+		{"IPPROTO_UDP", 17},
+		{"IPPROTO_UDPLITE", 136},
+		{"IPTOS_CLASS_CS0", 0},
+		{"IPTOS_CLASS_CS1", 32},
+		{"IPTOS_CLASS_CS2", 64},
+		{"IPTOS_CLASS_CS3", 96},
+		{"IPTOS_CLASS_CS4", 128},
+		{"IPTOS_CLASS_CS5", 160},
+		{"IPTOS_CLASS_CS6", 192},
+		{"IPTOS_CLASS_CS7", 224},
+		{"IPTOS_CLASS_DEFAULT", 0},
+		{"IPTOS_CLASS_MASK", 224},
+		{"IPTOS_DSCP_AF11", 40},
+		{"IPTOS_DSCP_AF12", 48},
+		{"IPTOS_DSCP_AF13", 56},
+		{"IPTOS_DSCP_AF21", 72},
+		{"IPTOS_DSCP_AF22", 80},
+		{"IPTOS_DSCP_AF23", 88},
+		{"IPTOS_DSCP_AF31", 104},
+		{"IPTOS_DSCP_AF32", 112},
+		{"IPTOS_DSCP_AF33", 120},
+		{"IPTOS_DSCP_AF41", 136},
+		{"IPTOS_DSCP_AF42", 144},
+		{"IPTOS_DSCP_AF43", 152},
+		{"IPTOS_DSCP_EF", 184},
+		{"IPTOS_DSCP_MASK", 252},
+		{"IPTOS_ECN_CE", 3},
+		{"IPTOS_ECN_ECT0", 2},
+		{"IPTOS_ECN_ECT1", 1},
+		{"IPTOS_ECN_MASK", 3},
+		{"IPTOS_ECN_NOT_ECT", 0},
+		{"IPTOS_LOWCOST", 2},
+		{"IPTOS_LOWDELAY", 16},
+		{"IPTOS_MINCOST", 2},
+		{"IPTOS_PREC_CRITIC_ECP", 160},
+		{"IPTOS_PREC_FLASH", 96},
+		{"IPTOS_PREC_FLASHOVERRIDE", 128},
+		{"IPTOS_PREC_IMMEDIATE", 64},
+		{"IPTOS_PREC_INTERNETCONTROL", 192},
+		{"IPTOS_PREC_MASK", 224},
+		{"IPTOS_PREC_NETCONTROL", 224},
+		{"IPTOS_PREC_PRIORITY", 32},
+		{"IPTOS_PREC_ROUTINE", 0},
+		{"IPTOS_RELIABILITY", 4},
+		{"IPTOS_THROUGHPUT", 8},
+		{"IPTOS_TOS_MASK", 30},
+		{"IPV6_2292DSTOPTS", 4},
+		{"IPV6_2292HOPLIMIT", 8},
+		{"IPV6_2292HOPOPTS", 3},
+		{"IPV6_2292PKTINFO", 2},
+		{"IPV6_2292PKTOPTIONS", 6},
+		{"IPV6_2292RTHDR", 5},
+		{"IPV6_ADDRFORM", 1},
+		{"IPV6_ADDR_PREFERENCES", 72},
+		{"IPV6_ADD_MEMBERSHIP", 20},
+		{"IPV6_AUTHHDR", 10},
+		{"IPV6_AUTOFLOWLABEL", 70},
+		{"IPV6_CHECKSUM", 7},
+		{"IPV6_DONTFRAG", 62},
+		{"IPV6_DROP_MEMBERSHIP", 21},
+		{"IPV6_DSTOPTS", 59},
+		{"IPV6_FLOWINFO", 11},
+		{"IPV6_FLOWINFO_FLOWLABEL", 0xFFFFF},
+		{"IPV6_FLOWINFO_PRIORITY", 0xFF00000},
+		{"IPV6_FLOWINFO_SEND", 33},
+		{"IPV6_FLOWLABEL_MGR", 32},
+		{"IPV6_FL_A_GET", 0},
+		{"IPV6_FL_A_PUT", 1},
+		{"IPV6_FL_A_RENEW", 2},
+		{"IPV6_FL_F_CREATE", 1},
+		{"IPV6_FL_F_EXCL", 2},
+		{"IPV6_FL_F_REFLECT", 4},
+		{"IPV6_FL_F_REMOTE", 8},
+		{"IPV6_FL_S_ANY", 255},
+		{"IPV6_FL_S_EXCL", 1},
+		{"IPV6_FL_S_NONE", 0},
+		{"IPV6_FL_S_PROCESS", 2},
+		{"IPV6_FL_S_USER", 3},
+		{"IPV6_FREEBIND", 78},
+		{"IPV6_HDRINCL", 36},
+		{"IPV6_HOPLIMIT", 52},
+		{"IPV6_HOPOPTS", 54},
+		{"IPV6_IPSEC_POLICY", 34},
+		{"IPV6_JOIN_ANYCAST", 27},
+		{"IPV6_JOIN_GROUP", 20},
+		{"IPV6_LEAVE_ANYCAST", 28},
+		{"IPV6_LEAVE_GROUP", 21},
+		{"IPV6_MINHOPCOUNT", 73},
+		{"IPV6_MTU", 24},
+		{"IPV6_MTU_DISCOVER", 23},
+		{"IPV6_MULTICAST_ALL", 29},
+		{"IPV6_MULTICAST_HOPS", 18},
+		{"IPV6_MULTICAST_IF", 17},
+		{"IPV6_MULTICAST_LOOP", 19},
+		{"IPV6_NEXTHOP", 9},
+		{"IPV6_ORIGDSTADDR", 74},
+		{"IPV6_PATHMTU", 61},
+		{"IPV6_PKTINFO", 50},
+		{"IPV6_PMTUDISC_DO", 2},
+		{"IPV6_PMTUDISC_DONT", 0},
+		{"IPV6_PMTUDISC_INTERFACE", 4},
+		{"IPV6_PMTUDISC_OMIT", 5},
+		{"IPV6_PMTUDISC_PROBE", 3},
+		{"IPV6_PMTUDISC_WANT", 1},
+		{"IPV6_PREFER_SRC_CGA", 8},
+		{"IPV6_PREFER_SRC_COA", 4},
+		{"IPV6_PREFER_SRC_HOME", 1024},
+		{"IPV6_PREFER_SRC_NONCGA", 2048},
+		{"IPV6_PREFER_SRC_PUBLIC", 2},
+		{"IPV6_PREFER_SRC_PUBTMP_DEFAULT", 256},
+		{"IPV6_PREFER_SRC_TMP", 1},
+		{"IPV6_PRIORITY_10", 2560},
+		{"IPV6_PRIORITY_11", 2816},
+		{"IPV6_PRIORITY_12", 3072},
+		{"IPV6_PRIORITY_13", 3328},
+		{"IPV6_PRIORITY_14", 3584},
+		{"IPV6_PRIORITY_15", 3840},
+		{"IPV6_PRIORITY_8", 2048},
+		{"IPV6_PRIORITY_9", 2304},
+		{"IPV6_PRIORITY_BULK", 1024},
+		{"IPV6_PRIORITY_CONTROL", 1792},
+		{"IPV6_PRIORITY_FILLER", 256},
+		{"IPV6_PRIORITY_INTERACTIVE", 1536},
+		{"IPV6_PRIORITY_RESERVED1", 768},
+		{"IPV6_PRIORITY_RESERVED2", 1280},
+		{"IPV6_PRIORITY_UNATTENDED", 512},
+		{"IPV6_PRIORITY_UNCHARACTERIZED", 0},
+		{"IPV6_RECVDSTOPTS", 58},
+		{"IPV6_RECVERR", 25},
+		{"IPV6_RECVERR_RFC4884", 31},
+		{"IPV6_RECVFRAGSIZE", 77},
+		{"IPV6_RECVHOPLIMIT", 51},
+		{"IPV6_RECVHOPOPTS", 53},
+		{"IPV6_RECVORIGDSTADDR", 74},
+		{"IPV6_RECVPATHMTU", 60},
+		{"IPV6_RECVPKTINFO", 49},
+		{"IPV6_RECVRTHDR", 56},
+		{"IPV6_RECVTCLASS", 66},
+		{"IPV6_ROUTER_ALERT", 22},
+		{"IPV6_ROUTER_ALERT_ISOLATE", 30},
+		{"IPV6_RTHDR", 57},
+		{"IPV6_RTHDRDSTOPTS", 55},
+		{"IPV6_RTHDR_LOOSE", 0},
+		{"IPV6_RTHDR_STRICT", 1},
+		{"IPV6_RTHDR_TYPE_0", 0},
+		{"IPV6_RXDSTOPTS", 59},
+		{"IPV6_RXHOPOPTS", 54},
+		{"IPV6_TCLASS", 67},
+		{"IPV6_TLV_CALIPSO", 7},
+		{"IPV6_TLV_HAO", 201},
+		{"IPV6_TLV_IOAM", 49},
+		{"IPV6_TLV_JUMBO", 194},
+		{"IPV6_TLV_PAD1", 0},
+		{"IPV6_TLV_PADN", 1},
+		{"IPV6_TLV_ROUTERALERT", 5},
+		{"IPV6_TRANSPARENT", 75},
+		{"IPV6_UNICAST_HOPS", 16},
+		{"IPV6_UNICAST_IF", 76},
+		{"IPV6_V6ONLY", 26},
+		{"IPV6_XFRM_POLICY", 35},
+		{"IP_ADD_MEMBERSHIP", 35},
+		{"IP_ADD_SOURCE_MEMBERSHIP", 39},
+		{"IP_BIND_ADDRESS_NO_PORT", 24},
+		{"IP_BLOCK_SOURCE", 38},
+		{"IP_CHECKSUM", 23},
+		{"IP_DEFAULT_MULTICAST_LOOP", 1},
+		{"IP_DEFAULT_MULTICAST_TTL", 1},
+		{"IP_DF", 16384},
+		{"IP_DROP_MEMBERSHIP", 36},
+		{"IP_DROP_SOURCE_MEMBERSHIP", 40},
+		{"IP_FREEBIND", 15},
+		{"IP_HDRINCL", 3},
+		{"IP_IPSEC_POLICY", 16},
+		{"IP_LOCAL_PORT_RANGE", 51},
+		{"IP_MAXPACKET", 65535},
+		{"IP_MAX_MEMBERSHIPS", 20},
+		{"IP_MF", 8192},
+		{"IP_MINTTL", 21},
+		{"IP_MSFILTER", 41},
+		{"IP_MSS", 576},
+		{"IP_MTU", 14},
+		{"IP_MTU_DISCOVER", 10},
+		{"IP_MULTICAST_ALL", 49},
+		{"IP_MULTICAST_IF", 32},
+		{"IP_MULTICAST_LOOP", 34},
+		{"IP_MULTICAST_TTL", 33},
+		{"IP_NODEFRAG", 22},
+		{"IP_OFFMASK", 8191},
+		{"IP_OPTIONS", 4},
+		{"IP_ORIGDSTADDR", 20},
+		{"IP_PASSSEC", 18},
+		{"IP_PKTINFO", 8},
+		{"IP_PKTOPTIONS", 9},
+		{"IP_PMTUDISC", 10},
+		{"IP_PMTUDISC_DO", 2},
+		{"IP_PMTUDISC_DONT", 0},
+		{"IP_PMTUDISC_INTERFACE", 4},
+		{"IP_PMTUDISC_OMIT", 5},
+		{"IP_PMTUDISC_PROBE", 3},
+		{"IP_PMTUDISC_WANT", 1},
+		{"IP_PROTOCOL", 52},
+		{"IP_RECVERR", 11},
+		{"IP_RECVERR_RFC4884", 26},
+		{"IP_RECVFRAGSIZE", 25},
+		{"IP_RECVOPTS", 6},
+		{"IP_RECVORIGDSTADDR", 20},
+		{"IP_RECVRETOPTS", 7},
+		{"IP_RECVTOS", 13},
+		{"IP_RECVTTL", 12},
+		{"IP_RETOPTS", 7},
+		{"IP_RF", 32768},
+		{"IP_ROUTER_ALERT", 5},
+		{"IP_TOS", 1},
+		{"IP_TRANSPARENT", 19},
+		{"IP_TTL", 2},
+		{"IP_UNBLOCK_SOURCE", 37},
+		{"IP_UNICAST_IF", 50},
+		{"IP_XFRM_POLICY", 17},
+		{"IUCLC", 512},
+		{"LANDLOCK_ACCESS_FS_EXECUTE", 1},
+		{"LANDLOCK_ACCESS_FS_MAKE_BLOCK", 2048},
+		{"LANDLOCK_ACCESS_FS_MAKE_CHAR", 64},
+		{"LANDLOCK_ACCESS_FS_MAKE_DIR", 128},
+		{"LANDLOCK_ACCESS_FS_MAKE_FIFO", 1024},
+		{"LANDLOCK_ACCESS_FS_MAKE_REG", 256},
+		{"LANDLOCK_ACCESS_FS_MAKE_SOCK", 512},
+		{"LANDLOCK_ACCESS_FS_MAKE_SYM", 4096},
+		{"LANDLOCK_ACCESS_FS_READ_DIR", 8},
+		{"LANDLOCK_ACCESS_FS_READ_FILE", 4},
+		{"LANDLOCK_ACCESS_FS_REFER", 8192},
+		{"LANDLOCK_ACCESS_FS_REMOVE_DIR", 16},
+		{"LANDLOCK_ACCESS_FS_REMOVE_FILE", 32},
+		{"LANDLOCK_ACCESS_FS_WRITE_FILE", 2},
+		{"LANDLOCK_CREATE_RULESET_ERRATA", 2},
+		{"LANDLOCK_CREATE_RULESET_VERSION", 1},
+		{"LANDLOCK_RULE_PATH_BENEATH", 1},
+		{"LINUX_REBOOT_CMD_CAD_OFF", 0},
+		{"LINUX_REBOOT_CMD_CAD_ON", 0x89ABCDEF},
+		{"LINUX_REBOOT_CMD_HALT", 0xCDEF0123},
+		{"LINUX_REBOOT_CMD_KEXEC", 0x45584543},
+		{"LINUX_REBOOT_CMD_POWER_OFF", 0x4321FEDC},
+		{"LINUX_REBOOT_CMD_RESTART", 0x1234567},
+		{"LINUX_REBOOT_CMD_RESTART2", 0xA1B2C3D4},
+		{"LINUX_REBOOT_CMD_SW_SUSPEND", 0xD000FCE2},
+		{"LINUX_REBOOT_MAGIC1", 0xFEE1DEAD},
+		{"LINUX_REBOOT_MAGIC2", 0x28121969},
+		{"LINUX_REBOOT_MAGIC2A", 0x5121996},
+		{"LINUX_REBOOT_MAGIC2B", 0x16041998},
+		{"LINUX_REBOOT_MAGIC2C", 0x20112000},
+		{"LOCK_EX", 2},
+		{"LOCK_MAND", 32},
+		{"LOCK_NB", 4},
+		{"LOCK_READ", 64},
+		{"LOCK_RW", 192},
+		{"LOCK_SH", 1},
+		{"LOCK_UN", 8},
+		{"LOCK_WRITE", 128},
+		{"MADV_COLD", 20},
+		{"MADV_COLLAPSE", 25},
+		{"MADV_DODUMP", 17},
+		{"MADV_DOFORK", 11},
+		{"MADV_DONTDUMP", 16},
+		{"MADV_DONTFORK", 10},
+		{"MADV_DONTNEED", 4},
+		{"MADV_DONTNEED_LOCKED", 24},
+		{"MADV_FREE", 8},
+		{"MADV_HUGEPAGE", 14},
+		{"MADV_HWPOISON", 100},
+		{"MADV_KEEPONFORK", 19},
+		{"MADV_MERGEABLE", 12},
+		{"MADV_NOHUGEPAGE", 15},
+		{"MADV_NORMAL", 0},
+		{"MADV_PAGEOUT", 21},
+		{"MADV_POPULATE_READ", 22},
+		{"MADV_POPULATE_WRITE", 23},
+		{"MADV_RANDOM", 1},
+		{"MADV_REMOVE", 9},
+		{"MADV_SEQUENTIAL", 2},
+		{"MADV_SOFT_OFFLINE", 101},
+		{"MADV_UNMERGEABLE", 13},
+		{"MADV_WILLNEED", 3},
+		{"MADV_WIPEONFORK", 18},
+		{"MAP_32BIT", 64},
+		{"MAP_ANON", 32},
+		{"MAP_ANONYMOUS", 32},
+		{"MAP_DENYWRITE", 2048},
+		{"MAP_EXECUTABLE", 4096},
+		{"MAP_FILE", 0},
+		{"MAP_FIXED", 16},
+		{"MAP_FIXED_NOREPLACE", 0x100000},
+		{"MAP_GROWSDOWN", 256},
+		{"MAP_HUGETLB", 0x40000},
+		{"MAP_HUGE_16GB", 0x88000000},
+		{"MAP_HUGE_16KB", 0x38000000},
+		{"MAP_HUGE_16MB", 0x60000000},
+		{"MAP_HUGE_1GB", 0x78000000},
+		{"MAP_HUGE_1MB", 0x50000000},
+		{"MAP_HUGE_256MB", 0x70000000},
+		{"MAP_HUGE_2GB", 0x7C000000},
+		{"MAP_HUGE_2MB", 0x54000000},
+		{"MAP_HUGE_32MB", 0x64000000},
+		{"MAP_HUGE_512KB", 0x4C000000},
+		{"MAP_HUGE_512MB", 0x74000000},
+		{"MAP_HUGE_64KB", 0x40000000},
+		{"MAP_HUGE_8MB", 0x5C000000},
+		{"MAP_HUGE_MASK", 63},
+		{"MAP_HUGE_SHIFT", 26},
+		{"MAP_LOCKED", 8192},
+		{"MAP_NONBLOCK", 0x10000},
+		{"MAP_NORESERVE", 16384},
+		{"MAP_POPULATE", 32768},
+		{"MAP_PRIVATE", 2},
+		{"MAP_SHARED", 1},
+		{"MAP_SHARED_VALIDATE", 3},
+		{"MAP_STACK", 0x20000},
+		{"MAP_SYNC", 0x80000},
+		{"MAP_TYPE", 15},
+		{"MAP_UNINITIALIZED", 0x4000000},
+		{"MCL_CURRENT", 1},
+		{"MCL_FUTURE", 2},
+		{"MCL_ONFAULT", 4},
+		{"MFD_ALLOW_SEALING", 2},
+		{"MFD_CLOEXEC", 1},
+		{"MFD_HUGETLB", 4},
+		{"MFD_HUGE_16GB", 0x88000000},
+		{"MFD_HUGE_16MB", 0x60000000},
+		{"MFD_HUGE_1GB", 0x78000000},
+		{"MFD_HUGE_1MB", 0x50000000},
+		{"MFD_HUGE_256MB", 0x70000000},
+		{"MFD_HUGE_2GB", 0x7C000000},
+		{"MFD_HUGE_2MB", 0x54000000},
+		{"MFD_HUGE_32MB", 0x64000000},
+		{"MFD_HUGE_512KB", 0x4C000000},
+		{"MFD_HUGE_512MB", 0x74000000},
+		{"MFD_HUGE_64KB", 0x40000000},
+		{"MFD_HUGE_8MB", 0x5C000000},
+		{"MFD_HUGE_MASK", 63},
+		{"MFD_HUGE_SHIFT", 26},
+		{"MLOCK_ONFAULT", 1},
+		{"MNT_DETACH", 2},
+		{"MNT_EXPIRE", 4},
+		{"MNT_FORCE", 1},
+		{"MOUNT_ATTR_IDMAP", 0x100000},
+		{"MOUNT_ATTR_NOATIME", 16},
+		{"MOUNT_ATTR_NODEV", 4},
+		{"MOUNT_ATTR_NODIRATIME", 128},
+		{"MOUNT_ATTR_NOEXEC", 8},
+		{"MOUNT_ATTR_NOSUID", 2},
+		{"MOUNT_ATTR_NOSYMFOLLOW", 0x200000},
+		{"MOUNT_ATTR_RDONLY", 1},
+		{"MOUNT_ATTR_RELATIME", 0},
+		{"MOUNT_ATTR_SIZE_VER0", 32},
+		{"MOUNT_ATTR_STRICTATIME", 32},
+		{"MOUNT_ATTR__ATIME", 112},
+		{"MOVE_MOUNT_F_AUTOMOUNTS", 2},
+		{"MOVE_MOUNT_F_EMPTY_PATH", 4},
+		{"MOVE_MOUNT_F_SYMLINKS", 1},
+		{"MOVE_MOUNT_SET_GROUP", 256},
+		{"MOVE_MOUNT_T_AUTOMOUNTS", 32},
+		{"MOVE_MOUNT_T_EMPTY_PATH", 64},
+		{"MOVE_MOUNT_T_SYMLINKS", 16},
+		{"MOVE_MOUNT__MASK", 375},
+		{"MREMAP_DONTUNMAP", 4},
+		{"MREMAP_FIXED", 2},
+		{"MREMAP_MAYMOVE", 1},
+		{"MSG_BATCH", 0x40000},
+		{"MSG_CMSG_CLOEXEC", 0x40000000},
+		{"MSG_CONFIRM", 2048},
+		{"MSG_CTRUNC", 8},
+		{"MSG_DONTROUTE", 4},
+		{"MSG_DONTWAIT", 64},
+		{"MSG_EOR", 128},
+		{"MSG_ERRQUEUE", 8192},
+		{"MSG_FASTOPEN", 0x20000000},
+		{"MSG_FIN", 512},
+		{"MSG_MORE", 32768},
+		{"MSG_NOSIGNAL", 16384},
+		{"MSG_OOB", 1},
+		{"MSG_PEEK", 2},
+		{"MSG_PROXY", 16},
+		{"MSG_RST", 4096},
+		{"MSG_SYN", 1024},
+		{"MSG_TRUNC", 32},
+		{"MSG_TRYHARD", 4},
+		{"MSG_WAITALL", 256},
+		{"MSG_WAITFORONE", 0x10000},
+		{"MSG_ZEROCOPY", 0x4000000},
+		{"MS_ACTIVE", 0x40000000},
+		{"MS_ASYNC", 1},
+		{"MS_BIND", 4096},
+		{"MS_BORN", 0x20000000},
+		{"MS_DIRSYNC", 128},
+		{"MS_INVALIDATE", 2},
+		{"MS_I_VERSION", 0x800000},
+		{"MS_KERNMOUNT", 0x400000},
+		{"MS_LAZYTIME", 0x2000000},
+		{"MS_MANDLOCK", 64},
+		{"MS_MGC_MSK", 0xFFFF0000},
+		{"MS_MGC_VAL", 0xC0ED0000},
+		{"MS_MOVE", 8192},
+		{"MS_NOATIME", 1024},
+		{"MS_NODEV", 4},
+		{"MS_NODIRATIME", 2048},
+		{"MS_NOEXEC", 8},
+		{"MS_NOREMOTELOCK", 0x8000000},
+		{"MS_NOSEC", 0x10000000},
+		{"MS_NOSUID", 2},
+		{"MS_NOSYMFOLLOW", 256},
+		{"MS_NOUSER", 0x80000000},
+		{"MS_POSIXACL", 0x10000},
+		{"MS_PRIVATE", 0x40000},
+		{"MS_RDONLY", 1},
+		{"MS_REC", 16384},
+		{"MS_RELATIME", 0x200000},
+		{"MS_REMOUNT", 32},
+		{"MS_RMT_MASK", 0x2800051},
+		{"MS_SHARED", 0x100000},
+		{"MS_SILENT", 32768},
+		{"MS_SLAVE", 0x80000},
+		{"MS_STRICTATIME", 0x1000000},
+		{"MS_SUBMOUNT", 0x4000000},
+		{"MS_SYNC", 4},
+		{"MS_SYNCHRONOUS", 16},
+		{"MS_UNBINDABLE", 0x20000},
+		{"MS_VERBOSE", 32768},
+		{"NAME_MAX", 255},
+		{"NETLINK_ADD_MEMBERSHIP", 1},
+		{"NETLINK_AUDIT", 9},
+		{"NETLINK_BROADCAST_ERROR", 4},
+		{"NETLINK_CAP_ACK", 10},
+		{"NETLINK_CONNECTED", 1},
+		{"NETLINK_CONNECTOR", 11},
+		{"NETLINK_CRYPTO", 21},
+		{"NETLINK_DNRTMSG", 14},
+		{"NETLINK_DROP_MEMBERSHIP", 2},
+		{"NETLINK_ECRYPTFS", 19},
+		{"NETLINK_EXT_ACK", 11},
+		{"NETLINK_FIB_LOOKUP", 10},
+		{"NETLINK_FIREWALL", 3},
+		{"NETLINK_GENERIC", 16},
+		{"NETLINK_GET_STRICT_CHK", 12},
+		{"NETLINK_INET_DIAG", 4},
+		{"NETLINK_IP6_FW", 13},
+		{"NETLINK_ISCSI", 8},
+		{"NETLINK_KOBJECT_UEVENT", 15},
+		{"NETLINK_LISTEN_ALL_NSID", 8},
+		{"NETLINK_LIST_MEMBERSHIPS", 9},
+		{"NETLINK_NETFILTER", 12},
+		{"NETLINK_NFLOG", 5},
+		{"NETLINK_NO_ENOBUFS", 5},
+		{"NETLINK_PKTINFO", 3},
+		{"NETLINK_RDMA", 20},
+		{"NETLINK_ROUTE", 0},
+		{"NETLINK_RX_RING", 6},
+		{"NETLINK_SCSITRANSPORT", 18},
+		{"NETLINK_SELINUX", 7},
+		{"NETLINK_SMC", 22},
+		{"NETLINK_SOCK_DIAG", 4},
+		{"NETLINK_TX_RING", 7},
+		{"NETLINK_UNCONNECTED", 0},
+		{"NETLINK_UNUSED", 1},
+		{"NETLINK_USERSOCK", 2},
+		{"NETLINK_XFRM", 6},
+		{"NLA_ALIGNTO", 4},
+		{"NLA_F_NESTED", 32768},
+		{"NLA_F_NET_BYTEORDER", 16384},
+		{"NLA_HDRLEN", 4},
+		{"NLA_TYPE_MASK", -0xC001},
+		{"NLMSG_ALIGNTO", 4},
+		{"NLMSG_DONE", 3},
+		{"NLMSG_ERROR", 2},
+		{"NLMSG_HDRLEN", 16},
+		{"NLMSG_MIN_TYPE", 16},
+		{"NLMSG_NOOP", 1},
+		{"NLMSG_OVERRUN", 4},
+		{"NLM_F_ACK", 4},
+		{"NLM_F_ACK_TLVS", 512},
+		{"NLM_F_APPEND", 2048},
+		{"NLM_F_ATOMIC", 1024},
+		{"NLM_F_BULK", 512},
+		{"NLM_F_CAPPED", 256},
+		{"NLM_F_CREATE", 1024},
+		{"NLM_F_DUMP", 768},
+		{"NLM_F_DUMP_FILTERED", 32},
+		{"NLM_F_DUMP_INTR", 16},
+		{"NLM_F_ECHO", 8},
+		{"NLM_F_EXCL", 512},
+		{"NLM_F_MATCH", 512},
+		{"NLM_F_MULTI", 2},
+		{"NLM_F_NONREC", 256},
+		{"NLM_F_REPLACE", 256},
+		{"NLM_F_REQUEST", 1},
+		{"NLM_F_ROOT", 256},
+		{"OLCUC", 2},
+		{"OPEN_TREE_CLOEXEC", 0x80000},
+		{"OPEN_TREE_CLONE", 1},
+		{"O_ACCMODE", 3},
+		{"O_APPEND", 1024},
+		{"O_ASYNC", 8192},
+		{"O_CLOEXEC", 0x80000},
+		{"O_CREAT", 64},
+		{"O_DIRECT", 16384},
+		{"O_DIRECTORY", 0x10000},
+		{"O_DSYNC", 4096},
+		{"O_EXCL", 128},
+		{"O_FSYNC", 0x101000},
+		{"O_LARGEFILE", 32768},
+		{"O_NDELAY", 2048},
+		{"O_NOATIME", 0x40000},
+		{"O_NOCTTY", 256},
+		{"O_NOFOLLOW", 0x20000},
+		{"O_NONBLOCK", 2048},
+		{"O_PATH", 0x200000},
+		{"O_RDONLY", 0},
+		{"O_RDWR", 2},
+		{"O_RSYNC", 0x101000},
+		{"O_SYNC", 0x101000},
+		{"O_TMPFILE", 0x410000},
+		{"O_TRUNC", 512},
+		{"O_WRONLY", 1},
+		{"PACKET_ADD_MEMBERSHIP", 1},
+		{"PACKET_AUXDATA", 8},
+		{"PACKET_BROADCAST", 1},
+		{"PACKET_COPY_THRESH", 7},
+		{"PACKET_DROP_MEMBERSHIP", 2},
+		{"PACKET_FANOUT", 18},
+		{"PACKET_FANOUT_CBPF", 6},
+		{"PACKET_FANOUT_CPU", 2},
+		{"PACKET_FANOUT_DATA", 22},
+		{"PACKET_FANOUT_EBPF", 7},
+		{"PACKET_FANOUT_FLAG_DEFRAG", 32768},
+		{"PACKET_FANOUT_FLAG_ROLLOVER", 4096},
+		{"PACKET_FANOUT_FLAG_UNIQUEID", 8192},
+		{"PACKET_FANOUT_HASH", 0},
+		{"PACKET_FANOUT_LB", 1},
+		{"PACKET_FANOUT_QM", 5},
+		{"PACKET_FANOUT_RND", 4},
+		{"PACKET_FANOUT_ROLLOVER", 3},
+		{"PACKET_FASTROUTE", 6},
+		{"PACKET_HDRLEN", 11},
+		{"PACKET_HOST", 0},
+		{"PACKET_IGNORE_OUTGOING", 23},
+		{"PACKET_KERNEL", 7},
+		{"PACKET_LOOPBACK", 5},
+		{"PACKET_LOSS", 14},
+		{"PACKET_MR_ALLMULTI", 2},
+		{"PACKET_MR_MULTICAST", 0},
+		{"PACKET_MR_PROMISC", 1},
+		{"PACKET_MR_UNICAST", 3},
+		{"PACKET_MULTICAST", 2},
+		{"PACKET_ORIGDEV", 9},
+		{"PACKET_OTHERHOST", 3},
+		{"PACKET_OUTGOING", 4},
+		{"PACKET_QDISC_BYPASS", 20},
+		{"PACKET_RECV_OUTPUT", 3},
+		{"PACKET_RESERVE", 12},
+		{"PACKET_ROLLOVER_STATS", 21},
+		{"PACKET_RX_RING", 5},
+		{"PACKET_STATISTICS", 6},
+		{"PACKET_TIMESTAMP", 17},
+		{"PACKET_TX_HAS_OFF", 19},
+		{"PACKET_TX_RING", 13},
+		{"PACKET_TX_TIMESTAMP", 16},
+		{"PACKET_USER", 6},
+		{"PACKET_VERSION", 10},
+		{"PACKET_VNET_HDR", 15},
+		{"PATH_MAX", 4096},
+		{"PRIO_MAX", 20},
+		{"PRIO_MIN", -0x14},
+		{"PRIO_PGRP", 1},
+		{"PRIO_PROCESS", 0},
+		{"PRIO_USER", 2},
+		{"PROT_EXEC", 4},
+		{"PROT_GROWSDOWN", 0x1000000},
+		{"PROT_GROWSUP", 0x2000000},
+		{"PROT_NONE", 0},
+		{"PROT_READ", 1},
+		{"PROT_SEM", 8},
+		{"PROT_WRITE", 2},
+		{"PR_CAPBSET_DROP", 24},
+		{"PR_CAPBSET_READ", 23},
+		{"PR_CAP_AMBIENT", 47},
+		{"PR_CAP_AMBIENT_CLEAR_ALL", 4},
+		{"PR_CAP_AMBIENT_IS_SET", 1},
+		{"PR_CAP_AMBIENT_LOWER", 3},
+		{"PR_CAP_AMBIENT_RAISE", 2},
+		{"PR_ENDIAN_BIG", 0},
+		{"PR_ENDIAN_LITTLE", 1},
+		{"PR_ENDIAN_PPC_LITTLE", 2},
+		{"PR_FPEMU_NOPRINT", 1},
+		{"PR_FPEMU_SIGFPE", 2},
+		{"PR_FP_EXC_ASYNC", 2},
+		{"PR_FP_EXC_DISABLED", 0},
+		{"PR_FP_EXC_DIV", 0x10000},
+		{"PR_FP_EXC_INV", 0x100000},
+		{"PR_FP_EXC_NONRECOV", 1},
+		{"PR_FP_EXC_OVF", 0x20000},
+		{"PR_FP_EXC_PRECISE", 3},
+		{"PR_FP_EXC_RES", 0x80000},
+		{"PR_FP_EXC_SW_ENABLE", 128},
+		{"PR_FP_EXC_UND", 0x40000},
+		{"PR_FP_MODE_FR", 1},
+		{"PR_FP_MODE_FRE", 2},
+		{"PR_GET_CHILD_SUBREAPER", 37},
+		{"PR_GET_DUMPABLE", 3},
+		{"PR_GET_ENDIAN", 19},
+		{"PR_GET_FPEMU", 9},
+		{"PR_GET_FPEXC", 11},
+		{"PR_GET_FP_MODE", 46},
+		{"PR_GET_IO_FLUSHER", 58},
+		{"PR_GET_KEEPCAPS", 7},
+		{"PR_GET_NAME", 16},
+		{"PR_GET_NO_NEW_PRIVS", 39},
+		{"PR_GET_PDEATHSIG", 2},
+		{"PR_GET_SECCOMP", 21},
+		{"PR_GET_SECUREBITS", 27},
+		{"PR_GET_SPECULATION_CTRL", 52},
+		{"PR_GET_TAGGED_ADDR_CTRL", 56},
+		{"PR_GET_THP_DISABLE", 42},
+		{"PR_GET_TID_ADDRESS", 40},
+		{"PR_GET_TIMERSLACK", 30},
+		{"PR_GET_TIMING", 13},
+		{"PR_GET_TSC", 25},
+		{"PR_GET_UNALIGN", 5},
+		{"PR_MCE_KILL", 33},
+		{"PR_MCE_KILL_CLEAR", 0},
+		{"PR_MCE_KILL_DEFAULT", 2},
+		{"PR_MCE_KILL_EARLY", 1},
+		{"PR_MCE_KILL_GET", 34},
+		{"PR_MCE_KILL_LATE", 0},
+		{"PR_MCE_KILL_SET", 1},
+		{"PR_MPX_DISABLE_MANAGEMENT", 44},
+		{"PR_MPX_ENABLE_MANAGEMENT", 43},
+		{"PR_MTE_TAG_MASK", 0x7FFF8},
+		{"PR_MTE_TAG_SHIFT", 3},
+		{"PR_MTE_TCF_ASYNC", 4},
+		{"PR_MTE_TCF_MASK", 6},
+		{"PR_MTE_TCF_NONE", 0},
+		{"PR_MTE_TCF_SHIFT", 1},
+		{"PR_MTE_TCF_SYNC", 2},
+		{"PR_PAC_APDAKEY", 4},
+		{"PR_PAC_APDBKEY", 8},
+		{"PR_PAC_APGAKEY", 16},
+		{"PR_PAC_APIAKEY", 1},
+		{"PR_PAC_APIBKEY", 2},
+		{"PR_PAC_GET_ENABLED_KEYS", 61},
+		{"PR_PAC_RESET_KEYS", 54},
+		{"PR_PAC_SET_ENABLED_KEYS", 60},
+		{"PR_SCHED_CORE", 62},
+		{"PR_SCHED_CORE_CREATE", 1},
+		{"PR_SCHED_CORE_GET", 0},
+		{"PR_SCHED_CORE_MAX", 4},
+		{"PR_SCHED_CORE_SCOPE_PROCESS_GROUP", 2},
+		{"PR_SCHED_CORE_SCOPE_THREAD", 0},
+		{"PR_SCHED_CORE_SCOPE_THREAD_GROUP", 1},
+		{"PR_SCHED_CORE_SHARE_FROM", 3},
+		{"PR_SCHED_CORE_SHARE_TO", 2},
+		{"PR_SET_CHILD_SUBREAPER", 36},
+		{"PR_SET_DUMPABLE", 4},
+		{"PR_SET_ENDIAN", 20},
+		{"PR_SET_FPEMU", 10},
+		{"PR_SET_FPEXC", 12},
+		{"PR_SET_FP_MODE", 45},
+		{"PR_SET_IO_FLUSHER", 57},
+		{"PR_SET_KEEPCAPS", 8},
+		{"PR_SET_MM", 35},
+		{"PR_SET_MM_ARG_END", 9},
+		{"PR_SET_MM_ARG_START", 8},
+		{"PR_SET_MM_AUXV", 12},
+		{"PR_SET_MM_BRK", 7},
+		{"PR_SET_MM_END_CODE", 2},
+		{"PR_SET_MM_END_DATA", 4},
+		{"PR_SET_MM_ENV_END", 11},
+		{"PR_SET_MM_ENV_START", 10},
+		{"PR_SET_MM_EXE_FILE", 13},
+		{"PR_SET_MM_MAP", 14},
+		{"PR_SET_MM_MAP_SIZE", 15},
+		{"PR_SET_MM_START_BRK", 6},
+		{"PR_SET_MM_START_CODE", 1},
+		{"PR_SET_MM_START_DATA", 3},
+		{"PR_SET_MM_START_STACK", 5},
+		{"PR_SET_NAME", 15},
+		{"PR_SET_NO_NEW_PRIVS", 38},
+		{"PR_SET_PDEATHSIG", 1},
+		{"PR_SET_PTRACER", 0x59616D61},
+		{"PR_SET_PTRACER_ANY", -0x1},
+		{"PR_SET_SECCOMP", 22},
+		{"PR_SET_SECUREBITS", 28},
+		{"PR_SET_SPECULATION_CTRL", 53},
+		{"PR_SET_SYSCALL_USER_DISPATCH", 59},
+		{"PR_SET_TAGGED_ADDR_CTRL", 55},
+		{"PR_SET_THP_DISABLE", 41},
+		{"PR_SET_TIMERSLACK", 29},
+		{"PR_SET_TIMING", 14},
+		{"PR_SET_TSC", 26},
+		{"PR_SET_UNALIGN", 6},
+		{"PR_SET_VMA", 0x53564D41},
+		{"PR_SET_VMA_ANON_NAME", 0},
+		{"PR_SME_GET_VL", 64},
+		{"PR_SME_SET_VL", 63},
+		{"PR_SME_SET_VL_ONEXEC", 0x40000},
+		{"PR_SME_VL_INHERIT", 0x20000},
+		{"PR_SME_VL_LEN_MASK", 65535},
+		{"PR_SPEC_DISABLE", 4},
+		{"PR_SPEC_DISABLE_NOEXEC", 16},
+		{"PR_SPEC_ENABLE", 2},
+		{"PR_SPEC_FORCE_DISABLE", 8},
+		{"PR_SPEC_INDIRECT_BRANCH", 1},
+		{"PR_SPEC_L1D_FLUSH", 2},
+		{"PR_SPEC_NOT_AFFECTED", 0},
+		{"PR_SPEC_PRCTL", 1},
+		{"PR_SPEC_STORE_BYPASS", 0},
+		{"PR_SVE_GET_VL", 51},
+		{"PR_SVE_SET_VL", 50},
+		{"PR_SVE_SET_VL_ONEXEC", 0x40000},
+		{"PR_SVE_VL_INHERIT", 0x20000},
+		{"PR_SVE_VL_LEN_MASK", 65535},
+		{"PR_SYS_DISPATCH_OFF", 0},
+		{"PR_SYS_DISPATCH_ON", 1},
+		{"PR_TAGGED_ADDR_ENABLE", 1},
+		{"PR_TASK_PERF_EVENTS_DISABLE", 31},
+		{"PR_TASK_PERF_EVENTS_ENABLE", 32},
+		{"PR_TIMING_STATISTICAL", 0},
+		{"PR_TIMING_TIMESTAMP", 1},
+		{"PR_TSC_ENABLE", 1},
+		{"PR_TSC_SIGSEGV", 2},
+		{"PR_UNALIGN_NOPRINT", 1},
+		{"PR_UNALIGN_SIGBUS", 2},
+		{"PTRACE_ARCH_PRCTL", 30},
+		{"PTRACE_ATTACH", 16},
+		{"PTRACE_CONT", 7},
+		{"PTRACE_DETACH", 17},
+		{"PTRACE_EVENTMSG_SYSCALL_ENTRY", 1},
+		{"PTRACE_EVENTMSG_SYSCALL_EXIT", 2},
+		{"PTRACE_EVENT_CLONE", 3},
+		{"PTRACE_EVENT_EXEC", 4},
+		{"PTRACE_EVENT_EXIT", 6},
+		{"PTRACE_EVENT_FORK", 1},
+		{"PTRACE_EVENT_SECCOMP", 7},
+		{"PTRACE_EVENT_STOP", 128},
+		{"PTRACE_EVENT_VFORK", 2},
+		{"PTRACE_EVENT_VFORK_DONE", 5},
+		{"PTRACE_GETEVENTMSG", 16897},
+		{"PTRACE_GETFPREGS", 14},
+		{"PTRACE_GETFPXREGS", 18},
+		{"PTRACE_GETREGS", 12},
+		{"PTRACE_GETREGSET", 16900},
+		{"PTRACE_GETSIGINFO", 16898},
+		{"PTRACE_GETSIGMASK", 16906},
+		{"PTRACE_GET_RSEQ_CONFIGURATION", 16911},
+		{"PTRACE_GET_SYSCALL_INFO", 16910},
+		{"PTRACE_GET_THREAD_AREA", 25},
+		{"PTRACE_INTERRUPT", 16903},
+		{"PTRACE_KILL", 8},
+		{"PTRACE_LISTEN", 16904},
+		{"PTRACE_OLDSETOPTIONS", 21},
+		{"PTRACE_O_EXITKILL", 0x100000},
+		{"PTRACE_O_MASK", 0x3000FF},
+		{"PTRACE_O_SUSPEND_SECCOMP", 0x200000},
+		{"PTRACE_O_TRACECLONE", 8},
+		{"PTRACE_O_TRACEEXEC", 16},
+		{"PTRACE_O_TRACEEXIT", 64},
+		{"PTRACE_O_TRACEFORK", 2},
+		{"PTRACE_O_TRACESECCOMP", 128},
+		{"PTRACE_O_TRACESYSGOOD", 1},
+		{"PTRACE_O_TRACEVFORK", 4},
+		{"PTRACE_O_TRACEVFORKDONE", 32},
+		{"PTRACE_PEEKDATA", 2},
+		{"PTRACE_PEEKSIGINFO", 16905},
+		{"PTRACE_PEEKSIGINFO_SHARED", 1},
+		{"PTRACE_PEEKTEXT", 1},
+		{"PTRACE_PEEKUSR", 3},
+		{"PTRACE_POKEDATA", 5},
+		{"PTRACE_POKETEXT", 4},
+		{"PTRACE_POKEUSR", 6},
+		{"PTRACE_SECCOMP_GET_FILTER", 16908},
+		{"PTRACE_SECCOMP_GET_METADATA", 16909},
+		{"PTRACE_SEIZE", 16902},
+		{"PTRACE_SETFPREGS", 15},
+		{"PTRACE_SETFPXREGS", 19},
+		{"PTRACE_SETOPTIONS", 16896},
+		{"PTRACE_SETREGS", 13},
+		{"PTRACE_SETREGSET", 16901},
+		{"PTRACE_SETSIGINFO", 16899},
+		{"PTRACE_SETSIGMASK", 16907},
+		{"PTRACE_SET_THREAD_AREA", 26},
+		{"PTRACE_SINGLEBLOCK", 33},
+		{"PTRACE_SINGLESTEP", 9},
+		{"PTRACE_SYSCALL", 24},
+		{"PTRACE_SYSCALL_INFO_ENTRY", 1},
+		{"PTRACE_SYSCALL_INFO_EXIT", 2},
+		{"PTRACE_SYSCALL_INFO_NONE", 0},
+		{"PTRACE_SYSCALL_INFO_SECCOMP", 3},
+		{"PTRACE_SYSEMU", 31},
+		{"PTRACE_SYSEMU_SINGLESTEP", 32},
+		{"PTRACE_TRACEME", 0},
+		{"RESOLVE_BENEATH", 8},
+		{"RESOLVE_CACHED", 32},
+		{"RESOLVE_IN_ROOT", 16},
+		{"RESOLVE_NO_MAGICLINKS", 2},
+		{"RESOLVE_NO_SYMLINKS", 4},
+		{"RESOLVE_NO_XDEV", 1},
+		{"RLIMIT_AS", 9},
+		{"RLIMIT_CORE", 4},
+		{"RLIMIT_CPU", 0},
+		{"RLIMIT_DATA", 2},
+		{"RLIMIT_FSIZE", 1},
+		{"RLIMIT_LOCKS", 10},
+		{"RLIMIT_MEMLOCK", 8},
+		{"RLIMIT_MSGQUEUE", 12},
+		{"RLIMIT_NICE", 13},
+		{"RLIMIT_NOFILE", 7},
+		{"RLIMIT_NPROC", 6},
+		{"RLIMIT_RSS", 5},
+		{"RLIMIT_RTPRIO", 14},
+		{"RLIMIT_RTTIME", 15},
+		{"RLIMIT_SIGPENDING", 11},
+		{"RLIMIT_STACK", 3},
+		{"RLIM_INFINITY", -0x1},
+		{"RLIM_NLIMITS", 16},
+		{"RTAX_ADVMSS", 8},
+		{"RTAX_CC_ALGO", 16},
+		{"RTAX_CWND", 7},
+		{"RTAX_FASTOPEN_NO_COOKIE", 17},
+		{"RTAX_FEATURES", 12},
+		{"RTAX_FEATURE_ALLFRAG", 8},
+		{"RTAX_FEATURE_ECN", 1},
+		{"RTAX_FEATURE_MASK", 15},
+		{"RTAX_FEATURE_SACK", 2},
+		{"RTAX_FEATURE_TIMESTAMP", 4},
+		{"RTAX_HOPLIMIT", 10},
+		{"RTAX_INITCWND", 11},
+		{"RTAX_INITRWND", 14},
+		{"RTAX_LOCK", 1},
+		{"RTAX_MAX", 17},
+		{"RTAX_MTU", 2},
+		{"RTAX_QUICKACK", 15},
+		{"RTAX_REORDERING", 9},
+		{"RTAX_RTO_MIN", 13},
+		{"RTAX_RTT", 4},
+		{"RTAX_RTTVAR", 5},
+		{"RTAX_SSTHRESH", 6},
+		{"RTAX_UNSPEC", 0},
+		{"RTAX_WINDOW", 3},
+		{"RTA_ALIGNTO", 4},
+		{"RTA_CACHEINFO", 12},
+		{"RTA_DPORT", 29},
+		{"RTA_DST", 1},
+		{"RTA_ENCAP", 22},
+		{"RTA_ENCAP_TYPE", 21},
+		{"RTA_EXPIRES", 23},
+		{"RTA_FLOW", 11},
+		{"RTA_GATEWAY", 5},
+		{"RTA_IIF", 3},
+		{"RTA_IP_PROTO", 27},
+		{"RTA_MARK", 16},
+		{"RTA_MAX", 30},
+		{"RTA_METRICS", 8},
+		{"RTA_MFC_STATS", 17},
+		{"RTA_MP_ALGO", 14},
+		{"RTA_MULTIPATH", 9},
+		{"RTA_NEWDST", 19},
+		{"RTA_NH_ID", 30},
+		{"RTA_OIF", 4},
+		{"RTA_PAD", 24},
+		{"RTA_PREF", 20},
+		{"RTA_PREFSRC", 7},
+		{"RTA_PRIORITY", 6},
+		{"RTA_PROTOINFO", 10},
+		{"RTA_SESSION", 13},
+		{"RTA_SPORT", 28},
+		{"RTA_SRC", 2},
+		{"RTA_TABLE", 15},
+		{"RTA_TTL_PROPAGATE", 26},
+		{"RTA_UID", 25},
+		{"RTA_UNSPEC", 0},
+		{"RTA_VIA", 18},
+		{"RTCF_BROADCAST", 0x10000000},
+		{"RTCF_DEAD", 1},
+		{"RTCF_DIRECTDST", 0x20000},
+		{"RTCF_DIRECTSRC", 0x4000000},
+		{"RTCF_DNAT", 0x8000000},
+		{"RTCF_DOREDIRECT", 0x1000000},
+		{"RTCF_FAST", 0x200000},
+		{"RTCF_LOCAL", 0x80000000},
+		{"RTCF_LOG", 0x2000000},
+		{"RTCF_MASQ", 0x400000},
+		{"RTCF_MULTICAST", 0x20000000},
+		{"RTCF_NAT", 0x8800000},
+		{"RTCF_NOTIFY", 0x10000},
+		{"RTCF_ONLINK", 4},
+		{"RTCF_REDIRECTED", 0x40000},
+		{"RTCF_REJECT", 0x40000000},
+		{"RTCF_SNAT", 0x800000},
+		{"RTCF_TPROXY", 0x80000},
+		{"RTCF_VALVE", 0x200000},
+		{"RTF_ADDRCLASSMASK", 0xF8000000},
+		{"RTF_ADDRCONF", 0x40000},
+		{"RTF_ALLONLINK", 0x20000},
+		{"RTF_ANYCAST", 0x100000},
+		{"RTF_BROADCAST", 0x10000000},
+		{"RTF_CACHE", 0x1000000},
+		{"RTF_DEFAULT", 0x10000},
+		{"RTF_DYNAMIC", 16},
+		{"RTF_EXPIRES", 0x400000},
+		{"RTF_FLOW", 0x2000000},
+		{"RTF_GATEWAY", 2},
+		{"RTF_HOST", 4},
+		{"RTF_INTERFACE", 0x40000000},
+		{"RTF_IRTT", 256},
+		{"RTF_LINKRT", 0x100000},
+		{"RTF_LOCAL", 0x80000000},
+		{"RTF_MODIFIED", 32},
+		{"RTF_MSS", 64},
+		{"RTF_MTU", 64},
+		{"RTF_MULTICAST", 0x20000000},
+		{"RTF_NAT", 0x8000000},
+		{"RTF_NOFORWARD", 4096},
+		{"RTF_NONEXTHOP", 0x200000},
+		{"RTF_NOPMTUDISC", 16384},
+		{"RTF_PCPU", 0x40000000},
+		{"RTF_POLICY", 0x4000000},
+		{"RTF_PREFIX_RT", 0x80000},
+		{"RTF_PREF_MASK", 0x18000000},
+		{"RTF_REINSTATE", 8},
+		{"RTF_REJECT", 512},
+		{"RTF_ROUTEINFO", 0x800000},
+		{"RTF_STATIC", 1024},
+		{"RTF_THROW", 8192},
+		{"RTF_UP", 1},
+		{"RTF_WINDOW", 128},
+		{"RTF_XRESOLVE", 2048},
+		{"RTM_BASE", 16},
+		{"RTM_DELACTION", 49},
+		{"RTM_DELADDR", 21},
+		{"RTM_DELADDRLABEL", 73},
+		{"RTM_DELCHAIN", 101},
+		{"RTM_DELLINK", 17},
+		{"RTM_DELLINKPROP", 109},
+		{"RTM_DELMDB", 85},
+		{"RTM_DELNEIGH", 29},
+		{"RTM_DELNETCONF", 81},
+		{"RTM_DELNEXTHOP", 105},
+		{"RTM_DELNEXTHOPBUCKET", 117},
+		{"RTM_DELNSID", 89},
+		{"RTM_DELQDISC", 37},
+		{"RTM_DELROUTE", 25},
+		{"RTM_DELRULE", 33},
+		{"RTM_DELTCLASS", 41},
+		{"RTM_DELTFILTER", 45},
+		{"RTM_DELTUNNEL", 121},
+		{"RTM_DELVLAN", 113},
+		{"RTM_F_CLONED", 512},
+		{"RTM_F_EQUALIZE", 1024},
+		{"RTM_F_FIB_MATCH", 8192},
+		{"RTM_F_LOOKUP_TABLE", 4096},
+		{"RTM_F_NOTIFY", 256},
+		{"RTM_F_OFFLOAD", 16384},
+		{"RTM_F_OFFLOAD_FAILED", 0x20000000},
+		{"RTM_F_PREFIX", 2048},
+		{"RTM_F_TRAP", 32768},
+		{"RTM_GETACTION", 50},
+		{"RTM_GETADDR", 22},
+		{"RTM_GETADDRLABEL", 74},
+		{"RTM_GETANYCAST", 62},
+		{"RTM_GETCHAIN", 102},
+		{"RTM_GETDCB", 78},
+		{"RTM_GETLINK", 18},
+		{"RTM_GETLINKPROP", 110},
+		{"RTM_GETMDB", 86},
+		{"RTM_GETMULTICAST", 58},
+		{"RTM_GETNEIGH", 30},
+		{"RTM_GETNEIGHTBL", 66},
+		{"RTM_GETNETCONF", 82},
+		{"RTM_GETNEXTHOP", 106},
+		{"RTM_GETNEXTHOPBUCKET", 118},
+		{"RTM_GETNSID", 90},
+		{"RTM_GETQDISC", 38},
+		{"RTM_GETROUTE", 26},
+		{"RTM_GETRULE", 34},
+		{"RTM_GETSTATS", 94},
+		{"RTM_GETTCLASS", 42},
+		{"RTM_GETTFILTER", 46},
+		{"RTM_GETTUNNEL", 122},
+		{"RTM_GETVLAN", 114},
+		{"RTM_MAX", 123},
+		{"RTM_NEWACTION", 48},
+		{"RTM_NEWADDR", 20},
+		{"RTM_NEWADDRLABEL", 72},
+		{"RTM_NEWCACHEREPORT", 96},
+		{"RTM_NEWCHAIN", 100},
+		{"RTM_NEWLINK", 16},
+		{"RTM_NEWLINKPROP", 108},
+		{"RTM_NEWMDB", 84},
+		{"RTM_NEWNDUSEROPT", 68},
+		{"RTM_NEWNEIGH", 28},
+		{"RTM_NEWNEIGHTBL", 64},
+		{"RTM_NEWNETCONF", 80},
+		{"RTM_NEWNEXTHOP", 104},
+		{"RTM_NEWNEXTHOPBUCKET", 116},
+		{"RTM_NEWNSID", 88},
+		{"RTM_NEWPREFIX", 52},
+		{"RTM_NEWQDISC", 36},
+		{"RTM_NEWROUTE", 24},
+		{"RTM_NEWRULE", 32},
+		{"RTM_NEWSTATS", 92},
+		{"RTM_NEWTCLASS", 40},
+		{"RTM_NEWTFILTER", 44},
+		{"RTM_NEWTUNNEL", 120},
+		{"RTM_NEWVLAN", 112},
+		{"RTM_NR_FAMILIES", 27},
+		{"RTM_NR_MSGTYPES", 108},
+		{"RTM_SETDCB", 79},
+		{"RTM_SETLINK", 19},
+		{"RTM_SETNEIGHTBL", 67},
+		{"RTM_SETSTATS", 95},
+		{"RTNH_ALIGNTO", 4},
+		{"RTNH_COMPARE_MASK", 89},
+		{"RTNH_F_DEAD", 1},
+		{"RTNH_F_LINKDOWN", 16},
+		{"RTNH_F_OFFLOAD", 8},
+		{"RTNH_F_ONLINK", 4},
+		{"RTNH_F_PERVASIVE", 2},
+		{"RTNH_F_TRAP", 64},
+		{"RTNH_F_UNRESOLVED", 32},
+		{"RTN_ANYCAST", 4},
+		{"RTN_BLACKHOLE", 6},
+		{"RTN_BROADCAST", 3},
+		{"RTN_LOCAL", 2},
+		{"RTN_MAX", 11},
+		{"RTN_MULTICAST", 5},
+		{"RTN_NAT", 10},
+		{"RTN_PROHIBIT", 8},
+		{"RTN_THROW", 9},
+		{"RTN_UNICAST", 1},
+		{"RTN_UNREACHABLE", 7},
+		{"RTN_UNSPEC", 0},
+		{"RTN_XRESOLVE", 11},
+		{"RTPROT_BABEL", 42},
+		{"RTPROT_BGP", 186},
+		{"RTPROT_BIRD", 12},
+		{"RTPROT_BOOT", 3},
+		{"RTPROT_DHCP", 16},
+		{"RTPROT_DNROUTED", 13},
+		{"RTPROT_EIGRP", 192},
+		{"RTPROT_GATED", 8},
+		{"RTPROT_ISIS", 187},
+		{"RTPROT_KEEPALIVED", 18},
+		{"RTPROT_KERNEL", 2},
+		{"RTPROT_MROUTED", 17},
+		{"RTPROT_MRT", 10},
+		{"RTPROT_NTK", 15},
+		{"RTPROT_OPENR", 99},
+		{"RTPROT_OSPF", 188},
+		{"RTPROT_RA", 9},
+		{"RTPROT_REDIRECT", 1},
+		{"RTPROT_RIP", 189},
+		{"RTPROT_STATIC", 4},
+		{"RTPROT_UNSPEC", 0},
+		{"RTPROT_XORP", 14},
+		{"RTPROT_ZEBRA", 11},
+		{"RT_CLASS_DEFAULT", 253},
+		{"RT_CLASS_LOCAL", 255},
+		{"RT_CLASS_MAIN", 254},
+		{"RT_CLASS_MAX", 255},
+		{"RT_CLASS_UNSPEC", 0},
+		{"RT_SCOPE_HOST", 254},
+		{"RT_SCOPE_LINK", 253},
+		{"RT_SCOPE_NOWHERE", 255},
+		{"RT_SCOPE_SITE", 200},
+		{"RT_SCOPE_UNIVERSE", 0},
+		{"RT_TABLE_COMPAT", 252},
+		{"RT_TABLE_DEFAULT", 253},
+		{"RT_TABLE_LOCAL", 255},
+		{"RT_TABLE_MAIN", 254},
+		{"RT_TABLE_MAX", 0xFFFFFFFF},
+		{"RT_TABLE_UNSPEC", 0},
+		{"RUSAGE_BOTH", -0x2},
+		{"RUSAGE_CHILDREN", -0x1},
+		{"RUSAGE_SELF", 0},
+		{"RUSAGE_THREAD", 1},
+		{"R_OK", 4},
+		{"SCHED_BATCH", 3},
+		{"SCHED_DEADLINE", 6},
+		{"SCHED_FIFO", 1},
+		{"SCHED_FLAG_ALL", 127},
+		{"SCHED_FLAG_DL_OVERRUN", 4},
+		{"SCHED_FLAG_KEEP_ALL", 24},
+		{"SCHED_FLAG_KEEP_PARAMS", 16},
+		{"SCHED_FLAG_KEEP_POLICY", 8},
+		{"SCHED_FLAG_RECLAIM", 2},
+		{"SCHED_FLAG_RESET_ON_FORK", 1},
+		{"SCHED_FLAG_UTIL_CLAMP", 96},
+		{"SCHED_FLAG_UTIL_CLAMP_MAX", 64},
+		{"SCHED_FLAG_UTIL_CLAMP_MIN", 32},
+		{"SCHED_IDLE", 5},
+		{"SCHED_NORMAL", 0},
+		{"SCHED_RESET_ON_FORK", 0x40000000},
+		{"SCHED_RR", 2},
+		{"SCM_CREDENTIALS", 2},
+		{"SCM_RIGHTS", 1},
+		{"SCM_TIMESTAMP", 29},
+		{"SCM_TIMESTAMPING", 37},
+		{"SCM_TIMESTAMPING_OPT_STATS", 54},
+		{"SCM_TIMESTAMPING_PKTINFO", 58},
+		{"SCM_TIMESTAMPNS", 35},
+		{"SCM_TXTIME", 61},
+		{"SCM_WIFI_STATUS", 41},
+		{"SECCOMP_ADDFD_FLAG_SEND", 2},
+		{"SECCOMP_ADDFD_FLAG_SETFD", 1},
+		{"SECCOMP_FILTER_FLAG_LOG", 2},
+		{"SECCOMP_FILTER_FLAG_NEW_LISTENER", 8},
+		{"SECCOMP_FILTER_FLAG_SPEC_ALLOW", 4},
+		{"SECCOMP_FILTER_FLAG_TSYNC", 1},
+		{"SECCOMP_FILTER_FLAG_TSYNC_ESRCH", 16},
+		{"SECCOMP_FILTER_FLAG_WAIT_KILLABLE_RECV", 32},
+		{"SECCOMP_GET_ACTION_AVAIL", 2},
+		{"SECCOMP_GET_NOTIF_SIZES", 3},
+		{"SECCOMP_IOCTL_NOTIF_ADDFD", 0x40182103},
+		{"SECCOMP_IOCTL_NOTIF_ID_VALID", 0x40082102},
+		{"SECCOMP_IOCTL_NOTIF_RECV", 0xC0502100},
+		{"SECCOMP_IOCTL_NOTIF_SEND", 0xC0182101},
+		{"SECCOMP_IOC_MAGIC", 33},
+		{"SECCOMP_MODE_DISABLED", 0},
+		{"SECCOMP_MODE_FILTER", 2},
+		{"SECCOMP_MODE_STRICT", 1},
+		{"SECCOMP_RET_ACTION", 0x7FFF0000},
+		{"SECCOMP_RET_ACTION_FULL", 0xFFFF0000},
+		{"SECCOMP_RET_ALLOW", 0x7FFF0000},
+		{"SECCOMP_RET_DATA", 65535},
+		{"SECCOMP_RET_ERRNO", 0x50000},
+		{"SECCOMP_RET_KILL", 0},
+		{"SECCOMP_RET_KILL_PROCESS", 0x80000000},
+		{"SECCOMP_RET_KILL_THREAD", 0},
+		{"SECCOMP_RET_LOG", 0x7FFC0000},
+		{"SECCOMP_RET_TRACE", 0x7FF00000},
+		{"SECCOMP_RET_TRAP", 0x30000},
+		{"SECCOMP_RET_USER_NOTIF", 0x7FC00000},
+		{"SECCOMP_SET_MODE_FILTER", 1},
+		{"SECCOMP_SET_MODE_STRICT", 0},
+		{"SECCOMP_USER_NOTIF_FLAG_CONTINUE", 1},
+		{"SHUT_RD", 0},
+		{"SHUT_RDWR", 2},
+		{"SHUT_WR", 1},
+		{"SIGABRT", 6},
+		{"SIGALRM", 14},
+		{"SIGBUS", 7},
+		{"SIGCHLD", 17},
+		{"SIGCLD", 17},
+		{"SIGCONT", 18},
+		{"SIGFPE", 8},
+		{"SIGHUP", 1},
+		{"SIGILL", 4},
+		{"SIGINT", 2},
+		{"SIGIO", 29},
+		{"SIGIOT", 6},
+		{"SIGKILL", 9},
+		{"SIGPIPE", 13},
+		{"SIGPOLL", 29},
+		{"SIGPROF", 27},
+		{"SIGPWR", 30},
+		{"SIGQUIT", 3},
+		{"SIGRTMIN", 32},
+		{"SIGSEGV", 11},
+		{"SIGSTKFLT", 16},
+		{"SIGSTKSZ", 8192},
+		{"SIGSTOP", 19},
+		{"SIGSYS", 31},
+		{"SIGTERM", 15},
+		{"SIGTRAP", 5},
+		{"SIGTSTP", 20},
+		{"SIGTTIN", 21},
+		{"SIGTTOU", 22},
+		{"SIGUNUSED", 31},
+		{"SIGURG", 23},
+		{"SIGUSR1", 10},
+		{"SIGUSR2", 12},
+		{"SIGVTALRM", 26},
+		{"SIGWINCH", 28},
+		{"SIGXCPU", 24},
+		{"SIGXFSZ", 25},
+		{"SIOCADDDLCI", 35200},
+		{"SIOCADDMULTI", 35121},
+		{"SIOCADDRT", 35083},
+		{"SIOCATMARK", 35077},
+		{"SIOCBONDCHANGEACTIVE", 35221},
+		{"SIOCBONDENSLAVE", 35216},
+		{"SIOCBONDINFOQUERY", 35220},
+		{"SIOCBONDRELEASE", 35217},
+		{"SIOCBONDSETHWADDR", 35218},
+		{"SIOCBONDSLAVEINFOQUERY", 35219},
+		{"SIOCBRADDBR", 35232},
+		{"SIOCBRADDIF", 35234},
+		{"SIOCBRDELBR", 35233},
+		{"SIOCBRDELIF", 35235},
+		{"SIOCDARP", 35155},
+		{"SIOCDELDLCI", 35201},
+		{"SIOCDELMULTI", 35122},
+		{"SIOCDELRT", 35084},
+		{"SIOCDEVPRIVATE", 35312},
+		{"SIOCDIFADDR", 35126},
+		{"SIOCDRARP", 35168},
+		{"SIOCETHTOOL", 35142},
+		{"SIOCGARP", 35156},
+		{"SIOCGHWTSTAMP", 35249},
+		{"SIOCGIFADDR", 35093},
+		{"SIOCGIFBR", 35136},
+		{"SIOCGIFBRDADDR", 35097},
+		{"SIOCGIFCONF", 35090},
+		{"SIOCGIFCOUNT", 35128},
+		{"SIOCGIFDSTADDR", 35095},
+		{"SIOCGIFENCAP", 35109},
+		{"SIOCGIFFLAGS", 35091},
+		{"SIOCGIFHWADDR", 35111},
+		{"SIOCGIFINDEX", 35123},
+		{"SIOCGIFMAP", 35184},
+		{"SIOCGIFMEM", 35103},
+		{"SIOCGIFMETRIC", 35101},
+		{"SIOCGIFMTU", 35105},
+		{"SIOCGIFNAME", 35088},
+		{"SIOCGIFNETMASK", 35099},
+		{"SIOCGIFPFLAGS", 35125},
+		{"SIOCGIFSLAVE", 35113},
+		{"SIOCGIFTXQLEN", 35138},
+		{"SIOCGIFVLAN", 35202},
+		{"SIOCGMIIPHY", 35143},
+		{"SIOCGMIIREG", 35144},
+		{"SIOCGPGRP", 35076},
+		{"SIOCGRARP", 35169},
+		{"SIOCGSKNS", 35148},
+		{"SIOCGSTAMP", 35078},
+		{"SIOCGSTAMPNS", 35079},
+		{"SIOCGSTAMPNS_NEW", 0x80108907},
+		{"SIOCGSTAMPNS_OLD", 35079},
+		{"SIOCGSTAMP_NEW", 0x80108906},
+		{"SIOCGSTAMP_OLD", 35078},
+		{"SIOCINQ", 21531},
+		{"SIOCOUTQ", 21521},
+		{"SIOCOUTQNSD", 35147},
+		{"SIOCPROTOPRIVATE", 35296},
+		{"SIOCRTMSG", 35085},
+		{"SIOCSARP", 35157},
+		{"SIOCSHWTSTAMP", 35248},
+		{"SIOCSIFADDR", 35094},
+		{"SIOCSIFBR", 35137},
+		{"SIOCSIFBRDADDR", 35098},
+		{"SIOCSIFDSTADDR", 35096},
+		{"SIOCSIFENCAP", 35110},
+		{"SIOCSIFFLAGS", 35092},
+		{"SIOCSIFHWADDR", 35108},
+		{"SIOCSIFHWBROADCAST", 35127},
+		{"SIOCSIFLINK", 35089},
+		{"SIOCSIFMAP", 35185},
+		{"SIOCSIFMEM", 35104},
+		{"SIOCSIFMETRIC", 35102},
+		{"SIOCSIFMTU", 35106},
+		{"SIOCSIFNAME", 35107},
+		{"SIOCSIFNETMASK", 35100},
+		{"SIOCSIFPFLAGS", 35124},
+		{"SIOCSIFSLAVE", 35120},
+		{"SIOCSIFTXQLEN", 35139},
+		{"SIOCSIFVLAN", 35203},
+		{"SIOCSMIIREG", 35145},
+		{"SIOCSPGRP", 35074},
+		{"SIOCSRARP", 35170},
+		{"SIOCWANDEV", 35146},
+		{"SOCK_CLOEXEC", 0x80000},
+		{"SOCK_DCCP", 6},
+		{"SOCK_DGRAM", 2},
+		{"SOCK_NONBLOCK", 2048},
+		{"SOCK_PACKET", 10},
+		{"SOCK_RAW", 3},
+		{"SOCK_RDM", 4},
+		{"SOCK_SEQPACKET", 5},
+		{"SOCK_STREAM", 1},
+		{"SOL_AAL", 265},
+		{"SOL_ALG", 279},
+		{"SOL_ATM", 264},
+		{"SOL_BLUETOOTH", 274},
+		{"SOL_CAIF", 278},
+		{"SOL_DCCP", 269},
+		{"SOL_DECNET", 261},
+		{"SOL_ICMPV6", 58},
+		{"SOL_IP", 0},
+		{"SOL_IPV6", 41},
+		{"SOL_IRDA", 266},
+		{"SOL_IUCV", 277},
+		{"SOL_KCM", 281},
+		{"SOL_LLC", 268},
+		{"SOL_MCTP", 285},
+		{"SOL_MPTCP", 284},
+		{"SOL_NETBEUI", 267},
+		{"SOL_NETLINK", 270},
+		{"SOL_NFC", 280},
+		{"SOL_PACKET", 263},
+		{"SOL_PNPIPE", 275},
+		{"SOL_PPPOL2TP", 273},
+		{"SOL_RAW", 255},
+		{"SOL_RDS", 276},
+		{"SOL_RXRPC", 272},
+		{"SOL_SMC", 286},
+		{"SOL_SOCKET", 1},
+		{"SOL_TCP", 6},
+		{"SOL_TIPC", 271},
+		{"SOL_TLS", 282},
+		{"SOL_X25", 262},
+		{"SOL_XDP", 283},
+		{"SOMAXCONN", 4096},
+		{"SO_ACCEPTCONN", 30},
+		{"SO_ATTACH_BPF", 50},
+		{"SO_ATTACH_FILTER", 26},
+		{"SO_ATTACH_REUSEPORT_CBPF", 51},
+		{"SO_ATTACH_REUSEPORT_EBPF", 52},
+		{"SO_BINDTODEVICE", 25},
+		{"SO_BINDTOIFINDEX", 62},
+		{"SO_BPF_EXTENSIONS", 48},
+		{"SO_BROADCAST", 6},
+		{"SO_BSDCOMPAT", 14},
+		{"SO_BUF_LOCK", 72},
+		{"SO_BUSY_POLL", 46},
+		{"SO_BUSY_POLL_BUDGET", 70},
+		{"SO_CNX_ADVICE", 53},
+		{"SO_COOKIE", 57},
+		{"SO_DEBUG", 1},
+		{"SO_DETACH_BPF", 27},
+		{"SO_DETACH_FILTER", 27},
+		{"SO_DETACH_REUSEPORT_BPF", 68},
+		{"SO_DOMAIN", 39},
+		{"SO_DONTROUTE", 5},
+		{"SO_ERROR", 4},
+		{"SO_GET_FILTER", 26},
+		{"SO_INCOMING_CPU", 49},
+		{"SO_INCOMING_NAPI_ID", 56},
+		{"SO_KEEPALIVE", 9},
+		{"SO_LINGER", 13},
+		{"SO_LOCK_FILTER", 44},
+		{"SO_MARK", 36},
+		{"SO_MAX_PACING_RATE", 47},
+		{"SO_MEMINFO", 55},
+		{"SO_NETNS_COOKIE", 71},
+		{"SO_NOFCS", 43},
+		{"SO_NO_CHECK", 11},
+		{"SO_OOBINLINE", 10},
+		{"SO_PASSCRED", 16},
+		{"SO_PASSSEC", 34},
+		{"SO_PEEK_OFF", 42},
+		{"SO_PEERCRED", 17},
+		{"SO_PEERGROUPS", 59},
+		{"SO_PEERNAME", 28},
+		{"SO_PEERSEC", 31},
+		{"SO_PREFER_BUSY_POLL", 69},
+		{"SO_PRIORITY", 12},
+		{"SO_PROTOCOL", 38},
+		{"SO_RCVBUF", 8},
+		{"SO_RCVBUFFORCE", 33},
+		{"SO_RCVLOWAT", 18},
+		{"SO_RCVMARK", 75},
+		{"SO_RCVTIMEO", 20},
+		{"SO_RCVTIMEO_NEW", 66},
+		{"SO_RCVTIMEO_OLD", 20},
+		{"SO_RESERVE_MEM", 73},
+		{"SO_REUSEADDR", 2},
+		{"SO_REUSEPORT", 15},
+		{"SO_RXQ_OVFL", 40},
+		{"SO_SECURITY_AUTHENTICATION", 22},
+		{"SO_SECURITY_ENCRYPTION_NETWORK", 24},
+		{"SO_SECURITY_ENCRYPTION_TRANSPORT", 23},
+		{"SO_SELECT_ERR_QUEUE", 45},
+		{"SO_SNDBUF", 7},
+		{"SO_SNDBUFFORCE", 32},
+		{"SO_SNDLOWAT", 19},
+		{"SO_SNDTIMEO", 21},
+		{"SO_SNDTIMEO_NEW", 67},
+		{"SO_SNDTIMEO_OLD", 21},
+		{"SO_TIMESTAMP", 29},
+		{"SO_TIMESTAMPING", 37},
+		{"SO_TIMESTAMPING_NEW", 65},
+		{"SO_TIMESTAMPING_OLD", 37},
+		{"SO_TIMESTAMPNS", 35},
+		{"SO_TIMESTAMPNS_NEW", 64},
+		{"SO_TIMESTAMPNS_OLD", 35},
+		{"SO_TIMESTAMP_NEW", 63},
+		{"SO_TIMESTAMP_OLD", 29},
+		{"SO_TXREHASH", 74},
+		{"SO_TXTIME", 61},
+		{"SO_TYPE", 3},
+		{"SO_WIFI_STATUS", 41},
+		{"SO_ZEROCOPY", 60},
+		{"STATX_ALL", 4095},
+		{"STATX_ATIME", 32},
+		{"STATX_ATTR_APPEND", 32},
+		{"STATX_ATTR_AUTOMOUNT", 4096},
+		{"STATX_ATTR_COMPRESSED", 4},
+		{"STATX_ATTR_DAX", 0x200000},
+		{"STATX_ATTR_ENCRYPTED", 2048},
+		{"STATX_ATTR_IMMUTABLE", 16},
+		{"STATX_ATTR_MOUNT_ROOT", 8192},
+		{"STATX_ATTR_NODUMP", 64},
+		{"STATX_ATTR_VERITY", 0x100000},
+		{"STATX_BASIC_STATS", 2047},
+		{"STATX_BLOCKS", 1024},
+		{"STATX_BTIME", 2048},
+		{"STATX_CTIME", 128},
+		{"STATX_DIOALIGN", 8192},
+		{"STATX_GID", 16},
+		{"STATX_INO", 256},
+		{"STATX_MNT_ID", 4096},
+		{"STATX_MODE", 2},
+		{"STATX_MTIME", 64},
+		{"STATX_NLINK", 4},
+		{"STATX_SIZE", 512},
+		{"STATX_TYPE", 1},
+		{"STATX_UID", 8},
+		{"STATX__RESERVED", 0x80000000},
+		{"S_BLKSIZE", 512},
+		{"S_IEXEC", 64},
+		{"S_IFBLK", 24576},
+		{"S_IFCHR", 8192},
+		{"S_IFDIR", 16384},
+		{"S_IFIFO", 4096},
+		{"S_IFLNK", 40960},
+		{"S_IFMT", 61440},
+		{"S_IFREG", 32768},
+		{"S_IFSOCK", 49152},
+		{"S_IREAD", 256},
+		{"S_IRGRP", 32},
+		{"S_IROTH", 4},
+		{"S_IRUSR", 256},
+		{"S_IRWXG", 56},
+		{"S_IRWXO", 7},
+		{"S_IRWXU", 448},
+		{"S_ISGID", 1024},
+		{"S_ISUID", 2048},
+		{"S_ISVTX", 512},
+		{"S_IWGRP", 16},
+		{"S_IWOTH", 2},
+		{"S_IWRITE", 128},
+		{"S_IWUSR", 128},
+		{"S_IXGRP", 8},
+		{"S_IXOTH", 1},
+		{"S_IXUSR", 64},
+		{"TCFLSH", 21515},
+		{"TCGETA", 21509},
+		{"TCGETS", 21505},
+		{"TCGETS2", 0x802C542A},
+		{"TCGETX", 21554},
+		{"TCIFLUSH", 0},
+		{"TCIOFF", 2},
+		{"TCIOFLUSH", 2},
+		{"TCION", 3},
+		{"TCOFLUSH", 1},
+		{"TCOOFF", 0},
+		{"TCOON", 1},
+		{"TCP_CA_CWR", 2},
+		{"TCP_CA_Disorder", 1},
+		{"TCP_CA_Loss", 4},
+		{"TCP_CA_Open", 0},
+		{"TCP_CA_Recovery", 3},
+		{"TCP_CC_INFO", 26},
+		{"TCP_CLOSE", 7},
+		{"TCP_CLOSE_WAIT", 8},
+		{"TCP_CLOSING", 11},
+		{"TCP_CM_INQ", 36},
+		{"TCP_CONGESTION", 13},
+		{"TCP_COOKIE_IN_ALWAYS", 1},
+		{"TCP_COOKIE_MAX", 16},
+		{"TCP_COOKIE_MIN", 8},
+		{"TCP_COOKIE_OUT_NEVER", 2},
+		{"TCP_COOKIE_PAIR_SIZE", 32},
+		{"TCP_COOKIE_TRANSACTIONS", 15},
+		{"TCP_CORK", 3},
+		{"TCP_DATA_OFFSET", 240},
+		{"TCP_DEFER_ACCEPT", 9},
+		{"TCP_ESTABLISHED", 1},
+		{"TCP_FASTOPEN", 23},
+		{"TCP_FASTOPEN_CONNECT", 30},
+		{"TCP_FASTOPEN_KEY", 33},
+		{"TCP_FASTOPEN_NO_COOKIE", 34},
+		{"TCP_FIN_WAIT1", 4},
+		{"TCP_FIN_WAIT2", 5},
+		{"TCP_FLAG_ACK", 4096},
+		{"TCP_FLAG_CWR", 32768},
+		{"TCP_FLAG_ECE", 16384},
+		{"TCP_FLAG_FIN", 256},
+		{"TCP_FLAG_PSH", 2048},
+		{"TCP_FLAG_RST", 1024},
+		{"TCP_FLAG_SYN", 512},
+		{"TCP_FLAG_URG", 8192},
+		{"TCP_INFO", 11},
+		{"TCP_INQ", 36},
+		{"TCP_KEEPCNT", 6},
+		{"TCP_KEEPIDLE", 4},
+		{"TCP_KEEPINTVL", 5},
+		{"TCP_LAST_ACK", 9},
+		{"TCP_LINGER2", 8},
+		{"TCP_LISTEN", 10},
+		{"TCP_MAXSEG", 2},
+		{"TCP_MAXWIN", 65535},
+		{"TCP_MAX_WINSHIFT", 14},
+		{"TCP_MD5SIG", 14},
+		{"TCP_MD5SIG_EXT", 32},
+		{"TCP_MD5SIG_FLAG_IFINDEX", 2},
+		{"TCP_MD5SIG_FLAG_PREFIX", 1},
+		{"TCP_MD5SIG_MAXKEYLEN", 80},
+		{"TCP_MSS", 512},
+		{"TCP_MSS_DEFAULT", 536},
+		{"TCP_MSS_DESIRED", 1220},
+		{"TCP_NLA_BUSY", 1},
+		{"TCP_NLA_BYTES_NOTSENT", 24},
+		{"TCP_NLA_BYTES_RETRANS", 19},
+		{"TCP_NLA_BYTES_SENT", 18},
+		{"TCP_NLA_CA_STATE", 14},
+		{"TCP_NLA_DATA_SEGS_OUT", 4},
+		{"TCP_NLA_DELIVERED", 16},
+		{"TCP_NLA_DELIVERED_CE", 17},
+		{"TCP_NLA_DELIVERY_RATE", 7},
+		{"TCP_NLA_DELIVERY_RATE_APP_LMT", 12},
+		{"TCP_NLA_DSACK_DUPS", 20},
+		{"TCP_NLA_EDT", 25},
+		{"TCP_NLA_MIN_RTT", 10},
+		{"TCP_NLA_PACING_RATE", 6},
+		{"TCP_NLA_PAD", 0},
+		{"TCP_NLA_RECUR_RETRANS", 11},
+		{"TCP_NLA_REORDERING", 9},
+		{"TCP_NLA_REORD_SEEN", 21},
+		{"TCP_NLA_RWND_LIMITED", 2},
+		{"TCP_NLA_SNDBUF_LIMITED", 3},
+		{"TCP_NLA_SNDQ_SIZE", 13},
+		{"TCP_NLA_SND_CWND", 8},
+		{"TCP_NLA_SND_SSTHRESH", 15},
+		{"TCP_NLA_SRTT", 22},
+		{"TCP_NLA_TIMEOUT_REHASH", 23},
+		{"TCP_NLA_TOTAL_RETRANS", 5},
+		{"TCP_NLA_TTL", 26},
+		{"TCP_NODELAY", 1},
+		{"TCP_NOTSENT_LOWAT", 25},
+		{"TCP_NO_QUEUE", 0},
+		{"TCP_QUEUES_NR", 3},
+		{"TCP_QUEUE_SEQ", 21},
+		{"TCP_QUICKACK", 12},
+		{"TCP_RECEIVE_ZEROCOPY_FLAG_TLB_CLEAN_HINT", 1},
+		{"TCP_RECV_QUEUE", 1},
+		{"TCP_REPAIR", 19},
+		{"TCP_REPAIR_OFF", 0},
+		{"TCP_REPAIR_OFF_NO_WP", -0x1},
+		{"TCP_REPAIR_ON", 1},
+		{"TCP_REPAIR_OPTIONS", 22},
+		{"TCP_REPAIR_QUEUE", 20},
+		{"TCP_REPAIR_WINDOW", 29},
+		{"TCP_RESERVED_BITS", 15},
+		{"TCP_SAVED_SYN", 28},
+		{"TCP_SAVE_SYN", 27},
+		{"TCP_SEND_QUEUE", 2},
+		{"TCP_SYNCNT", 7},
+		{"TCP_SYN_RECV", 3},
+		{"TCP_SYN_SENT", 2},
+		{"TCP_S_DATA_IN", 4},
+		{"TCP_S_DATA_OUT", 8},
+		{"TCP_THIN_DUPACK", 17},
+		{"TCP_THIN_LINEAR_TIMEOUTS", 16},
+		{"TCP_TIMESTAMP", 24},
+		{"TCP_TIME_WAIT", 6},
+		{"TCP_TX_DELAY", 37},
+		{"TCP_ULP", 31},
+		{"TCP_USER_TIMEOUT", 18},
+		{"TCP_WINDOW_CLAMP", 10},
+		{"TCP_ZEROCOPY_RECEIVE", 35},
+		{"TCSADRAIN", 1},
+		{"TCSAFLUSH", 2},
+		{"TCSANOW", 0},
+		{"TCSBRK", 21513},
+		{"TCSBRKP", 21541},
+		{"TCSETA", 21510},
+		{"TCSETAF", 21512},
+		{"TCSETAW", 21511},
+		{"TCSETS", 21506},
+		{"TCSETS2", 0x402C542B},
+		{"TCSETSF", 21508},
+		{"TCSETSF2", 0x402C542D},
+		{"TCSETSW", 21507},
+		{"TCSETSW2", 0x402C542C},
+		{"TCSETX", 21555},
+		{"TCSETXF", 21556},
+		{"TCSETXW", 21557},
+		{"TCXONC", 21514},
+		{"TIOCCBRK", 21544},
+		{"TIOCCONS", 21533},
+		{"TIOCEXCL", 21516},
+		{"TIOCGDEV", 0x80045432},
+		{"TIOCGETD", 21540},
+		{"TIOCGEXCL", 0x80045440},
+		{"TIOCGICOUNT", 21597},
+		{"TIOCGISO7816", 0x80285442},
+		{"TIOCGLCKTRMIOS", 21590},
+		{"TIOCGPGRP", 21519},
+		{"TIOCGPKT", 0x80045438},
+		{"TIOCGPTLCK", 0x80045439},
+		{"TIOCGPTN", 0x80045430},
+		{"TIOCGPTPEER", 21569},
+		{"TIOCGRS485", 21550},
+		{"TIOCGSERIAL", 21534},
+		{"TIOCGSID", 21545},
+		{"TIOCGSOFTCAR", 21529},
+		{"TIOCGWINSZ", 21523},
+		{"TIOCINQ", 21531},
+		{"TIOCLINUX", 21532},
+		{"TIOCMBIC", 21527},
+		{"TIOCMBIS", 21526},
+		{"TIOCMGET", 21525},
+		{"TIOCMIWAIT", 21596},
+		{"TIOCMSET", 21528},
+		{"TIOCM_CAR", 64},
+		{"TIOCM_CD", 64},
+		{"TIOCM_CTS", 32},
+		{"TIOCM_DSR", 256},
+		{"TIOCM_DTR", 2},
+		{"TIOCM_LE", 1},
+		{"TIOCM_LOOP", 32768},
+		{"TIOCM_OUT1", 8192},
+		{"TIOCM_OUT2", 16384},
+		{"TIOCM_RI", 128},
+		{"TIOCM_RNG", 128},
+		{"TIOCM_RTS", 4},
+		{"TIOCM_SR", 16},
+		{"TIOCM_ST", 8},
+		{"TIOCNOTTY", 21538},
+		{"TIOCNXCL", 21517},
+		{"TIOCOUTQ", 21521},
+		{"TIOCPKT", 21536},
+		{"TIOCPKT_DATA", 0},
+		{"TIOCPKT_DOSTOP", 32},
+		{"TIOCPKT_FLUSHREAD", 1},
+		{"TIOCPKT_FLUSHWRITE", 2},
+		{"TIOCPKT_IOCTL", 64},
+		{"TIOCPKT_NOSTOP", 16},
+		{"TIOCPKT_START", 8},
+		{"TIOCPKT_STOP", 4},
+		{"TIOCSBRK", 21543},
+		{"TIOCSCTTY", 21518},
+		{"TIOCSERCONFIG", 21587},
+		{"TIOCSERGETLSR", 21593},
+		{"TIOCSERGETMULTI", 21594},
+		{"TIOCSERGSTRUCT", 21592},
+		{"TIOCSERGWILD", 21588},
+		{"TIOCSERSETMULTI", 21595},
+		{"TIOCSERSWILD", 21589},
+		{"TIOCSER_TEMT", 1},
+		{"TIOCSETD", 21539},
+		{"TIOCSIG", 0x40045436},
+		{"TIOCSISO7816", 0xC0285443},
+		{"TIOCSLCKTRMIOS", 21591},
+		{"TIOCSPGRP", 21520},
+		{"TIOCSPTLCK", 0x40045431},
+		{"TIOCSRS485", 21551},
+		{"TIOCSSERIAL", 21535},
+		{"TIOCSSOFTCAR", 21530},
+		{"TIOCSTI", 21522},
+		{"TIOCSWINSZ", 21524},
+		{"TIOCVHANGUP", 21559},
+		{"TUNATTACHFILTER", 0x401054D5},
+		{"TUNDETACHFILTER", 0x401054D6},
+		{"TUNGETDEVNETNS", 21731},
+		{"TUNGETFEATURES", 0x800454CF},
+		{"TUNGETFILTER", 0x801054DB},
+		{"TUNGETIFF", 0x800454D2},
+		{"TUNGETSNDBUF", 0x800454D3},
+		{"TUNGETVNETBE", 0x800454DF},
+		{"TUNGETVNETHDRSZ", 0x800454D7},
+		{"TUNGETVNETLE", 0x800454DD},
+		{"TUNSETCARRIER", 0x400454E2},
+		{"TUNSETDEBUG", 0x400454C9},
+		{"TUNSETFILTEREBPF", 0x800454E1},
+		{"TUNSETGROUP", 0x400454CE},
+		{"TUNSETIFF", 0x400454CA},
+		{"TUNSETIFINDEX", 0x400454DA},
+		{"TUNSETLINK", 0x400454CD},
+		{"TUNSETNOCSUM", 0x400454C8},
+		{"TUNSETOFFLOAD", 0x400454D0},
+		{"TUNSETOWNER", 0x400454CC},
+		{"TUNSETPERSIST", 0x400454CB},
+		{"TUNSETQUEUE", 0x400454D9},
+		{"TUNSETSNDBUF", 0x400454D4},
+		{"TUNSETSTEERINGEBPF", 0x800454E0},
+		{"TUNSETTXFILTER", 0x400454D1},
+		{"TUNSETVNETBE", 0x400454DE},
+		{"TUNSETVNETHDRSZ", 0x400454D8},
+		{"TUNSETVNETLE", 0x400454DC},
+		{"TUN_FLT_ALLMULTI", 1},
+		{"TUN_F_CSUM", 1},
+		{"TUN_F_TSO4", 2},
+		{"TUN_F_TSO6", 4},
+		{"TUN_F_TSO_ECN", 8},
+		{"TUN_F_UFO", 16},
+		{"TUN_PKT_STRIP", 1},
+		{"TUN_READQ_SIZE", 500},
+		{"TUN_TAP_DEV", 2},
+		{"TUN_TUN_DEV", 1},
+		{"TUN_TX_TIMESTAMP", 1},
+		{"TUN_TYPE_MASK", 15},
+		{"WALL", 0x40000000},
+		{"WCLONE", 0x80000000},
+		{"WCONTINUED", 8},
+		{"WEXITED", 4},
+		{"WNOHANG", 1},
+		{"WNOTHREAD", 0x20000000},
+		{"WNOWAIT", 0x1000000},
+		{"WORDSIZE", 64},
+		{"WSTOPPED", 2},
+		{"WUNTRACED", 2},
+		{"W_OK", 2},
+		{"XCASE", 4},
+		{"X_OK", 1},
+	},
+}
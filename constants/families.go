@@ -0,0 +1,197 @@
+package constants
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Family is a group of constants that are used for the same purpose, such as the address families or the flags of open.
+// A constant belongs to the first family whose pattern matches its name.
+type Family struct {
+	Name    string
+	Pattern *regexp.Regexp
+}
+
+func family(name, pattern string) Family {
+	return Family{Name: name, Pattern: regexp.MustCompile(pattern)}
+}
+
+// Families contain all the known families of constants. Constants that don't match any of them, such as the errors,
+// don't belong to a family. The more specific patterns come first, so that for example F_OK is an access mode
+// and not a fcntl command.
+var Families = []Family{
+	family("*_OK", `^[RWXF]_OK$`),
+	family("AF_*", `^AF_`),
+	family("SOCK_*", `^SOCK_`),
+	family("IPPROTO_*", `^IPPROTO_`),
+	family("ETH_P_*", `^ETH_P_`),
+	family("NETLINK_*", `^NETLINK_`),
+	family("SOL_*", `^SOL_`),
+	family("SO_*", `^SO_`),
+	family("IP_*", `^IP_`),
+	family("IPV6_*", `^IPV6_`),
+	family("TCP_*", `^TCP_`),
+	family("PACKET_*", `^PACKET_`),
+	family("MSG_*", `^MSG_`),
+	family("SHUT_*", `^SHUT_`),
+	family("O_*", `^O_`),
+	family("AT_*", `^AT_`),
+	family("S_I*", `^S_I`),
+	family("F_*", `^F_`),
+	family("LOCK_*", `^LOCK_`),
+	family("PROT_*", `^PROT_`),
+	family("MAP_*", `^MAP_`),
+	family("MADV_*", `^MADV_`),
+	family("MREMAP_*", `^MREMAP_`),
+	family("MCL_*", `^MCL_`),
+	family("MLOCK_*", `^MLOCK_`),
+	family("MFD_*", `^MFD_`),
+	family("MS_*", `^MS_`),
+	family("MNT_*", `^MNT_`),
+	family("CLONE_*", `^CLONE_`),
+	family("SIG*", `^SIG[A-Z0-9]+$`),
+	family("PR_*", `^PR_`),
+	family("ARCH_*", `^ARCH_`),
+	family("PTRACE_*", `^PTRACE_`),
+	family("W*", `^W[A-Z]+$`),
+	family("RLIMIT_*", `^RLIMIT_`),
+	family("PRIO_*", `^PRIO_`),
+	family("RUSAGE_*", `^RUSAGE_`),
+	family("LINUX_REBOOT_*", `^LINUX_REBOOT_`),
+	family("EPOLL_CTL_*", `^EPOLL_CTL_`),
+	family("EPOLL*", `^EPOLL`),
+	family("IN_*", `^IN_`),
+	family("FUTEX_*", `^FUTEX_`),
+	family("GRND_*", `^GRND_`),
+	family("SECCOMP_*", `^SECCOMP_`),
+	family("CLOSE_RANGE_*", `^CLOSE_RANGE_`),
+	family("LANDLOCK_*", `^LANDLOCK_`),
+	family("STATX_*", `^STATX_`),
+	family("SCHED_*", `^SCHED_`),
+}
+
+// FamilyOf returns the name of the family the given constant belongs to, if any
+func FamilyOf(name string) (string, bool) {
+	nm := strings.ToUpper(name)
+	for _, f := range Families {
+		if f.Pattern.MatchString(nm) {
+			return f.Name, true
+		}
+	}
+	return "", false
+}
+
+// ArgumentFamilies contain the families of the constants each syscall argument can be compared with, by syscall and
+// argument name. Arguments that aren't listed can be compared with any constant.
+var ArgumentFamilies = map[string]map[string][]string{
+	"socket":                  {"domain": {"AF_*"}, "type": {"SOCK_*"}, "protocol": {"IPPROTO_*", "ETH_P_*", "NETLINK_*"}},
+	"socketpair":              {"domain": {"AF_*"}, "type": {"SOCK_*"}, "protocol": {"IPPROTO_*"}},
+	"accept4":                 {"flags": {"SOCK_*"}},
+	"setsockopt":              {"level": {"SOL_*", "IPPROTO_*"}, "optname": {"SO_*", "IP_*", "IPV6_*", "TCP_*", "PACKET_*", "NETLINK_*"}},
+	"getsockopt":              {"level": {"SOL_*", "IPPROTO_*"}, "optname": {"SO_*", "IP_*", "IPV6_*", "TCP_*", "PACKET_*", "NETLINK_*"}},
+	"sendto":                  {"flags": {"MSG_*"}},
+	"recvfrom":                {"flags": {"MSG_*"}},
+	"sendmsg":                 {"flags": {"MSG_*"}},
+	"recvmsg":                 {"flags": {"MSG_*"}},
+	"sendmmsg":                {"flags": {"MSG_*"}},
+	"recvmmsg":                {"flags": {"MSG_*"}},
+	"shutdown":                {"how": {"SHUT_*"}},
+	"open":                    {"flags": {"O_*"}, "mode": {"S_I*"}},
+	"openat":                  {"dirfd": {"AT_*"}, "flags": {"O_*"}, "mode": {"S_I*"}},
+	"creat":                   {"mode": {"S_I*"}},
+	"mkdir":                   {"mode": {"S_I*"}},
+	"mkdirat":                 {"dirfd": {"AT_*"}, "mode": {"S_I*"}},
+	"mknod":                   {"mode": {"S_I*"}},
+	"mknodat":                 {"dirfd": {"AT_*"}, "mode": {"S_I*"}},
+	"chmod":                   {"mode": {"S_I*"}},
+	"fchmod":                  {"mode": {"S_I*"}},
+	"fchmodat":                {"dirfd": {"AT_*"}, "mode": {"S_I*"}, "flags": {"AT_*"}},
+	"umask":                   {"mask": {"S_I*"}},
+	"access":                  {"mode": {"*_OK"}},
+	"faccessat":               {"dirfd": {"AT_*"}, "mode": {"*_OK"}, "flags": {"AT_*"}},
+	"faccessat2":              {"dirfd": {"AT_*"}, "mode": {"*_OK"}, "flags": {"AT_*"}},
+	"newfstatat":              {"dirfd": {"AT_*"}, "flags": {"AT_*"}},
+	"statx":                   {"dirfd": {"AT_*"}, "flags": {"AT_*"}, "mask": {"STATX_*"}},
+	"unlinkat":                {"dirfd": {"AT_*"}, "flags": {"AT_*"}},
+	"linkat":                  {"olddirfd": {"AT_*"}, "newdirfd": {"AT_*"}, "flags": {"AT_*"}},
+	"renameat":                {"olddirfd": {"AT_*"}, "newdirfd": {"AT_*"}},
+	"renameat2":               {"olddirfd": {"AT_*"}, "newdirfd": {"AT_*"}},
+	"fchownat":                {"dirfd": {"AT_*"}, "flags": {"AT_*"}},
+	"utimensat":               {"dirfd": {"AT_*"}, "flags": {"AT_*"}},
+	"futimesat":               {"dirfd": {"AT_*"}},
+	"readlinkat":              {"dirfd": {"AT_*"}},
+	"symlinkat":               {"newdirfd": {"AT_*"}},
+	"execveat":                {"dirfd": {"AT_*"}, "flags": {"AT_*"}},
+	"openat2":                 {"dirfd": {"AT_*"}},
+	"fcntl":                   {"cmd": {"F_*"}},
+	"flock":                   {"operation": {"LOCK_*"}},
+	"pipe2":                   {"flags": {"O_*"}},
+	"dup3":                    {"flags": {"O_*"}},
+	"inotify_init1":           {"flags": {"IN_*"}},
+	"inotify_add_watch":       {"mask": {"IN_*"}},
+	"mmap":                    {"prot": {"PROT_*"}, "flags": {"MAP_*"}},
+	"mprotect":                {"prot": {"PROT_*"}},
+	"pkey_mprotect":           {"prot": {"PROT_*"}},
+	"madvise":                 {"advice": {"MADV_*"}},
+	"process_madvise":         {"advice": {"MADV_*"}},
+	"mremap":                  {"flags": {"MREMAP_*"}},
+	"mlockall":                {"flags": {"MCL_*"}},
+	"mlock2":                  {"flags": {"MLOCK_*"}},
+	"msync":                   {"flags": {"MS_*"}},
+	"memfd_create":            {"flags": {"MFD_*"}},
+	"mount":                   {"mountflags": {"MS_*"}},
+	"umount2":                 {"flags": {"MNT_*"}},
+	"clone":                   {"flags": {"CLONE_*", "SIG*"}},
+	"unshare":                 {"flags": {"CLONE_*"}},
+	"setns":                   {"nstype": {"CLONE_*"}},
+	"kill":                    {"sig": {"SIG*"}},
+	"tkill":                   {"sig": {"SIG*"}},
+	"tgkill":                  {"sig": {"SIG*"}},
+	"rt_sigaction":            {"signum": {"SIG*"}},
+	"rt_sigqueueinfo":         {"sig": {"SIG*"}},
+	"pidfd_send_signal":       {"sig": {"SIG*"}},
+	"prctl":                   {"option": {"PR_*"}},
+	"arch_prctl":              {"code": {"ARCH_*"}},
+	"ptrace":                  {"request": {"PTRACE_*"}},
+	"wait4":                   {"options": {"W*"}},
+	"waitid":                  {"options": {"W*"}},
+	"getrlimit":               {"resource": {"RLIMIT_*"}},
+	"setrlimit":               {"resource": {"RLIMIT_*"}},
+	"prlimit64":               {"resource": {"RLIMIT_*"}},
+	"getpriority":             {"which": {"PRIO_*"}},
+	"setpriority":             {"which": {"PRIO_*"}},
+	"getrusage":               {"who": {"RUSAGE_*"}},
+	"reboot":                  {"magic": {"LINUX_REBOOT_*"}, "magic2": {"LINUX_REBOOT_*"}, "cmd": {"LINUX_REBOOT_*"}},
+	"epoll_create1":           {"flags": {"EPOLL*"}},
+	"epoll_ctl":               {"op": {"EPOLL_CTL_*"}},
+	"futex":                   {"futex_op": {"FUTEX_*"}},
+	"getrandom":               {"flags": {"GRND_*"}},
+	"seccomp":                 {"operation": {"SECCOMP_*"}, "flags": {"SECCOMP_*"}},
+	"close_range":             {"flags": {"CLOSE_RANGE_*"}},
+	"sched_setscheduler":      {"policy": {"SCHED_*"}},
+	"landlock_create_ruleset": {"flags": {"LANDLOCK_*"}},
+	"landlock_add_rule":       {"rule_type": {"LANDLOCK_*"}},
+}
+
+// GetArgumentFamilies returns the families of the constants the argument with the given index can be compared with,
+// if they are known. Names created by RawSyscallName refer to the syscall with that number
+func GetArgumentFamilies(syscall string, index int) ([]string, bool) {
+	sig, ok := GetSyscallSignature(syscall)
+	if !ok || index < 0 || index >= len(sig) {
+		return nil, false
+	}
+	if nr, ok := rawSyscallNumber(syscall); ok {
+		syscall = SyscallNumbers[int(nr)]
+	}
+	res, ok := ArgumentFamilies[strings.ToLower(syscall)][sig[index].Name]
+	return res, ok
+}
+
+// GetArgumentFamilies returns the families of the constants the argument with the given index can be compared with
+// on the architecture, if they are known. Since they are based on the signatures, they are only known for x86_64
+func (a *Arch) GetArgumentFamilies(syscall string, index int) ([]string, bool) {
+	if a != X86_64 {
+		return nil, false
+	}
+	return GetArgumentFamilies(syscall, index)
+}
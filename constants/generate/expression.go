@@ -0,0 +1,484 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	identifier tokenKind = iota
+	number
+	character
+	punctuation
+	str
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+var operators = []string{"<<", ">>", "<=", ">=", "==", "!=", "&&", "||", "##"}
+
+// tokenize splits C source into tokens. It only knows as much of C as is needed for constant expressions and enums
+func tokenize(s string) []token {
+	var result []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(s) && isIdentifierChar(s[j]) {
+				j++
+			}
+			result = append(result, token{kind: number, text: s[i:j]})
+			i = j
+		case isIdentifierChar(c):
+			j := i
+			for j < len(s) && isIdentifierChar(s[j]) {
+				j++
+			}
+			result = append(result, token{kind: identifier, text: s[i:j]})
+			i = j
+		case c == '\'' || c == '"':
+			j := i + 1
+			for j < len(s) && s[j] != c {
+				if s[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(s) {
+				j = len(s) - 1
+			}
+			kind := character
+			if c == '"' {
+				kind = str
+			}
+			result = append(result, token{kind: kind, text: s[i : j+1]})
+			i = j + 1
+		default:
+			op := string(c)
+			for _, o := range operators {
+				if strings.HasPrefix(s[i:], o) {
+					op = o
+					break
+				}
+			}
+			result = append(result, token{kind: punctuation, text: op})
+			i += len(op)
+		}
+	}
+	return result
+}
+
+// expand replaces all macros and enumerators in the tokens with their definitions. The macros in hidden are
+// not expanded again, to stop macros that refer to themselves. Headers often define an enumerator as a macro with
+// the same name, such as #define RTM_NEWLINK RTM_NEWLINK, so a hidden macro can still refer to an enumerator.
+func (p *preprocessor) expand(tokens []token, hidden map[string]bool) ([]token, error) {
+	var result []token
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		if t.kind != identifier || hidden["enum "+t.text] {
+			result = append(result, t)
+			continue
+		}
+		if t.text == "sizeof" {
+			size, end, err := p.sizeOf(tokens, i+1)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, token{kind: number, text: strconv.FormatInt(size, 10)})
+			i = end
+			continue
+		}
+
+		m, ok := p.macros[t.text]
+		if !ok || hidden[t.text] {
+			if e, ok := p.enumerators[t.text]; ok {
+				value, err := p.expand(e.value, with(hidden, "enum "+t.text))
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, parenthesized(value)...)
+				continue
+			}
+			result = append(result, t)
+			continue
+		}
+
+		body := m.body
+		if m.params != nil {
+			if i+1 >= len(tokens) || tokens[i+1].text != "(" {
+				result = append(result, t)
+				continue
+			}
+			args, end, err := arguments(tokens, i+1)
+			if err != nil {
+				return nil, err
+			}
+			if len(args) != len(m.params) && !(len(m.params) == 0 && len(args) == 1 && len(args[0]) == 0) {
+				return nil, fmt.Errorf("macro %s takes %d arguments, but was given %d", m.name, len(m.params), len(args))
+			}
+			body = substitute(m, args)
+			i = end
+		}
+		expanded, err := p.expand(body, with(hidden, t.text))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, expanded...)
+	}
+	return result, nil
+}
+
+func with(hidden map[string]bool, name string) map[string]bool {
+	result := map[string]bool{name: true}
+	for k := range hidden {
+		result[k] = true
+	}
+	return result
+}
+
+func parenthesized(tokens []token) []token {
+	result := []token{{kind: punctuation, text: "("}}
+	result = append(result, tokens...)
+	return append(result, token{kind: punctuation, text: ")"})
+}
+
+// arguments returns the arguments of a function-like macro call starting at the opening parenthesis, and the index of the closing one
+func arguments(tokens []token, start int) ([][]token, int, error) {
+	var args [][]token
+	var current []token
+	depth := 0
+	for i := start + 1; i < len(tokens); i++ {
+		switch tokens[i].text {
+		case "(":
+			depth++
+		case ")":
+			if depth == 0 {
+				return append(args, current), i, nil
+			}
+			depth--
+		case ",":
+			if depth == 0 {
+				args = append(args, current)
+				current = nil
+				continue
+			}
+		}
+		current = append(current, tokens[i])
+	}
+	return nil, 0, errors.New("unterminated macro call")
+}
+
+func substitute(m *macro, args [][]token) []token {
+	var result []token
+	for _, t := range m.body {
+		replaced := false
+		if t.kind == identifier {
+			for ix, param := range m.params {
+				if param == t.text {
+					result = append(result, parenthesized(args[ix])...)
+					replaced = true
+					break
+				}
+			}
+		}
+		if !replaced {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// sizeOf returns the size of the type in parentheses starting at the given index, and the index of the closing parenthesis
+func (p *preprocessor) sizeOf(tokens []token, start int) (int64, int, error) {
+	if start >= len(tokens) || tokens[start].text != "(" {
+		return 0, 0, errors.New("sizeof without parentheses")
+	}
+	args, end, err := arguments(tokens, start)
+	if err != nil {
+		return 0, 0, err
+	}
+	// Macro arguments are put in parentheses when they are substituted, but types never contain any, except in arrays
+	// of function pointers that aren't used by the headers
+	var words []string
+	count := int64(1)
+	for i, t := range args[0] {
+		switch {
+		case t.text == "(" || t.text == ")":
+		case t.text == "[" && i+2 < len(args[0]) && args[0][i+2].text == "]":
+			n, err := parseNumber(args[0][i+1].text)
+			if err != nil {
+				return 0, 0, err
+			}
+			count *= n
+		case t.text == "]" || (i > 0 && args[0][i-1].text == "["):
+		default:
+			words = append(words, t.text)
+		}
+	}
+	typ := strings.Replace(strings.Join(words, " "), " *", "*", -1)
+	if strings.HasSuffix(typ, "*") {
+		typ = "void*"
+	}
+	size, ok := p.sizes[typ]
+	if !ok {
+		return 0, 0, fmt.Errorf("unknown size of type %s", typ)
+	}
+	return size * count, end, nil
+}
+
+// evaluate returns the value of the named macro or enumerator
+func (p *preprocessor) evaluate(name string) (int64, error) {
+	if m, ok := p.macros[name]; ok && m.params == nil {
+		return p.evaluateTokens([]token{{kind: identifier, text: name}}, false)
+	}
+	if _, ok := p.enumerators[name]; ok {
+		return p.evaluateTokens([]token{{kind: identifier, text: name}}, false)
+	}
+	return 0, fmt.Errorf("%s is not defined", name)
+}
+
+// evaluateTokens expands and calculates a constant expression. In conditions, identifiers left after expansion are zero,
+// like the C preprocessor does, while they are errors everywhere else
+func (p *preprocessor) evaluateTokens(tokens []token, condition bool) (int64, error) {
+	expanded, err := p.expand(tokens, nil)
+	if err != nil {
+		return 0, err
+	}
+	e := &evaluator{tokens: expanded, condition: condition}
+	v, err := e.ternary()
+	if err != nil {
+		return 0, err
+	}
+	if e.pos != len(e.tokens) {
+		return 0, fmt.Errorf("unexpected %s", e.tokens[e.pos].text)
+	}
+	return v, nil
+}
+
+// evaluator calculates the value of a C constant expression using 64 bit arithmetic
+type evaluator struct {
+	tokens    []token
+	pos       int
+	condition bool
+}
+
+func (e *evaluator) peek() string {
+	if e.pos < len(e.tokens) {
+		return e.tokens[e.pos].text
+	}
+	return ""
+}
+
+func (e *evaluator) expect(s string) error {
+	if e.peek() != s {
+		return fmt.Errorf("expected %s", s)
+	}
+	e.pos++
+	return nil
+}
+
+func (e *evaluator) ternary() (int64, error) {
+	cond, err := e.binary(0)
+	if err != nil || e.peek() != "?" {
+		return cond, err
+	}
+	e.pos++
+	left, err := e.ternary()
+	if err != nil {
+		return 0, err
+	}
+	if err := e.expect(":"); err != nil {
+		return 0, err
+	}
+	right, err := e.ternary()
+	if err != nil {
+		return 0, err
+	}
+	if cond != 0 {
+		return left, nil
+	}
+	return right, nil
+}
+
+var precedence = map[string]int{
+	"||": 1, "&&": 2, "|": 3, "^": 4, "&": 5,
+	"==": 6, "!=": 6, "<": 7, ">": 7, "<=": 7, ">=": 7,
+	"<<": 8, ">>": 8, "+": 9, "-": 9, "*": 10, "/": 10, "%": 10,
+}
+
+func boolValue(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func (e *evaluator) binary(min int) (int64, error) {
+	left, err := e.unary()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		op := e.peek()
+		prec, ok := precedence[op]
+		if !ok || prec <= min {
+			return left, nil
+		}
+		e.pos++
+		right, err := e.binary(prec)
+		if err != nil {
+			return 0, err
+		}
+		switch op {
+		case "||":
+			left = boolValue(left != 0 || right != 0)
+		case "&&":
+			left = boolValue(left != 0 && right != 0)
+		case "|":
+			left |= right
+		case "^":
+			left ^= right
+		case "&":
+			left &= right
+		case "==":
+			left = boolValue(left == right)
+		case "!=":
+			left = boolValue(left != right)
+		case "<":
+			left = boolValue(left < right)
+		case ">":
+			left = boolValue(left > right)
+		case "<=":
+			left = boolValue(left <= right)
+		case ">=":
+			left = boolValue(left >= right)
+		case "<<":
+			left = int64(uint64(left) << uint64(right))
+		case ">>":
+			left = int64(uint64(left) >> uint64(right))
+		case "+":
+			left += right
+		case "-":
+			left -= right
+		case "*":
+			left *= right
+		case "/", "%":
+			if right == 0 {
+				return 0, errors.New("division by zero")
+			}
+			if op == "/" {
+				left /= right
+			} else {
+				left %= right
+			}
+		}
+	}
+}
+
+// typeWords are the words that can start a cast
+var typeWords = map[string]bool{
+	"int": true, "unsigned": true, "signed": true, "long": true, "short": true, "char": true,
+	"struct": true, "union": true, "enum": true, "const": true, "void": true, "__force": true,
+}
+
+// isCast returns true if the parenthesis at the current position starts a cast. Casts are ignored, since
+// constants are always calculated with 64 bits
+func (e *evaluator) isCast() (int, bool) {
+	i := e.pos + 1
+	typ := false
+	for ; i < len(e.tokens) && e.tokens[i].text != ")"; i++ {
+		t := e.tokens[i]
+		if t.kind != identifier && t.text != "*" {
+			return 0, false
+		}
+		if typeWords[t.text] || strings.HasSuffix(t.text, "_t") || strings.HasPrefix(t.text, "__u") || strings.HasPrefix(t.text, "__s") || strings.HasPrefix(t.text, "__le") || strings.HasPrefix(t.text, "__be") {
+			typ = true
+		}
+	}
+	return i, typ && i < len(e.tokens)
+}
+
+func (e *evaluator) unary() (int64, error) {
+	switch e.peek() {
+	case "-", "+", "~", "!":
+		op := e.peek()
+		e.pos++
+		v, err := e.unary()
+		if err != nil {
+			return 0, err
+		}
+		switch op {
+		case "-":
+			return -v, nil
+		case "~":
+			return ^v, nil
+		case "!":
+			return boolValue(v == 0), nil
+		}
+		return v, nil
+	case "(":
+		if end, ok := e.isCast(); ok {
+			e.pos = end + 1
+			return e.unary()
+		}
+		e.pos++
+		v, err := e.ternary()
+		if err != nil {
+			return 0, err
+		}
+		return v, e.expect(")")
+	}
+	return e.primary()
+}
+
+func (e *evaluator) primary() (int64, error) {
+	if e.pos >= len(e.tokens) {
+		return 0, errors.New("unexpected end of expression")
+	}
+	t := e.tokens[e.pos]
+	e.pos++
+	switch t.kind {
+	case number:
+		return parseNumber(t.text)
+	case character:
+		return parseCharacter(t.text)
+	case identifier:
+		if e.condition {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("%s is not defined", t.text)
+	}
+	return 0, fmt.Errorf("unexpected %s", t.text)
+}
+
+func parseNumber(s string) (int64, error) {
+	s = strings.TrimRight(s, "uUlL")
+	base := 10
+	switch {
+	case strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X"):
+		s, base = s[2:], 16
+	case len(s) > 1 && s[0] == '0':
+		s, base = s[1:], 8
+	}
+	v, err := strconv.ParseUint(s, base, 64)
+	return int64(v), err
+}
+
+func parseCharacter(s string) (int64, error) {
+	v, _, _, err := strconv.UnquoteChar(s[1:len(s)-1], '\'')
+	return int64(v), err
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type GenerateSuite struct{}
+
+var _ = Suite(&GenerateSuite{})
+
+func processed(c *C, source string) *preprocessor {
+	p := newPreprocessor(nil, map[string]string{"__x86_64__": "1"}, sizes(8))
+	c.Assert(p.process("test.h", source), IsNil)
+	return p
+}
+
+func evaluated(c *C, source, name string) int64 {
+	v, err := processed(c, source).evaluate(name)
+	c.Assert(err, IsNil)
+	return v
+}
+
+func (s *GenerateSuite) Test_tokenizesConstantExpressions(c *C) {
+	c.Assert(tokenize("(1UL << 3) >= 'a'"), DeepEquals, []token{
+		{punctuation, "("},
+		{number, "1UL"},
+		{punctuation, "<<"},
+		{number, "3"},
+		{punctuation, ")"},
+		{punctuation, ">="},
+		{character, "'a'"},
+	})
+}
+
+func (s *GenerateSuite) Test_parsesNumbersInAllBases(c *C) {
+	c.Assert(evaluated(c, "#define A 42", "A"), Equals, int64(42))
+	c.Assert(evaluated(c, "#define A 0x2A", "A"), Equals, int64(42))
+	c.Assert(evaluated(c, "#define A 052", "A"), Equals, int64(42))
+	c.Assert(evaluated(c, "#define A 0", "A"), Equals, int64(0))
+	c.Assert(evaluated(c, "#define A 42UL", "A"), Equals, int64(42))
+	c.Assert(evaluated(c, "#define A 0xFFFFFFFFFFFFFFFFULL", "A"), Equals, int64(-1))
+}
+
+func (s *GenerateSuite) Test_parsesCharacters(c *C) {
+	c.Assert(evaluated(c, "#define A 'T'", "A"), Equals, int64('T'))
+	c.Assert(evaluated(c, "#define A '\\n'", "A"), Equals, int64('\n'))
+	c.Assert(evaluated(c, "#define A ('T' << 8) | 1", "A"), Equals, int64(0x5401))
+}
+
+func (s *GenerateSuite) Test_usesThePrecedenceOfC(c *C) {
+	c.Assert(evaluated(c, "#define A 1 + 2 * 3", "A"), Equals, int64(7))
+	c.Assert(evaluated(c, "#define A 1 << 2 + 1", "A"), Equals, int64(8))
+	c.Assert(evaluated(c, "#define A 1 | 2 & 3 ^ 4", "A"), Equals, int64(7))
+	c.Assert(evaluated(c, "#define A 10 - 4 - 3", "A"), Equals, int64(3))
+	c.Assert(evaluated(c, "#define A 1 == 1 && 2 < 1 || 3 >= 3", "A"), Equals, int64(1))
+	c.Assert(evaluated(c, "#define A (1 + 2) * 3", "A"), Equals, int64(9))
+}
+
+func (s *GenerateSuite) Test_calculatesUnaryOperators(c *C) {
+	c.Assert(evaluated(c, "#define A -1", "A"), Equals, int64(-1))
+	c.Assert(evaluated(c, "#define A ~0", "A"), Equals, int64(-1))
+	c.Assert(evaluated(c, "#define A !5", "A"), Equals, int64(0))
+	c.Assert(evaluated(c, "#define A -(2 + 3)", "A"), Equals, int64(-5))
+}
+
+func (s *GenerateSuite) Test_shiftsAsUnsignedValues(c *C) {
+	c.Assert(evaluated(c, "#define A -1 >> 60", "A"), Equals, int64(0xF))
+	c.Assert(evaluated(c, "#define A 1 << 63", "A"), Equals, int64(-1)<<63)
+}
+
+func (s *GenerateSuite) Test_calculatesTernaryExpressions(c *C) {
+	c.Assert(evaluated(c, "#define A 1 ? 2 : 3", "A"), Equals, int64(2))
+	c.Assert(evaluated(c, "#define A 0 ? 2 : 0 ? 3 : 4", "A"), Equals, int64(4))
+}
+
+func (s *GenerateSuite) Test_ignoresCasts(c *C) {
+	c.Assert(evaluated(c, "#define A ((unsigned int)~0)", "A"), Equals, int64(-1))
+	c.Assert(evaluated(c, "#define A ((__u32)4)", "A"), Equals, int64(4))
+	c.Assert(evaluated(c, "#define A ((struct foo *)8)", "A"), Equals, int64(8))
+	c.Assert(evaluated(c, "#define A ((int)(1 + 2))", "A"), Equals, int64(3))
+}
+
+func (s *GenerateSuite) Test_calculatesSizes(c *C) {
+	c.Assert(evaluated(c, "#define A sizeof(int)", "A"), Equals, int64(4))
+	c.Assert(evaluated(c, "#define A sizeof(unsigned long)", "A"), Equals, int64(8))
+	c.Assert(evaluated(c, "#define A sizeof(char *)", "A"), Equals, int64(8))
+	c.Assert(evaluated(c, "#define A sizeof(__u32[4])", "A"), Equals, int64(16))
+	c.Assert(evaluated(c, "#define A sizeof(struct seccomp_data)", "A"), Equals, int64(64))
+}
+
+func (s *GenerateSuite) Test_usesTheSizesOfTheArchitecture(c *C) {
+	p := newPreprocessor(nil, nil, sizes(4))
+	c.Assert(p.process("test.h", "#define A sizeof(long)"), IsNil)
+	v, err := p.evaluate("A")
+	c.Assert(err, IsNil)
+	c.Assert(v, Equals, int64(4))
+}
+
+func (s *GenerateSuite) Test_expandsMacros(c *C) {
+	c.Assert(evaluated(c, "#define B 2\n#define A (B + 1)", "A"), Equals, int64(3))
+	c.Assert(evaluated(c, "#define A (B + 1)\n#define B 2", "A"), Equals, int64(3))
+}
+
+func (s *GenerateSuite) Test_expandsFunctionLikeMacros(c *C) {
+	p := processed(c, `
+#define _IOC(dir,type,nr,size) (((dir) << 30) | ((type) << 8) | (nr) | ((size) << 16))
+#define _IOR(type,nr,size) _IOC(2,(type),(nr),sizeof(size))
+#define TCGETS2 _IOR('T', 0x2A, struct termios2)
+#define ZERO() 0
+#define A ZERO()
+`)
+	v, err := p.evaluate("TCGETS2")
+	c.Assert(err, IsNil)
+	c.Assert(v, Equals, int64(0x802C542A))
+
+	v, err = p.evaluate("A")
+	c.Assert(err, IsNil)
+	c.Assert(v, Equals, int64(0))
+
+	_, err = p.evaluate("_IOC")
+	c.Assert(err, ErrorMatches, "_IOC is not defined")
+}
+
+func (s *GenerateSuite) Test_putsMacroArgumentsInParentheses(c *C) {
+	c.Assert(evaluated(c, "#define TWICE(x) x * 2\n#define A TWICE(1 + 2)", "A"), Equals, int64(6))
+}
+
+func (s *GenerateSuite) Test_reportsWrongNumberOfMacroArguments(c *C) {
+	_, err := processed(c, "#define F(x, y) x + y\n#define A F(1)").evaluate("A")
+	c.Assert(err, ErrorMatches, "macro F takes 2 arguments, but was given 1")
+}
+
+func (s *GenerateSuite) Test_doesntExpandMacrosThatReferToThemselves(c *C) {
+	_, err := processed(c, "#define A A + 1").evaluate("A")
+	c.Assert(err, ErrorMatches, "A is not defined")
+}
+
+func (s *GenerateSuite) Test_macrosCanReferToEnumeratorsWithTheSameName(c *C) {
+	c.Assert(evaluated(c, "enum { RTM_BASE = 16, RTM_NEWLINK = 16 };\n#define RTM_NEWLINK RTM_NEWLINK", "RTM_NEWLINK"), Equals, int64(16))
+}
+
+func (s *GenerateSuite) Test_reportsUndefinedNames(c *C) {
+	_, err := processed(c, "#define A B + 1").evaluate("A")
+	c.Assert(err, ErrorMatches, "B is not defined")
+
+	_, err = processed(c, "").evaluate("A")
+	c.Assert(err, ErrorMatches, "A is not defined")
+}
+
+func (s *GenerateSuite) Test_reportsDivisionByZero(c *C) {
+	_, err := processed(c, "#define A 1 / (2 - 2)").evaluate("A")
+	c.Assert(err, ErrorMatches, "division by zero")
+
+	_, err = processed(c, "#define A 1 % 0").evaluate("A")
+	c.Assert(err, ErrorMatches, "division by zero")
+}
+
+func (s *GenerateSuite) Test_reportsUnknownSizes(c *C) {
+	_, err := processed(c, "#define A sizeof(struct unknown)").evaluate("A")
+	c.Assert(err, ErrorMatches, "unknown size of type struct unknown")
+}
+
+func (s *GenerateSuite) Test_reportsIncompleteExpressions(c *C) {
+	_, err := processed(c, "#define A (1 + 2").evaluate("A")
+	c.Assert(err, ErrorMatches, "expected \\)")
+
+	_, err = processed(c, "#define A 1 +").evaluate("A")
+	c.Assert(err, ErrorMatches, "unexpected end of expression")
+
+	_, err = processed(c, "#define A 1 2").evaluate("A")
+	c.Assert(err, ErrorMatches, "unexpected 2")
+}
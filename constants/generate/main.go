@@ -0,0 +1,460 @@
+// Command generate creates the syscall, error and constant tables of the constants package, from the copy
+// of the kernel headers in constants/linux. It is run by go generate in the constants package:
+//
+//	go run ./generate -headers linux -out .
+//
+// The headers are read with a small preprocessor that only knows enough C to calculate the values of
+// constants, so constants that can't be calculated are skipped and reported.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// arch describes how to read the headers for one architecture
+type arch struct {
+	name        string
+	variable    string
+	auditArch   string
+	includeDirs []string
+	predefined  map[string]string
+	longSize    int64
+	// legacy are constants the tables had when they came from Go's syscall package, that aren't defined in
+	// the copy of the headers. They are only used for names the headers don't define. The values are the ones
+	// of zerrors_linux_amd64.go and zerrors_linux_386.go in the syscall package
+	legacy map[string]int64
+}
+
+var archs = []arch{
+	{
+		name:        "x86_64",
+		variable:    "X86_64",
+		auditArch:   "AUDIT_ARCH_X86_64",
+		includeDirs: []string{"include/arch/x86", "include", "libc"},
+		predefined:  map[string]string{"__x86_64__": "1", "__LP64__": "1", "__linux__": "1", "__USE_MISC": "1", "__USE_GNU": "1"},
+		longSize:    8,
+		legacy: map[string]int64{
+			// glibc defines these as F_GETLK, F_SETLK and F_SETLKW on 64 bit architectures, while the kernel
+			// headers only have the values of 32 bit architectures
+			"F_GETLK64": 5, "F_SETLK64": 6, "F_SETLKW64": 7,
+			// FD_SETSIZE and WORDSIZE (__WORDSIZE) come from the C library, older versions of which also defined
+			// EPOLL_NONBLOCK as O_NONBLOCK. ARPHRD_IEEE802154_PHY is the old name of ARPHRD_IEEE802154_MONITOR
+			"FD_SETSIZE": 1024, "EPOLL_NONBLOCK": 0x800, "ARPHRD_IEEE802154_PHY": 0x325, "WORDSIZE": 64,
+		},
+	},
+	{
+		name:        "i386",
+		variable:    "I386",
+		auditArch:   "AUDIT_ARCH_I386",
+		includeDirs: []string{"include/arch/x86", "include", "libc"},
+		predefined:  map[string]string{"__i386__": "1", "__linux__": "1", "__USE_MISC": "1", "__USE_GNU": "1"},
+		longSize:    4,
+		legacy: map[string]int64{
+			// The same as on x86_64, except that the headers define the 64 bit locking commands here
+			"FD_SETSIZE": 1024, "EPOLL_NONBLOCK": 0x800, "ARPHRD_IEEE802154_PHY": 0x325, "WORDSIZE": 32,
+		},
+	},
+}
+
+// family is a group of constants - the ones defined in any of the files whose name matches the pattern
+type family struct {
+	files   []string
+	pattern string
+}
+
+// errorFiles contain the errno values
+var errorFiles = []string{"asm-generic/errno-base.h", "asm-generic/errno.h", "bits/errno.h"}
+
+// families list the constants that will be generated. Some of them, like the address families, are not part
+// of the kernel UAPI headers, so they are read from the C library headers in the libc directory instead
+var families = []family{
+	{[]string{"linux/if_ether.h"}, `^ETH_P_`},
+	{[]string{"linux/prctl.h"}, `^PR_`},
+	{[]string{"asm/prctl.h"}, `^ARCH_`},
+	{[]string{"linux/if_arp.h"}, `^ARPHRD_`},
+	{[]string{"linux/in6.h"}, `^(IPV6_|IPPROTO_)`},
+	{[]string{"linux/in.h"}, `^(IP_|IPPROTO_|IN_)`},
+	{[]string{"linux/inotify.h"}, `^IN_`},
+	{[]string{"linux/rtnetlink.h"}, `^(RTM_|RTAX_|RTPROT_|RTNH_|RTA_|RTN_|RT_SCOPE_|RT_TABLE_)`},
+	{[]string{"linux/in_route.h", "net/route.h"}, `^(RTCF_|RT_CLASS_)`},
+	{[]string{"linux/route.h", "linux/ipv6_route.h", "net/route.h"}, `^RTF_`},
+	{[]string{"linux/ptrace.h", "asm/ptrace-abi.h"}, `^PTRACE_`},
+	{[]string{"bits/socket.h"}, `^(AF_|SOL_|MSG_|SCM_|SOMAXCONN$)`},
+	{[]string{"bits/socket_type.h"}, `^SOCK_`},
+	{[]string{"sys/socket.h"}, `^SHUT_`},
+	{[]string{"netinet/in.h", "bits/in.h"}, `^(SOL_|IPPROTO_|IPV6_|IP_)`},
+	{[]string{"netinet/ip.h"}, `^(IP_|IPTOS_|IPOPT_)`},
+	{[]string{"netinet/tcp.h"}, `^(SOL_TCP$|TCP_)`},
+	{[]string{"unistd.h"}, `^([RWXF]_OK|F_(ULOCK|LOCK|TLOCK|TEST))$`},
+	{[]string{"asm-generic/socket.h", "asm-generic/sockios.h"}, `^(SO_|SCM_|SOL_SOCKET$|SIOC)`},
+	{[]string{"linux/sockios.h"}, `^SIOC`},
+	{[]string{"linux/filter.h", "linux/bpf_common.h"}, `^BPF_`},
+	{[]string{"asm-generic/fcntl.h", "linux/fcntl.h"}, `^(O_|F_|FD_|LOCK_|AT_|DN_)`},
+	{[]string{"linux/openat2.h"}, `^RESOLVE_`},
+	{[]string{"linux/close_range.h"}, `^CLOSE_RANGE_`},
+	{[]string{"linux/mount.h"}, `^(MS_|MNT_|MOVE_MOUNT_|FSOPEN_|FSMOUNT_|FSPICK_|OPEN_TREE_|MOUNT_ATTR_)`},
+	{[]string{"sys/mount.h"}, `^MNT_`},
+	{[]string{"asm-generic/mman-common.h", "asm-generic/mman.h", "asm/mman.h", "linux/mman.h", "bits/mman-linux.h"}, `^(MAP_|PROT_|MADV_|MCL_|MS_|MLOCK_|MREMAP_)`},
+	{[]string{"linux/stat.h"}, `^(S_I|STATX_)`},
+	{[]string{"sys/stat.h"}, `^(S_I(READ|WRITE|EXEC)|S_BLKSIZE)$`},
+	{[]string{"linux/netlink.h"}, `^(NETLINK_|NLM_|NLMSG_|NLA_)`},
+	{[]string{"linux/if.h"}, `^(IFF_|IFNAMSIZ$)`},
+	{[]string{"linux/if_tun.h"}, `^(TUN|IFF_)`},
+	{[]string{"linux/if_addr.h"}, `^IFA_`},
+	{[]string{"linux/if_packet.h"}, `^PACKET_`},
+	{[]string{"linux/sched.h"}, `^(CLONE_|SCHED_)`},
+	{[]string{"linux/tcp.h"}, `^TCP_`},
+	{[]string{"asm-generic/ioctls.h", "asm-generic/termbits.h", "asm-generic/termbits-common.h", "asm-generic/termios.h"}, `^(TIOC|TC[A-Z]|FIO|XCASE$|OLCUC$|IUCLC$)`},
+	{[]string{"asm/signal.h", "asm-generic/signal-defs.h", "bits/signum-arch.h"}, `^SIG[A-Z0-9]+$`},
+	{[]string{"linux/wait.h"}, `^(__)?W[A-Z]+$`},
+	{[]string{"asm-generic/resource.h", "linux/resource.h"}, `^(RLIMIT_|RLIM_|PRIO_|RUSAGE_)`},
+	{[]string{"linux/reboot.h"}, `^LINUX_REBOOT_`},
+	{[]string{"linux/icmpv6.h"}, `^ICMPV6_`},
+	{[]string{"linux/limits.h"}, `^(NAME_MAX|PATH_MAX)$`},
+	{[]string{"linux/eventpoll.h"}, `^EPOLL`},
+	{[]string{"dirent.h"}, `^DT_`},
+	{[]string{"linux/landlock.h"}, `^LANDLOCK_`},
+	{[]string{"linux/seccomp.h"}, `^SECCOMP_`},
+	{[]string{"linux/memfd.h"}, `^MFD_`},
+	{[]string{"linux/futex.h"}, `^FUTEX_`},
+	{[]string{"linux/random.h"}, `^GRND_`},
+	{[]string{"linux/capability.h"}, `^CAP_`},
+}
+
+// ignored are constants matched by a family that the headers define in terms of something outside of the UAPI
+// headers, like the C library or the kernel itself, or that aren't constants at all
+var ignored = map[string]bool{
+	"SIGRTMAX":        true,
+	"RTCF_NOPMTUDISC": true,
+	"EPOLL_PACKED":    true,
+}
+
+// aliases are names the C library gives to kernel constants, in headers that can't be read together with
+// the kernel ones because they also redefine the kernel constants differently
+var aliases = map[string]string{
+	"O_ASYNC": "FASYNC",
+	"O_FSYNC": "O_SYNC",
+	"O_RSYNC": "O_SYNC",
+}
+
+// headers are included for every architecture, in this order
+var headers = []string{
+	"linux/audit.h",
+	"linux/version.h",
+	"asm/unistd.h",
+	"bits/errno.h",
+	"bits/socket.h",
+	"sys/socket.h",
+	"sys/mount.h",
+	"dirent.h",
+	"unistd.h",
+	"sys/stat.h",
+	"netinet/in.h",
+	"netinet/ip.h",
+	"netinet/tcp.h",
+	"net/route.h",
+	"bits/signum-arch.h",
+	"bits/mman-linux.h",
+	"asm/prctl.h",
+	"asm/ptrace-abi.h",
+	"asm/signal.h",
+	"asm/mman.h",
+	"linux/mman.h",
+	"linux/fcntl.h",
+	"linux/openat2.h",
+	"linux/close_range.h",
+	"linux/mount.h",
+	"linux/stat.h",
+	"linux/if_ether.h",
+	"linux/prctl.h",
+	"linux/if_arp.h",
+	"linux/in.h",
+	"linux/in6.h",
+	"linux/inotify.h",
+	"linux/rtnetlink.h",
+	"linux/in_route.h",
+	"linux/route.h",
+	"linux/ipv6_route.h",
+	"linux/ptrace.h",
+	"linux/sockios.h",
+	"linux/filter.h",
+	"linux/netlink.h",
+	"linux/if.h",
+	"linux/if_tun.h",
+	"linux/if_addr.h",
+	"linux/if_packet.h",
+	"linux/sched.h",
+	"linux/tcp.h",
+	"asm/ioctls.h",
+	"asm/termbits.h",
+	"asm/termios.h",
+	"linux/wait.h",
+	"asm/resource.h",
+	"linux/resource.h",
+	"linux/reboot.h",
+	"linux/icmpv6.h",
+	"linux/limits.h",
+	"linux/eventpoll.h",
+	"linux/landlock.h",
+	"linux/seccomp.h",
+	"linux/memfd.h",
+	"linux/futex.h",
+	"linux/random.h",
+	"linux/capability.h",
+}
+
+// sizes returns the sizes of the types used by sizeof in the headers, for an architecture with the given size of long
+func sizes(long int64) map[string]int64 {
+	return map[string]int64{
+		"char": 1, "unsigned char": 1, "__u8": 1, "__s8": 1,
+		"short": 2, "unsigned short": 2, "__u16": 2, "__s16": 2,
+		"int": 4, "unsigned int": 4, "unsigned": 4, "__u32": 4, "__s32": 4, "__kernel_pid_t": 4,
+		"long long": 8, "unsigned long long": 8, "__u64": 8, "__s64": 8,
+		"long": long, "unsigned long": long, "size_t": long, "void*": long,
+		// unsigned short len, followed by a pointer
+		"struct sock_fprog": 2 * long,
+		"struct termios":    36,
+		"struct termios2":   44,
+		"struct nlmsghdr":   16,
+		"struct nlattr":     4,
+		"time_t":            long,
+		"__kernel_long_t":   long,
+		"struct timeval":    2 * long,
+		"struct timespec":   2 * long,
+		// flags, tg, sc_fi, sc_di, clk and reserved[5], all 32 bits
+		"struct serial_iso7816": 40,
+		// nr, arch, instruction_pointer and args[6]
+		"struct seccomp_data": 64,
+		// id, pid, flags and data
+		"struct seccomp_notif": 80,
+		// id, val, error and flags
+		"struct seccomp_notif_resp": 24,
+		// id, flags, srcfd, newfd and newfd_flags
+		"struct seccomp_notif_addfd": 24,
+	}
+}
+
+type value struct {
+	name  string
+	value int64
+}
+
+type syscall struct {
+	name   string
+	number int64
+	since  string
+}
+
+type table struct {
+	arch      arch
+	version   string
+	auditArch int64
+	syscalls  []syscall
+	errors    []value
+	constants []value
+}
+
+func main() {
+	headerDir := flag.String("headers", "linux", "the directory containing the copy of the headers")
+	out := flag.String("out", ".", "the directory to write the generated files to")
+	flag.Parse()
+
+	for _, a := range archs {
+		t, err := generate(*headerDir, a)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", a.name, err)
+			os.Exit(1)
+		}
+		if err := write(filepath.Join(*out, "arch_"+a.name+".go"), t); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", a.name, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func generate(headerDir string, a arch) (*table, error) {
+	var dirs []string
+	for _, d := range a.includeDirs {
+		dirs = append(dirs, filepath.Join(headerDir, d))
+	}
+	p := newPreprocessor(dirs, a.predefined, sizes(a.longSize))
+	for _, h := range headers {
+		if _, ok := p.find(h); !ok {
+			return nil, fmt.Errorf("header %s is missing", h)
+		}
+		if err := p.include(h); err != nil {
+			return nil, err
+		}
+	}
+
+	t := &table{arch: a}
+	code, err := p.evaluate("LINUX_VERSION_CODE")
+	if err != nil {
+		return nil, err
+	}
+	t.version = fmt.Sprintf("%d.%d", code>>16, (code>>8)&0xFF)
+	if t.auditArch, err = p.evaluate(a.auditArch); err != nil {
+		return nil, err
+	}
+
+	versions, err := readVersions(filepath.Join(headerDir, "versions", a.name))
+	if err != nil {
+		return nil, err
+	}
+	for name, m := range p.macros {
+		if !strings.HasPrefix(name, "__NR_") || m.params != nil {
+			continue
+		}
+		nr, err := p.evaluate(name)
+		if err != nil {
+			return nil, err
+		}
+		s := syscall{name: strings.TrimPrefix(name, "__NR_"), number: nr}
+		if s.since = versions[s.name]; s.since == "" {
+			return nil, fmt.Errorf("the version that introduced %s is not known - please add it to the versions file", s.name)
+		}
+		t.syscalls = append(t.syscalls, s)
+	}
+	sort.Slice(t.syscalls, func(i, j int) bool { return t.syscalls[i].number < t.syscalls[j].number })
+
+	errorPattern := regexp.MustCompile(`^E[A-Z0-9]+$`)
+	t.errors = collect(p, errorFiles, errorPattern)
+	seen := make(map[string]bool)
+	for _, e := range t.errors {
+		seen[e.name] = true
+	}
+	for _, f := range families {
+		for _, c := range collect(p, f.files, regexp.MustCompile(f.pattern)) {
+			c.name = strings.TrimPrefix(c.name, "__")
+			if !seen[c.name] {
+				seen[c.name] = true
+				t.constants = append(t.constants, c)
+			}
+		}
+	}
+	for alias, name := range aliases {
+		v, err := p.evaluate(name)
+		if err != nil {
+			return nil, err
+		}
+		t.constants = append(t.constants, value{alias, v})
+	}
+	for name, v := range a.legacy {
+		if !seen[name] {
+			t.constants = append(t.constants, value{name, v})
+		}
+	}
+	sort.Slice(t.constants, func(i, j int) bool { return t.constants[i].name < t.constants[j].name })
+	return t, nil
+}
+
+// collect returns the values of all macros and enumerators defined in one of the files that match the pattern.
+// Function-like macros are ignored, and constants that can't be calculated are reported and skipped
+func collect(p *preprocessor, files []string, pattern *regexp.Regexp) []value {
+	inFiles := make(map[string]bool)
+	for _, f := range files {
+		inFiles[f] = true
+	}
+
+	names := make(map[string]bool)
+	for name, m := range p.macros {
+		if inFiles[m.file] && m.params == nil && pattern.MatchString(name) && !ignored[name] {
+			names[name] = true
+		}
+	}
+	for name, e := range p.enumerators {
+		if inFiles[e.file] && pattern.MatchString(name) && !ignored[name] {
+			names[name] = true
+		}
+	}
+
+	var result []value
+	for name := range names {
+		v, err := p.evaluate(name)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "skipping %s: %v\n", name, err)
+			continue
+		}
+		result = append(result, value{name, v})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].name < result[j].name })
+	return result
+}
+
+// readVersions reads a file where each line contains the name of a syscall and the kernel version that introduced it
+func readVersions(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	result := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s: invalid line: %s", path, line)
+		}
+		result[fields[0]] = fields[1]
+	}
+	return result, scanner.Err()
+}
+
+func write(path string, t *table) error {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by go run ./generate. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package constants\n\n")
+	fmt.Fprintf(&b, "// %s contains the syscalls, errors and constants of the %s architecture, from the headers of Linux %s\n", t.arch.variable, t.arch.name, t.version)
+	fmt.Fprintf(&b, "var %s = &Arch{\n", t.arch.variable)
+	fmt.Fprintf(&b, "Name: %q,\n", t.arch.name)
+	fmt.Fprintf(&b, "AuditArch: 0x%X,\n", t.auditArch)
+	fmt.Fprintf(&b, "KernelVersion: %q,\n", t.version)
+	fmt.Fprintf(&b, "Syscalls: []SyscallInfo{\n")
+	for _, s := range t.syscalls {
+		fmt.Fprintf(&b, "{%q, %d, %q},\n", s.name, s.number, s.since)
+	}
+	fmt.Fprintf(&b, "},\n")
+	writeValues(&b, "Errors", t.errors)
+	writeValues(&b, "Constants", t.constants)
+	fmt.Fprintf(&b, "}\n")
+
+	formatted, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, formatted, 0644)
+}
+
+func writeValues(b *bytes.Buffer, field string, values []value) {
+	fmt.Fprintf(b, "%s: []Constant{\n", field)
+	for _, v := range values {
+		if v.value < 0 || v.value > 0xFFFF {
+			fmt.Fprintf(b, "{%q, %s},\n", v.name, hex(v.value))
+		} else {
+			fmt.Fprintf(b, "{%q, %d},\n", v.name, v.value)
+		}
+	}
+	fmt.Fprintf(b, "},\n")
+}
+
+func hex(v int64) string {
+	if v < 0 {
+		return fmt.Sprintf("-0x%X", -v)
+	}
+	return fmt.Sprintf("0x%X", v)
+}
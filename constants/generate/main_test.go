@@ -0,0 +1,74 @@
+package main
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func constantsOf(t *table) map[string]int64 {
+	result := make(map[string]int64)
+	for _, v := range t.constants {
+		result[v.name] = v.value
+	}
+	return result
+}
+
+func (s *GenerateSuite) Test_generatesTheTablesFromTheCopyOfTheHeaders(c *C) {
+	t, err := generate("../linux", archs[0])
+	c.Assert(err, IsNil)
+
+	c.Assert(t.auditArch, Equals, int64(0xC000003E))
+	c.Assert(t.syscalls[0], Equals, syscall{"read", 0, "2.6.0"})
+	constants := constantsOf(t)
+	c.Assert(constants["PR_SET_NO_NEW_PRIVS"], Equals, int64(38))
+	c.Assert(constants["TCGETS2"], Equals, int64(0x802C542A))
+	c.Assert(constants["O_ASYNC"], Equals, int64(0x2000))
+}
+
+func (s *GenerateSuite) Test_generatesConstantsForTheArchitecture(c *C) {
+	x86_64, err := generate("../linux", archs[0])
+	c.Assert(err, IsNil)
+	i386, err := generate("../linux", archs[1])
+	c.Assert(err, IsNil)
+
+	c.Assert(x86_64.syscalls[0].name, Equals, "read")
+	c.Assert(i386.syscalls[0].name, Equals, "restart_syscall")
+	c.Assert(constantsOf(x86_64)["F_GETLK64"], Equals, int64(5))
+	c.Assert(constantsOf(i386)["F_GETLK64"], Equals, int64(12))
+}
+
+func (s *GenerateSuite) Test_readsConstantsFromTheCLibraryHeadersOfAFamily(c *C) {
+	t, err := generate("../linux", archs[0])
+	c.Assert(err, IsNil)
+
+	constants := constantsOf(t)
+	c.Assert(constants["RTCF_LOCAL"], Equals, int64(0x80000000))
+	c.Assert(constants["RTCF_LOG"], Equals, int64(0x2000000))
+	c.Assert(constants["RT_CLASS_MAIN"], Equals, int64(254))
+}
+
+func (s *GenerateSuite) Test_keepsLegacyConstantsTheHeadersDontDefine(c *C) {
+	t, err := generate("../linux", archs[1])
+	c.Assert(err, IsNil)
+
+	constants := constantsOf(t)
+	c.Assert(constants["WORDSIZE"], Equals, int64(32))
+	c.Assert(constants["FD_SETSIZE"], Equals, int64(1024))
+}
+
+// The values that changed when the tables stopped coming from Go's syscall package. They are listed in the README,
+// which has to be updated if this test changes
+func (s *GenerateSuite) Test_generatesTheDocumentedChangesToTheOldValues(c *C) {
+	t, err := generate("../linux", archs[0])
+	c.Assert(err, IsNil)
+
+	changed := map[string]int64{
+		"O_LARGEFILE": 0x8000, "SOMAXCONN": 4096, "AF_MAX": 46,
+		"EPOLLET": 0x80000000, "MS_NOUSER": 0x80000000,
+		"MS_RMT_MASK": 0x2800051, "PTRACE_O_MASK": 0x3000ff, "RTCF_NAT": 0x8800000,
+		"IFA_MAX": 11, "RTAX_MAX": 17, "RTA_MAX": 30, "RTM_MAX": 123, "RTM_NR_FAMILIES": 27, "RTM_NR_MSGTYPES": 108,
+	}
+	constants := constantsOf(t)
+	for name, value := range changed {
+		c.Check(constants[name], Equals, value, Commentf("%s", name))
+	}
+}
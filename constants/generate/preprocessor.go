@@ -0,0 +1,317 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// macro is a #define found in a header. Function-like macros have parameters, and params is nil for object-like macros
+type macro struct {
+	name   string
+	params []string
+	body   []token
+	file   string
+}
+
+// enumerator is a constant from an enum declaration
+type enumerator struct {
+	name  string
+	value []token
+	file  string
+}
+
+// preprocessor reads headers the way the C preprocessor would, as far as that is needed to find the values
+// of constants. It follows includes, keeps track of conditional blocks and collects all macros and enumerators.
+// Includes that can't be found are ignored, since most of them only declare types.
+type preprocessor struct {
+	includeDirs []string
+	macros      map[string]*macro
+	enumerators map[string]*enumerator
+	included    map[string]bool
+	sizes       map[string]int64
+}
+
+func newPreprocessor(includeDirs []string, predefined map[string]string, sizes map[string]int64) *preprocessor {
+	p := &preprocessor{
+		includeDirs: includeDirs,
+		macros:      make(map[string]*macro),
+		enumerators: make(map[string]*enumerator),
+		included:    make(map[string]bool),
+		sizes:       sizes,
+	}
+	for k, v := range predefined {
+		p.macros[k] = &macro{name: k, body: tokenize(v)}
+	}
+	return p
+}
+
+// find returns the path of the header with the given name, from the first include directory that has it
+func (p *preprocessor) find(name string) (string, bool) {
+	for _, d := range p.includeDirs {
+		path := filepath.Join(d, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+// include processes the header with the given name, unless it has already been included
+func (p *preprocessor) include(name string) error {
+	path, ok := p.find(name)
+	if !ok || p.included[path] {
+		return nil
+	}
+	p.included[path] = true
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return p.process(name, string(content))
+}
+
+// logicalLines joins lines ending in a backslash and removes comments
+func logicalLines(content string) []string {
+	content = strings.Replace(content, "\\\n", " ", -1)
+	var b strings.Builder
+	for i := 0; i < len(content); i++ {
+		switch {
+		case strings.HasPrefix(content[i:], "/*"):
+			end := strings.Index(content[i+2:], "*/")
+			if end < 0 {
+				i = len(content)
+				continue
+			}
+			comment := content[i : i+2+end+2]
+			b.WriteString(strings.Repeat("\n", strings.Count(comment, "\n")))
+			if !strings.Contains(comment, "\n") {
+				b.WriteByte(' ')
+			}
+			i += 2 + end + 1
+		case strings.HasPrefix(content[i:], "//"):
+			end := strings.Index(content[i:], "\n")
+			if end < 0 {
+				end = len(content) - i
+			}
+			i += end - 1
+		case content[i] == '"' || content[i] == '\'':
+			end := i + 1
+			for end < len(content) && content[end] != content[i] && content[end] != '\n' {
+				if content[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(content) {
+				end = len(content) - 1
+			}
+			b.WriteString(content[i : end+1])
+			i = end
+		default:
+			b.WriteByte(content[i])
+		}
+	}
+	return strings.Split(b.String(), "\n")
+}
+
+// conditional is the state of one #if block
+type conditional struct {
+	active      bool
+	taken       bool
+	outerActive bool
+}
+
+func (p *preprocessor) process(file, content string) error {
+	var stack []conditional
+	active := func() bool {
+		return len(stack) == 0 || stack[len(stack)-1].active
+	}
+	var text []string
+
+	for _, line := range logicalLines(content) {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "#") {
+			if active() {
+				text = append(text, line)
+			}
+			continue
+		}
+
+		directive := strings.TrimSpace(trimmed[1:])
+		name := directive
+		rest := ""
+		if ix := strings.IndexAny(directive, " \t(<\""); ix >= 0 {
+			name, rest = directive[:ix], strings.TrimSpace(directive[ix:])
+		}
+
+		switch name {
+		case "if", "ifdef", "ifndef":
+			outer := active()
+			cond := false
+			if outer {
+				switch name {
+				case "if":
+					cond = p.condition(rest)
+				case "ifdef":
+					_, cond = p.macros[rest]
+				case "ifndef":
+					_, cond = p.macros[rest]
+					cond = !cond
+				}
+			}
+			stack = append(stack, conditional{active: outer && cond, taken: cond, outerActive: outer})
+		case "elif":
+			if len(stack) == 0 {
+				return fmt.Errorf("%s: #elif without #if", file)
+			}
+			c := &stack[len(stack)-1]
+			c.active = c.outerActive && !c.taken && p.condition(rest)
+			c.taken = c.taken || c.active
+		case "else":
+			if len(stack) == 0 {
+				return fmt.Errorf("%s: #else without #if", file)
+			}
+			c := &stack[len(stack)-1]
+			c.active = c.outerActive && !c.taken
+			c.taken = true
+		case "endif":
+			if len(stack) == 0 {
+				return fmt.Errorf("%s: #endif without #if", file)
+			}
+			stack = stack[:len(stack)-1]
+		case "define":
+			if active() {
+				p.define(file, rest)
+			}
+		case "undef":
+			if active() {
+				delete(p.macros, rest)
+			}
+		case "include":
+			if active() {
+				// Enumerators before the include have to be collected first, in case the included file uses them
+				p.collectEnums(file, text)
+				text = nil
+				if err := p.include(strings.Trim(rest, "<>\" \t")); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	p.collectEnums(file, text)
+	return nil
+}
+
+func (p *preprocessor) define(file, definition string) {
+	end := 0
+	for end < len(definition) && isIdentifierChar(definition[end]) {
+		end++
+	}
+	m := &macro{name: definition[:end], file: file}
+	rest := definition[end:]
+	if strings.HasPrefix(rest, "(") {
+		close := strings.Index(rest, ")")
+		m.params = []string{}
+		for _, param := range strings.Split(rest[1:close], ",") {
+			if param = strings.TrimSpace(param); param != "" {
+				m.params = append(m.params, param)
+			}
+		}
+		rest = rest[close+1:]
+	}
+	m.body = tokenize(rest)
+	p.macros[m.name] = m
+}
+
+// condition returns the value of the expression of an #if or #elif. Expressions that can't be evaluated are false
+func (p *preprocessor) condition(expression string) bool {
+	tokens := p.replaceDefined(tokenize(expression))
+	v, err := p.evaluateTokens(tokens, true)
+	return err == nil && v != 0
+}
+
+// replaceDefined replaces all uses of the defined operator with their value
+func (p *preprocessor) replaceDefined(tokens []token) []token {
+	var result []token
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i].text != "defined" {
+			result = append(result, tokens[i])
+			continue
+		}
+		name := ""
+		if i+1 < len(tokens) && tokens[i+1].text == "(" && i+3 < len(tokens) {
+			name = tokens[i+2].text
+			i += 3
+		} else if i+1 < len(tokens) {
+			name = tokens[i+1].text
+			i++
+		}
+		value := "0"
+		if _, ok := p.macros[name]; ok {
+			value = "1"
+		}
+		result = append(result, token{kind: number, text: value})
+	}
+	return result
+}
+
+// collectEnums finds all enum declarations in the given source text, and records the expression for each enumerator
+func (p *preprocessor) collectEnums(file string, text []string) {
+	tokens := tokenize(strings.Join(text, "\n"))
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i].text != "enum" {
+			continue
+		}
+		j := i + 1
+		for j < len(tokens) && tokens[j].kind == identifier {
+			j++
+		}
+		if j >= len(tokens) || tokens[j].text != "{" {
+			continue
+		}
+
+		// Enumerators without a value are one larger than the previous one, so they are recorded as
+		// the last explicit value plus an offset
+		var base []token
+		offset := int64(0)
+		j++
+		for j < len(tokens) && tokens[j].text != "}" {
+			if tokens[j].kind != identifier {
+				j++
+				continue
+			}
+			name := tokens[j].text
+			j++
+			var value []token
+			if j < len(tokens) && tokens[j].text == "=" {
+				j++
+				depth := 0
+				for j < len(tokens) && (depth > 0 || (tokens[j].text != "," && tokens[j].text != "}")) {
+					if tokens[j].text == "(" {
+						depth++
+					} else if tokens[j].text == ")" {
+						depth--
+					}
+					value = append(value, tokens[j])
+					j++
+				}
+				base, offset = value, 0
+			} else if base == nil {
+				value = []token{{kind: number, text: fmt.Sprintf("%d", offset)}}
+			} else {
+				value = append([]token{{kind: punctuation, text: "("}}, base...)
+				value = append(value, token{kind: punctuation, text: ")"}, token{kind: punctuation, text: "+"}, token{kind: number, text: fmt.Sprintf("%d", offset)})
+			}
+			offset++
+			p.enumerators[name] = &enumerator{name: name, value: value, file: file}
+			if j < len(tokens) && tokens[j].text == "," {
+				j++
+			}
+		}
+		i = j
+	}
+}
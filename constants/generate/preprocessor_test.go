@@ -0,0 +1,214 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *GenerateSuite) Test_joinsContinuedLines(c *C) {
+	c.Assert(logicalLines("#define A 1 + \\\n2\nB"), DeepEquals, []string{"#define A 1 +  2", "B"})
+}
+
+func (s *GenerateSuite) Test_removesCommentsButKeepsLineNumbers(c *C) {
+	c.Assert(logicalLines("A /* one */ B\n/* two\nlines */ C // three\nD"), DeepEquals, []string{"A   B", "", " C ", "D"})
+}
+
+func (s *GenerateSuite) Test_doesntRemoveCommentsInStrings(c *C) {
+	c.Assert(logicalLines(`#define A "/* not a comment */"`), DeepEquals, []string{`#define A "/* not a comment */"`})
+}
+
+func (s *GenerateSuite) Test_definesMacros(c *C) {
+	p := processed(c, "#define A 1\n#define F(x, y) ((x) + (y))\n#define G() 1\n#define H (1)\n#  define I 2")
+
+	c.Assert(p.macros["A"].params, IsNil)
+	c.Assert(p.macros["A"].file, Equals, "test.h")
+	c.Assert(p.macros["F"].params, DeepEquals, []string{"x", "y"})
+	c.Assert(p.macros["G"].params, DeepEquals, []string{})
+	c.Assert(p.macros["H"].params, IsNil)
+	c.Assert(p.macros["I"], NotNil)
+}
+
+func (s *GenerateSuite) Test_undefinesMacros(c *C) {
+	p := processed(c, "#define A 1\n#define B 2\n#undef A")
+
+	c.Assert(p.macros["A"], IsNil)
+	c.Assert(p.macros["B"], NotNil)
+}
+
+func (s *GenerateSuite) Test_usesPredefinedMacros(c *C) {
+	c.Assert(evaluated(c, "#ifdef __x86_64__\n#define A 1\n#else\n#define A 2\n#endif", "A"), Equals, int64(1))
+	c.Assert(evaluated(c, "#if __x86_64__\n#define A 1\n#else\n#define A 2\n#endif", "A"), Equals, int64(1))
+}
+
+func (s *GenerateSuite) Test_keepsOnlyTheTakenBranchOfConditionals(c *C) {
+	source := `
+#if X == 1
+#define A 1
+#elif X == 2
+#define A 2
+#elif X == 3
+#define A 3
+#else
+#define A 4
+#endif
+`
+	c.Assert(evaluated(c, "#define X 1"+source, "A"), Equals, int64(1))
+	c.Assert(evaluated(c, "#define X 2"+source, "A"), Equals, int64(2))
+	c.Assert(evaluated(c, "#define X 3"+source, "A"), Equals, int64(3))
+	c.Assert(evaluated(c, "#define X 5"+source, "A"), Equals, int64(4))
+}
+
+func (s *GenerateSuite) Test_takesOnlyTheFirstTrueBranch(c *C) {
+	c.Assert(evaluated(c, "#if 1\n#define A 1\n#elif 1\n#define A 2\n#else\n#define A 3\n#endif", "A"), Equals, int64(1))
+}
+
+func (s *GenerateSuite) Test_handlesIfdefAndIfndef(c *C) {
+	p := processed(c, `
+#define X
+#ifdef X
+#define A 1
+#endif
+#ifdef Y
+#define B 1
+#endif
+#ifndef X
+#define C 1
+#endif
+#ifndef Y
+#define D 1
+#endif
+`)
+	c.Assert(p.macros["A"], NotNil)
+	c.Assert(p.macros["B"], IsNil)
+	c.Assert(p.macros["C"], IsNil)
+	c.Assert(p.macros["D"], NotNil)
+}
+
+func (s *GenerateSuite) Test_ignoresEverythingInsideInactiveBlocks(c *C) {
+	p := processed(c, `
+#if 0
+#if 1
+#define A 1
+#else
+#define B 1
+#endif
+#elif 0
+#else
+#define C 1
+#endif
+#ifdef UNDEFINED
+#undef __x86_64__
+#endif
+`)
+	c.Assert(p.macros["A"], IsNil)
+	c.Assert(p.macros["B"], IsNil)
+	c.Assert(p.macros["C"], NotNil)
+	c.Assert(p.macros["__x86_64__"], NotNil)
+}
+
+func (s *GenerateSuite) Test_evaluatesTheDefinedOperator(c *C) {
+	source := "#if defined(__x86_64__) && !defined X\n#define A 1\n#else\n#define A 2\n#endif"
+	c.Assert(evaluated(c, source, "A"), Equals, int64(1))
+	c.Assert(evaluated(c, "#define X\n"+source, "A"), Equals, int64(2))
+}
+
+func (s *GenerateSuite) Test_treatsUnknownIdentifiersInConditionsAsZero(c *C) {
+	c.Assert(evaluated(c, "#if UNKNOWN\n#define A 1\n#else\n#define A 2\n#endif", "A"), Equals, int64(2))
+	c.Assert(evaluated(c, "#if UNKNOWN == 0\n#define A 1\n#else\n#define A 2\n#endif", "A"), Equals, int64(1))
+}
+
+func (s *GenerateSuite) Test_treatsConditionsThatCantBeEvaluatedAsFalse(c *C) {
+	c.Assert(evaluated(c, "#if 1 / 0\n#define A 1\n#else\n#define A 2\n#endif", "A"), Equals, int64(2))
+}
+
+func (s *GenerateSuite) Test_reportsUnbalancedConditionals(c *C) {
+	p := newPreprocessor(nil, nil, sizes(8))
+	c.Assert(p.process("test.h", "#endif"), ErrorMatches, "test.h: #endif without #if")
+	c.Assert(p.process("test.h", "#else"), ErrorMatches, "test.h: #else without #if")
+	c.Assert(p.process("test.h", "#elif 1"), ErrorMatches, "test.h: #elif without #if")
+}
+
+func (s *GenerateSuite) Test_numbersEnumerators(c *C) {
+	p := processed(c, `
+enum rt_scope_t {
+	RT_SCOPE_UNIVERSE = 0,
+	RT_SCOPE_SITE = 200,
+	RT_SCOPE_LINK,
+	RT_SCOPE_HOST = RT_SCOPE_LINK + 1,
+	RT_SCOPE_NOWHERE
+};
+enum { FIRST, SECOND, THIRD = (1 << 4), FOURTH };
+`)
+	expected := map[string]int64{
+		"RT_SCOPE_UNIVERSE": 0, "RT_SCOPE_SITE": 200, "RT_SCOPE_LINK": 201, "RT_SCOPE_HOST": 202, "RT_SCOPE_NOWHERE": 203,
+		"FIRST": 0, "SECOND": 1, "THIRD": 16, "FOURTH": 17,
+	}
+	for name, value := range expected {
+		v, err := p.evaluate(name)
+		c.Assert(err, IsNil)
+		c.Check(v, Equals, value, Commentf("%s", name))
+		c.Check(p.enumerators[name].file, Equals, "test.h")
+	}
+}
+
+func (s *GenerateSuite) Test_ignoresEnumsInInactiveBlocks(c *C) {
+	p := processed(c, "#if 0\nenum { A = 1 };\n#endif\nenum { B = 2 };")
+
+	c.Assert(p.enumerators["A"], IsNil)
+	c.Assert(p.enumerators["B"], NotNil)
+}
+
+func headerDir(c *C, headers map[string]string) string {
+	dir := c.MkDir()
+	for name, content := range headers {
+		path := filepath.Join(dir, name)
+		c.Assert(os.MkdirAll(filepath.Dir(path), 0755), IsNil)
+		c.Assert(ioutil.WriteFile(path, []byte(content), 0644), IsNil)
+	}
+	return dir
+}
+
+func (s *GenerateSuite) Test_followsIncludes(c *C) {
+	dir := headerDir(c, map[string]string{
+		"linux/a.h": "#include <asm/b.h>\n#include \"linux/c.h\"\n#include <missing.h>\n#define A (B + C)",
+		"asm/b.h":   "#define B 1",
+		"linux/c.h": "#ifndef C_H\n#define C_H\n#include <linux/a.h>\n#define C 2\n#endif",
+	})
+	p := newPreprocessor([]string{dir}, nil, sizes(8))
+	c.Assert(p.include("linux/a.h"), IsNil)
+
+	v, err := p.evaluate("A")
+	c.Assert(err, IsNil)
+	c.Assert(v, Equals, int64(3))
+	c.Assert(p.macros["B"].file, Equals, "asm/b.h")
+	c.Assert(p.macros["C"].file, Equals, "linux/c.h")
+}
+
+func (s *GenerateSuite) Test_findsHeadersInTheFirstDirectoryThatHasThem(c *C) {
+	first := headerDir(c, map[string]string{"a.h": "#define A 1"})
+	second := headerDir(c, map[string]string{"a.h": "#define A 2", "b.h": "#define B 2"})
+	p := newPreprocessor([]string{first, second}, nil, sizes(8))
+
+	path, ok := p.find("a.h")
+	c.Assert(ok, Equals, true)
+	c.Assert(path, Equals, filepath.Join(first, "a.h"))
+	path, ok = p.find("b.h")
+	c.Assert(ok, Equals, true)
+	c.Assert(path, Equals, filepath.Join(second, "b.h"))
+	_, ok = p.find("c.h")
+	c.Assert(ok, Equals, false)
+}
+
+func (s *GenerateSuite) Test_includedFilesCanUseEnumeratorsDefinedBeforeTheInclude(c *C) {
+	dir := headerDir(c, map[string]string{
+		"a.h": "enum { A = 1 };\n#include <b.h>",
+		"b.h": "#if A == 1\n#define B 1\n#endif",
+	})
+	p := newPreprocessor([]string{dir}, nil, sizes(8))
+	c.Assert(p.include("a.h"), IsNil)
+
+	c.Assert(p.macros["B"], NotNil)
+}
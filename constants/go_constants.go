@@ -1,9 +1,6 @@
 package constants
 
-import (
-	"strings"
-	"syscall"
-)
+import "strings"
 
 // AllConstants contain a mapping from the name of a constant to its value
 var AllConstants = make(map[string]int)
@@ -45,1700 +42,25 @@ func RegisterSyscall(name string, num int) {
 	SyscallNumbers[num] = nm
 }
 
+// init registers the syscalls, errors and constants of the native architecture
 func init() {
-	RegisterConstant("AF_ALG", syscall.AF_ALG)
-	RegisterConstant("AF_APPLETALK", syscall.AF_APPLETALK)
-	RegisterConstant("AF_ASH", syscall.AF_ASH)
-	RegisterConstant("AF_ATMPVC", syscall.AF_ATMPVC)
-	RegisterConstant("AF_ATMSVC", syscall.AF_ATMSVC)
-	RegisterConstant("AF_AX25", syscall.AF_AX25)
-	RegisterConstant("AF_BLUETOOTH", syscall.AF_BLUETOOTH)
-	RegisterConstant("AF_BRIDGE", syscall.AF_BRIDGE)
-	RegisterConstant("AF_CAIF", syscall.AF_CAIF)
-	RegisterConstant("AF_CAN", syscall.AF_CAN)
-	RegisterConstant("AF_DECnet", syscall.AF_DECnet)
-	RegisterConstant("AF_ECONET", syscall.AF_ECONET)
-	RegisterConstant("AF_FILE", syscall.AF_FILE)
-	RegisterConstant("AF_IEEE802154", syscall.AF_IEEE802154)
-	RegisterConstant("AF_INET", syscall.AF_INET)
-	RegisterConstant("AF_INET6", syscall.AF_INET6)
-	RegisterConstant("AF_IPX", syscall.AF_IPX)
-	RegisterConstant("AF_IRDA", syscall.AF_IRDA)
-	RegisterConstant("AF_ISDN", syscall.AF_ISDN)
-	RegisterConstant("AF_IUCV", syscall.AF_IUCV)
-	RegisterConstant("AF_KEY", syscall.AF_KEY)
-	RegisterConstant("AF_LLC", syscall.AF_LLC)
-	RegisterConstant("AF_LOCAL", syscall.AF_LOCAL)
-	RegisterConstant("AF_MAX", syscall.AF_MAX)
-	RegisterConstant("AF_NETBEUI", syscall.AF_NETBEUI)
-	RegisterConstant("AF_NETLINK", syscall.AF_NETLINK)
-	RegisterConstant("AF_NETROM", syscall.AF_NETROM)
-	RegisterConstant("AF_PACKET", syscall.AF_PACKET)
-	RegisterConstant("AF_PHONET", syscall.AF_PHONET)
-	RegisterConstant("AF_PPPOX", syscall.AF_PPPOX)
-	RegisterConstant("AF_RDS", syscall.AF_RDS)
-	RegisterConstant("AF_ROSE", syscall.AF_ROSE)
-	RegisterConstant("AF_ROUTE", syscall.AF_ROUTE)
-	RegisterConstant("AF_RXRPC", syscall.AF_RXRPC)
-	RegisterConstant("AF_SECURITY", syscall.AF_SECURITY)
-	RegisterConstant("AF_SNA", syscall.AF_SNA)
-	RegisterConstant("AF_TIPC", syscall.AF_TIPC)
-	RegisterConstant("AF_UNIX", syscall.AF_UNIX)
-	RegisterConstant("AF_UNSPEC", syscall.AF_UNSPEC)
-	RegisterConstant("AF_WANPIPE", syscall.AF_WANPIPE)
-	RegisterConstant("AF_X25", syscall.AF_X25)
-	RegisterConstant("ARPHRD_ADAPT", syscall.ARPHRD_ADAPT)
-	RegisterConstant("ARPHRD_APPLETLK", syscall.ARPHRD_APPLETLK)
-	RegisterConstant("ARPHRD_ARCNET", syscall.ARPHRD_ARCNET)
-	RegisterConstant("ARPHRD_ASH", syscall.ARPHRD_ASH)
-	RegisterConstant("ARPHRD_ATM", syscall.ARPHRD_ATM)
-	RegisterConstant("ARPHRD_AX25", syscall.ARPHRD_AX25)
-	RegisterConstant("ARPHRD_BIF", syscall.ARPHRD_BIF)
-	RegisterConstant("ARPHRD_CHAOS", syscall.ARPHRD_CHAOS)
-	RegisterConstant("ARPHRD_CISCO", syscall.ARPHRD_CISCO)
-	RegisterConstant("ARPHRD_CSLIP", syscall.ARPHRD_CSLIP)
-	RegisterConstant("ARPHRD_CSLIP6", syscall.ARPHRD_CSLIP6)
-	RegisterConstant("ARPHRD_DDCMP", syscall.ARPHRD_DDCMP)
-	RegisterConstant("ARPHRD_DLCI", syscall.ARPHRD_DLCI)
-	RegisterConstant("ARPHRD_ECONET", syscall.ARPHRD_ECONET)
-	RegisterConstant("ARPHRD_EETHER", syscall.ARPHRD_EETHER)
-	RegisterConstant("ARPHRD_ETHER", syscall.ARPHRD_ETHER)
-	RegisterConstant("ARPHRD_EUI64", syscall.ARPHRD_EUI64)
-	RegisterConstant("ARPHRD_FCAL", syscall.ARPHRD_FCAL)
-	RegisterConstant("ARPHRD_FCFABRIC", syscall.ARPHRD_FCFABRIC)
-	RegisterConstant("ARPHRD_FCPL", syscall.ARPHRD_FCPL)
-	RegisterConstant("ARPHRD_FCPP", syscall.ARPHRD_FCPP)
-	RegisterConstant("ARPHRD_FDDI", syscall.ARPHRD_FDDI)
-	RegisterConstant("ARPHRD_FRAD", syscall.ARPHRD_FRAD)
-	RegisterConstant("ARPHRD_HDLC", syscall.ARPHRD_HDLC)
-	RegisterConstant("ARPHRD_HIPPI", syscall.ARPHRD_HIPPI)
-	RegisterConstant("ARPHRD_HWX25", syscall.ARPHRD_HWX25)
-	RegisterConstant("ARPHRD_IEEE1394", syscall.ARPHRD_IEEE1394)
-	RegisterConstant("ARPHRD_IEEE802", syscall.ARPHRD_IEEE802)
-	RegisterConstant("ARPHRD_IEEE80211", syscall.ARPHRD_IEEE80211)
-	RegisterConstant("ARPHRD_IEEE80211_PRISM", syscall.ARPHRD_IEEE80211_PRISM)
-	RegisterConstant("ARPHRD_IEEE80211_RADIOTAP", syscall.ARPHRD_IEEE80211_RADIOTAP)
-	RegisterConstant("ARPHRD_IEEE802154", syscall.ARPHRD_IEEE802154)
-	RegisterConstant("ARPHRD_IEEE802154_PHY", syscall.ARPHRD_IEEE802154_PHY)
-	RegisterConstant("ARPHRD_IEEE802_TR", syscall.ARPHRD_IEEE802_TR)
-	RegisterConstant("ARPHRD_INFINIBAND", syscall.ARPHRD_INFINIBAND)
-	RegisterConstant("ARPHRD_IPDDP", syscall.ARPHRD_IPDDP)
-	RegisterConstant("ARPHRD_IPGRE", syscall.ARPHRD_IPGRE)
-	RegisterConstant("ARPHRD_IRDA", syscall.ARPHRD_IRDA)
-	RegisterConstant("ARPHRD_LAPB", syscall.ARPHRD_LAPB)
-	RegisterConstant("ARPHRD_LOCALTLK", syscall.ARPHRD_LOCALTLK)
-	RegisterConstant("ARPHRD_LOOPBACK", syscall.ARPHRD_LOOPBACK)
-	RegisterConstant("ARPHRD_METRICOM", syscall.ARPHRD_METRICOM)
-	RegisterConstant("ARPHRD_NETROM", syscall.ARPHRD_NETROM)
-	RegisterConstant("ARPHRD_NONE", syscall.ARPHRD_NONE)
-	RegisterConstant("ARPHRD_PIMREG", syscall.ARPHRD_PIMREG)
-	RegisterConstant("ARPHRD_PPP", syscall.ARPHRD_PPP)
-	RegisterConstant("ARPHRD_PRONET", syscall.ARPHRD_PRONET)
-	RegisterConstant("ARPHRD_RAWHDLC", syscall.ARPHRD_RAWHDLC)
-	RegisterConstant("ARPHRD_ROSE", syscall.ARPHRD_ROSE)
-	RegisterConstant("ARPHRD_RSRVD", syscall.ARPHRD_RSRVD)
-	RegisterConstant("ARPHRD_SIT", syscall.ARPHRD_SIT)
-	RegisterConstant("ARPHRD_SKIP", syscall.ARPHRD_SKIP)
-	RegisterConstant("ARPHRD_SLIP", syscall.ARPHRD_SLIP)
-	RegisterConstant("ARPHRD_SLIP6", syscall.ARPHRD_SLIP6)
-	RegisterConstant("ARPHRD_TUNNEL", syscall.ARPHRD_TUNNEL)
-	RegisterConstant("ARPHRD_TUNNEL6", syscall.ARPHRD_TUNNEL6)
-	RegisterConstant("ARPHRD_VOID", syscall.ARPHRD_VOID)
-	RegisterConstant("ARPHRD_X25", syscall.ARPHRD_X25)
-	RegisterConstant("BPF_A", syscall.BPF_A)
-	RegisterConstant("BPF_ABS", syscall.BPF_ABS)
-	RegisterConstant("BPF_ADD", syscall.BPF_ADD)
-	RegisterConstant("BPF_ALU", syscall.BPF_ALU)
-	RegisterConstant("BPF_AND", syscall.BPF_AND)
-	RegisterConstant("BPF_B", syscall.BPF_B)
-	RegisterConstant("BPF_DIV", syscall.BPF_DIV)
-	RegisterConstant("BPF_H", syscall.BPF_H)
-	RegisterConstant("BPF_IMM", syscall.BPF_IMM)
-	RegisterConstant("BPF_IND", syscall.BPF_IND)
-	RegisterConstant("BPF_JA", syscall.BPF_JA)
-	RegisterConstant("BPF_JEQ", syscall.BPF_JEQ)
-	RegisterConstant("BPF_JGE", syscall.BPF_JGE)
-	RegisterConstant("BPF_JGT", syscall.BPF_JGT)
-	RegisterConstant("BPF_JMP", syscall.BPF_JMP)
-	RegisterConstant("BPF_JSET", syscall.BPF_JSET)
-	RegisterConstant("BPF_K", syscall.BPF_K)
-	RegisterConstant("BPF_LD", syscall.BPF_LD)
-	RegisterConstant("BPF_LDX", syscall.BPF_LDX)
-	RegisterConstant("BPF_LEN", syscall.BPF_LEN)
-	RegisterConstant("BPF_LSH", syscall.BPF_LSH)
-	RegisterConstant("BPF_MAJOR_VERSION", syscall.BPF_MAJOR_VERSION)
-	RegisterConstant("BPF_MAXINSNS", syscall.BPF_MAXINSNS)
-	RegisterConstant("BPF_MEM", syscall.BPF_MEM)
-	RegisterConstant("BPF_MEMWORDS", syscall.BPF_MEMWORDS)
-	RegisterConstant("BPF_MINOR_VERSION", syscall.BPF_MINOR_VERSION)
-	RegisterConstant("BPF_MISC", syscall.BPF_MISC)
-	RegisterConstant("BPF_MSH", syscall.BPF_MSH)
-	RegisterConstant("BPF_MUL", syscall.BPF_MUL)
-	RegisterConstant("BPF_NEG", syscall.BPF_NEG)
-	RegisterConstant("BPF_OR", syscall.BPF_OR)
-	RegisterConstant("BPF_RET", syscall.BPF_RET)
-	RegisterConstant("BPF_RSH", syscall.BPF_RSH)
-	RegisterConstant("BPF_ST", syscall.BPF_ST)
-	RegisterConstant("BPF_STX", syscall.BPF_STX)
-	RegisterConstant("BPF_SUB", syscall.BPF_SUB)
-	RegisterConstant("BPF_TAX", syscall.BPF_TAX)
-	RegisterConstant("BPF_TXA", syscall.BPF_TXA)
-	RegisterConstant("BPF_W", syscall.BPF_W)
-	RegisterConstant("BPF_X", syscall.BPF_X)
-	RegisterConstant("CLONE_CHILD_CLEARTID", syscall.CLONE_CHILD_CLEARTID)
-	RegisterConstant("CLONE_CHILD_SETTID", syscall.CLONE_CHILD_SETTID)
-	RegisterConstant("CLONE_DETACHED", syscall.CLONE_DETACHED)
-	RegisterConstant("CLONE_FILES", syscall.CLONE_FILES)
-	RegisterConstant("CLONE_FS", syscall.CLONE_FS)
-	RegisterConstant("CLONE_IO", syscall.CLONE_IO)
-	RegisterConstant("CLONE_NEWIPC", syscall.CLONE_NEWIPC)
-	RegisterConstant("CLONE_NEWNET", syscall.CLONE_NEWNET)
-	RegisterConstant("CLONE_NEWNS", syscall.CLONE_NEWNS)
-	RegisterConstant("CLONE_NEWPID", syscall.CLONE_NEWPID)
-	RegisterConstant("CLONE_NEWUSER", syscall.CLONE_NEWUSER)
-	RegisterConstant("CLONE_NEWUTS", syscall.CLONE_NEWUTS)
-	RegisterConstant("CLONE_PARENT", syscall.CLONE_PARENT)
-	RegisterConstant("CLONE_PARENT_SETTID", syscall.CLONE_PARENT_SETTID)
-	RegisterConstant("CLONE_PTRACE", syscall.CLONE_PTRACE)
-	RegisterConstant("CLONE_SETTLS", syscall.CLONE_SETTLS)
-	RegisterConstant("CLONE_SIGHAND", syscall.CLONE_SIGHAND)
-	RegisterConstant("CLONE_SYSVSEM", syscall.CLONE_SYSVSEM)
-	RegisterConstant("CLONE_THREAD", syscall.CLONE_THREAD)
-	RegisterConstant("CLONE_UNTRACED", syscall.CLONE_UNTRACED)
-	RegisterConstant("CLONE_VFORK", syscall.CLONE_VFORK)
-	RegisterConstant("CLONE_VM", syscall.CLONE_VM)
-	RegisterConstant("DT_BLK", syscall.DT_BLK)
-	RegisterConstant("DT_CHR", syscall.DT_CHR)
-	RegisterConstant("DT_DIR", syscall.DT_DIR)
-	RegisterConstant("DT_FIFO", syscall.DT_FIFO)
-	RegisterConstant("DT_LNK", syscall.DT_LNK)
-	RegisterConstant("DT_REG", syscall.DT_REG)
-	RegisterConstant("DT_SOCK", syscall.DT_SOCK)
-	RegisterConstant("DT_UNKNOWN", syscall.DT_UNKNOWN)
-	RegisterConstant("DT_WHT", syscall.DT_WHT)
-	RegisterConstant("EPOLLERR", syscall.EPOLLERR)
-	RegisterConstant("EPOLLET", syscall.EPOLLET)
-	RegisterConstant("EPOLLHUP", syscall.EPOLLHUP)
-	RegisterConstant("EPOLLIN", syscall.EPOLLIN)
-	RegisterConstant("EPOLLMSG", syscall.EPOLLMSG)
-	RegisterConstant("EPOLLONESHOT", syscall.EPOLLONESHOT)
-	RegisterConstant("EPOLLOUT", syscall.EPOLLOUT)
-	RegisterConstant("EPOLLPRI", syscall.EPOLLPRI)
-	RegisterConstant("EPOLLRDBAND", syscall.EPOLLRDBAND)
-	RegisterConstant("EPOLLRDHUP", syscall.EPOLLRDHUP)
-	RegisterConstant("EPOLLRDNORM", syscall.EPOLLRDNORM)
-	RegisterConstant("EPOLLWRBAND", syscall.EPOLLWRBAND)
-	RegisterConstant("EPOLLWRNORM", syscall.EPOLLWRNORM)
-	RegisterConstant("EPOLL_CLOEXEC", syscall.EPOLL_CLOEXEC)
-	RegisterConstant("EPOLL_CTL_ADD", syscall.EPOLL_CTL_ADD)
-	RegisterConstant("EPOLL_CTL_DEL", syscall.EPOLL_CTL_DEL)
-	RegisterConstant("EPOLL_CTL_MOD", syscall.EPOLL_CTL_MOD)
-	RegisterConstant("EPOLL_NONBLOCK", syscall.EPOLL_NONBLOCK)
-	RegisterConstant("ETH_P_1588", syscall.ETH_P_1588)
-	RegisterConstant("ETH_P_8021Q", syscall.ETH_P_8021Q)
-	RegisterConstant("ETH_P_802_2", syscall.ETH_P_802_2)
-	RegisterConstant("ETH_P_802_3", syscall.ETH_P_802_3)
-	RegisterConstant("ETH_P_AARP", syscall.ETH_P_AARP)
-	RegisterConstant("ETH_P_ALL", syscall.ETH_P_ALL)
-	RegisterConstant("ETH_P_AOE", syscall.ETH_P_AOE)
-	RegisterConstant("ETH_P_ARCNET", syscall.ETH_P_ARCNET)
-	RegisterConstant("ETH_P_ARP", syscall.ETH_P_ARP)
-	RegisterConstant("ETH_P_ATALK", syscall.ETH_P_ATALK)
-	RegisterConstant("ETH_P_ATMFATE", syscall.ETH_P_ATMFATE)
-	RegisterConstant("ETH_P_ATMMPOA", syscall.ETH_P_ATMMPOA)
-	RegisterConstant("ETH_P_AX25", syscall.ETH_P_AX25)
-	RegisterConstant("ETH_P_BPQ", syscall.ETH_P_BPQ)
-	RegisterConstant("ETH_P_CAIF", syscall.ETH_P_CAIF)
-	RegisterConstant("ETH_P_CAN", syscall.ETH_P_CAN)
-	RegisterConstant("ETH_P_CONTROL", syscall.ETH_P_CONTROL)
-	RegisterConstant("ETH_P_CUST", syscall.ETH_P_CUST)
-	RegisterConstant("ETH_P_DDCMP", syscall.ETH_P_DDCMP)
-	RegisterConstant("ETH_P_DEC", syscall.ETH_P_DEC)
-	RegisterConstant("ETH_P_DIAG", syscall.ETH_P_DIAG)
-	RegisterConstant("ETH_P_DNA_DL", syscall.ETH_P_DNA_DL)
-	RegisterConstant("ETH_P_DNA_RC", syscall.ETH_P_DNA_RC)
-	RegisterConstant("ETH_P_DNA_RT", syscall.ETH_P_DNA_RT)
-	RegisterConstant("ETH_P_DSA", syscall.ETH_P_DSA)
-	RegisterConstant("ETH_P_ECONET", syscall.ETH_P_ECONET)
-	RegisterConstant("ETH_P_EDSA", syscall.ETH_P_EDSA)
-	RegisterConstant("ETH_P_FCOE", syscall.ETH_P_FCOE)
-	RegisterConstant("ETH_P_FIP", syscall.ETH_P_FIP)
-	RegisterConstant("ETH_P_HDLC", syscall.ETH_P_HDLC)
-	RegisterConstant("ETH_P_IEEE802154", syscall.ETH_P_IEEE802154)
-	RegisterConstant("ETH_P_IEEEPUP", syscall.ETH_P_IEEEPUP)
-	RegisterConstant("ETH_P_IEEEPUPAT", syscall.ETH_P_IEEEPUPAT)
-	RegisterConstant("ETH_P_IP", syscall.ETH_P_IP)
-	RegisterConstant("ETH_P_IPV6", syscall.ETH_P_IPV6)
-	RegisterConstant("ETH_P_IPX", syscall.ETH_P_IPX)
-	RegisterConstant("ETH_P_IRDA", syscall.ETH_P_IRDA)
-	RegisterConstant("ETH_P_LAT", syscall.ETH_P_LAT)
-	RegisterConstant("ETH_P_LINK_CTL", syscall.ETH_P_LINK_CTL)
-	RegisterConstant("ETH_P_LOCALTALK", syscall.ETH_P_LOCALTALK)
-	RegisterConstant("ETH_P_LOOP", syscall.ETH_P_LOOP)
-	RegisterConstant("ETH_P_MOBITEX", syscall.ETH_P_MOBITEX)
-	RegisterConstant("ETH_P_MPLS_MC", syscall.ETH_P_MPLS_MC)
-	RegisterConstant("ETH_P_MPLS_UC", syscall.ETH_P_MPLS_UC)
-	RegisterConstant("ETH_P_PAE", syscall.ETH_P_PAE)
-	RegisterConstant("ETH_P_PAUSE", syscall.ETH_P_PAUSE)
-	RegisterConstant("ETH_P_PHONET", syscall.ETH_P_PHONET)
-	RegisterConstant("ETH_P_PPPTALK", syscall.ETH_P_PPPTALK)
-	RegisterConstant("ETH_P_PPP_DISC", syscall.ETH_P_PPP_DISC)
-	RegisterConstant("ETH_P_PPP_MP", syscall.ETH_P_PPP_MP)
-	RegisterConstant("ETH_P_PPP_SES", syscall.ETH_P_PPP_SES)
-	RegisterConstant("ETH_P_PUP", syscall.ETH_P_PUP)
-	RegisterConstant("ETH_P_PUPAT", syscall.ETH_P_PUPAT)
-	RegisterConstant("ETH_P_RARP", syscall.ETH_P_RARP)
-	RegisterConstant("ETH_P_SCA", syscall.ETH_P_SCA)
-	RegisterConstant("ETH_P_SLOW", syscall.ETH_P_SLOW)
-	RegisterConstant("ETH_P_SNAP", syscall.ETH_P_SNAP)
-	RegisterConstant("ETH_P_TEB", syscall.ETH_P_TEB)
-	RegisterConstant("ETH_P_TIPC", syscall.ETH_P_TIPC)
-	RegisterConstant("ETH_P_TRAILER", syscall.ETH_P_TRAILER)
-	RegisterConstant("ETH_P_TR_802_2", syscall.ETH_P_TR_802_2)
-	RegisterConstant("ETH_P_WAN_PPP", syscall.ETH_P_WAN_PPP)
-	RegisterConstant("ETH_P_WCCP", syscall.ETH_P_WCCP)
-	RegisterConstant("ETH_P_X25", syscall.ETH_P_X25)
-	RegisterConstant("FD_CLOEXEC", syscall.FD_CLOEXEC)
-	RegisterConstant("FD_SETSIZE", syscall.FD_SETSIZE)
-	RegisterConstant("F_DUPFD", syscall.F_DUPFD)
-	RegisterConstant("F_DUPFD_CLOEXEC", syscall.F_DUPFD_CLOEXEC)
-	RegisterConstant("F_EXLCK", syscall.F_EXLCK)
-	RegisterConstant("F_GETFD", syscall.F_GETFD)
-	RegisterConstant("F_GETFL", syscall.F_GETFL)
-	RegisterConstant("F_GETLEASE", syscall.F_GETLEASE)
-	RegisterConstant("F_GETLK", syscall.F_GETLK)
-	RegisterConstant("F_GETLK64", syscall.F_GETLK64)
-	RegisterConstant("F_GETOWN", syscall.F_GETOWN)
-	RegisterConstant("F_GETOWN_EX", syscall.F_GETOWN_EX)
-	RegisterConstant("F_GETPIPE_SZ", syscall.F_GETPIPE_SZ)
-	RegisterConstant("F_GETSIG", syscall.F_GETSIG)
-	RegisterConstant("F_LOCK", syscall.F_LOCK)
-	RegisterConstant("F_NOTIFY", syscall.F_NOTIFY)
-	RegisterConstant("F_OK", syscall.F_OK)
-	RegisterConstant("F_RDLCK", syscall.F_RDLCK)
-	RegisterConstant("F_SETFD", syscall.F_SETFD)
-	RegisterConstant("F_SETFL", syscall.F_SETFL)
-	RegisterConstant("F_SETLEASE", syscall.F_SETLEASE)
-	RegisterConstant("F_SETLK", syscall.F_SETLK)
-	RegisterConstant("F_SETLK64", syscall.F_SETLK64)
-	RegisterConstant("F_SETLKW", syscall.F_SETLKW)
-	RegisterConstant("F_SETLKW64", syscall.F_SETLKW64)
-	RegisterConstant("F_SETOWN", syscall.F_SETOWN)
-	RegisterConstant("F_SETOWN_EX", syscall.F_SETOWN_EX)
-	RegisterConstant("F_SETPIPE_SZ", syscall.F_SETPIPE_SZ)
-	RegisterConstant("F_SETSIG", syscall.F_SETSIG)
-	RegisterConstant("F_SHLCK", syscall.F_SHLCK)
-	RegisterConstant("F_TEST", syscall.F_TEST)
-	RegisterConstant("F_TLOCK", syscall.F_TLOCK)
-	RegisterConstant("F_ULOCK", syscall.F_ULOCK)
-	RegisterConstant("F_UNLCK", syscall.F_UNLCK)
-	RegisterConstant("F_WRLCK", syscall.F_WRLCK)
-	RegisterConstant("ICMPV6_FILTER", syscall.ICMPV6_FILTER)
-	RegisterConstant("IFA_F_DADFAILED", syscall.IFA_F_DADFAILED)
-	RegisterConstant("IFA_F_DEPRECATED", syscall.IFA_F_DEPRECATED)
-	RegisterConstant("IFA_F_HOMEADDRESS", syscall.IFA_F_HOMEADDRESS)
-	RegisterConstant("IFA_F_NODAD", syscall.IFA_F_NODAD)
-	RegisterConstant("IFA_F_OPTIMISTIC", syscall.IFA_F_OPTIMISTIC)
-	RegisterConstant("IFA_F_PERMANENT", syscall.IFA_F_PERMANENT)
-	RegisterConstant("IFA_F_SECONDARY", syscall.IFA_F_SECONDARY)
-	RegisterConstant("IFA_F_TEMPORARY", syscall.IFA_F_TEMPORARY)
-	RegisterConstant("IFA_F_TENTATIVE", syscall.IFA_F_TENTATIVE)
-	RegisterConstant("IFA_MAX", syscall.IFA_MAX)
-	RegisterConstant("IFF_ALLMULTI", syscall.IFF_ALLMULTI)
-	RegisterConstant("IFF_AUTOMEDIA", syscall.IFF_AUTOMEDIA)
-	RegisterConstant("IFF_BROADCAST", syscall.IFF_BROADCAST)
-	RegisterConstant("IFF_DEBUG", syscall.IFF_DEBUG)
-	RegisterConstant("IFF_DYNAMIC", syscall.IFF_DYNAMIC)
-	RegisterConstant("IFF_LOOPBACK", syscall.IFF_LOOPBACK)
-	RegisterConstant("IFF_MASTER", syscall.IFF_MASTER)
-	RegisterConstant("IFF_MULTICAST", syscall.IFF_MULTICAST)
-	RegisterConstant("IFF_NOARP", syscall.IFF_NOARP)
-	RegisterConstant("IFF_NOTRAILERS", syscall.IFF_NOTRAILERS)
-	RegisterConstant("IFF_NO_PI", syscall.IFF_NO_PI)
-	RegisterConstant("IFF_ONE_QUEUE", syscall.IFF_ONE_QUEUE)
-	RegisterConstant("IFF_POINTOPOINT", syscall.IFF_POINTOPOINT)
-	RegisterConstant("IFF_PORTSEL", syscall.IFF_PORTSEL)
-	RegisterConstant("IFF_PROMISC", syscall.IFF_PROMISC)
-	RegisterConstant("IFF_RUNNING", syscall.IFF_RUNNING)
-	RegisterConstant("IFF_SLAVE", syscall.IFF_SLAVE)
-	RegisterConstant("IFF_TAP", syscall.IFF_TAP)
-	RegisterConstant("IFF_TUN", syscall.IFF_TUN)
-	RegisterConstant("IFF_TUN_EXCL", syscall.IFF_TUN_EXCL)
-	RegisterConstant("IFF_UP", syscall.IFF_UP)
-	RegisterConstant("IFF_VNET_HDR", syscall.IFF_VNET_HDR)
-	RegisterConstant("IFNAMSIZ", syscall.IFNAMSIZ)
-	RegisterConstant("IN_ACCESS", syscall.IN_ACCESS)
-	RegisterConstant("IN_ALL_EVENTS", syscall.IN_ALL_EVENTS)
-	RegisterConstant("IN_ATTRIB", syscall.IN_ATTRIB)
-	RegisterConstant("IN_CLASSA_HOST", syscall.IN_CLASSA_HOST)
-	RegisterConstant("IN_CLASSA_MAX", syscall.IN_CLASSA_MAX)
-	RegisterConstant("IN_CLASSA_NET", syscall.IN_CLASSA_NET)
-	RegisterConstant("IN_CLASSA_NSHIFT", syscall.IN_CLASSA_NSHIFT)
-	RegisterConstant("IN_CLASSB_HOST", syscall.IN_CLASSB_HOST)
-	RegisterConstant("IN_CLASSB_MAX", syscall.IN_CLASSB_MAX)
-	RegisterConstant("IN_CLASSB_NET", syscall.IN_CLASSB_NET)
-	RegisterConstant("IN_CLASSB_NSHIFT", syscall.IN_CLASSB_NSHIFT)
-	RegisterConstant("IN_CLASSC_HOST", syscall.IN_CLASSC_HOST)
-	RegisterConstant("IN_CLASSC_NET", syscall.IN_CLASSC_NET)
-	RegisterConstant("IN_CLASSC_NSHIFT", syscall.IN_CLASSC_NSHIFT)
-	RegisterConstant("IN_CLOEXEC", syscall.IN_CLOEXEC)
-	RegisterConstant("IN_CLOSE", syscall.IN_CLOSE)
-	RegisterConstant("IN_CLOSE_NOWRITE", syscall.IN_CLOSE_NOWRITE)
-	RegisterConstant("IN_CLOSE_WRITE", syscall.IN_CLOSE_WRITE)
-	RegisterConstant("IN_CREATE", syscall.IN_CREATE)
-	RegisterConstant("IN_DELETE", syscall.IN_DELETE)
-	RegisterConstant("IN_DELETE_SELF", syscall.IN_DELETE_SELF)
-	RegisterConstant("IN_DONT_FOLLOW", syscall.IN_DONT_FOLLOW)
-	RegisterConstant("IN_EXCL_UNLINK", syscall.IN_EXCL_UNLINK)
-	RegisterConstant("IN_IGNORED", syscall.IN_IGNORED)
-	RegisterConstant("IN_ISDIR", syscall.IN_ISDIR)
-	RegisterConstant("IN_LOOPBACKNET", syscall.IN_LOOPBACKNET)
-	RegisterConstant("IN_MASK_ADD", syscall.IN_MASK_ADD)
-	RegisterConstant("IN_MODIFY", syscall.IN_MODIFY)
-	RegisterConstant("IN_MOVE", syscall.IN_MOVE)
-	RegisterConstant("IN_MOVED_FROM", syscall.IN_MOVED_FROM)
-	RegisterConstant("IN_MOVED_TO", syscall.IN_MOVED_TO)
-	RegisterConstant("IN_MOVE_SELF", syscall.IN_MOVE_SELF)
-	RegisterConstant("IN_NONBLOCK", syscall.IN_NONBLOCK)
-	RegisterConstant("IN_ONESHOT", syscall.IN_ONESHOT)
-	RegisterConstant("IN_ONLYDIR", syscall.IN_ONLYDIR)
-	RegisterConstant("IN_OPEN", syscall.IN_OPEN)
-	RegisterConstant("IN_Q_OVERFLOW", syscall.IN_Q_OVERFLOW)
-	RegisterConstant("IN_UNMOUNT", syscall.IN_UNMOUNT)
-	RegisterConstant("IPPROTO_AH", syscall.IPPROTO_AH)
-	RegisterConstant("IPPROTO_COMP", syscall.IPPROTO_COMP)
-	RegisterConstant("IPPROTO_DCCP", syscall.IPPROTO_DCCP)
-	RegisterConstant("IPPROTO_DSTOPTS", syscall.IPPROTO_DSTOPTS)
-	RegisterConstant("IPPROTO_EGP", syscall.IPPROTO_EGP)
-	RegisterConstant("IPPROTO_ENCAP", syscall.IPPROTO_ENCAP)
-	RegisterConstant("IPPROTO_ESP", syscall.IPPROTO_ESP)
-	RegisterConstant("IPPROTO_FRAGMENT", syscall.IPPROTO_FRAGMENT)
-	RegisterConstant("IPPROTO_GRE", syscall.IPPROTO_GRE)
-	RegisterConstant("IPPROTO_HOPOPTS", syscall.IPPROTO_HOPOPTS)
-	RegisterConstant("IPPROTO_ICMP", syscall.IPPROTO_ICMP)
-	RegisterConstant("IPPROTO_ICMPV6", syscall.IPPROTO_ICMPV6)
-	RegisterConstant("IPPROTO_IDP", syscall.IPPROTO_IDP)
-	RegisterConstant("IPPROTO_IGMP", syscall.IPPROTO_IGMP)
-	RegisterConstant("IPPROTO_IP", syscall.IPPROTO_IP)
-	RegisterConstant("IPPROTO_IPIP", syscall.IPPROTO_IPIP)
-	RegisterConstant("IPPROTO_IPV6", syscall.IPPROTO_IPV6)
-	RegisterConstant("IPPROTO_MTP", syscall.IPPROTO_MTP)
-	RegisterConstant("IPPROTO_NONE", syscall.IPPROTO_NONE)
-	RegisterConstant("IPPROTO_PIM", syscall.IPPROTO_PIM)
-	RegisterConstant("IPPROTO_PUP", syscall.IPPROTO_PUP)
-	RegisterConstant("IPPROTO_RAW", syscall.IPPROTO_RAW)
-	RegisterConstant("IPPROTO_ROUTING", syscall.IPPROTO_ROUTING)
-	RegisterConstant("IPPROTO_RSVP", syscall.IPPROTO_RSVP)
-	RegisterConstant("IPPROTO_SCTP", syscall.IPPROTO_SCTP)
-	RegisterConstant("IPPROTO_TCP", syscall.IPPROTO_TCP)
-	RegisterConstant("IPPROTO_TP", syscall.IPPROTO_TP)
-	RegisterConstant("IPPROTO_UDP", syscall.IPPROTO_UDP)
-	RegisterConstant("IPPROTO_UDPLITE", syscall.IPPROTO_UDPLITE)
-	RegisterConstant("IPV6_2292DSTOPTS", syscall.IPV6_2292DSTOPTS)
-	RegisterConstant("IPV6_2292HOPLIMIT", syscall.IPV6_2292HOPLIMIT)
-	RegisterConstant("IPV6_2292HOPOPTS", syscall.IPV6_2292HOPOPTS)
-	RegisterConstant("IPV6_2292PKTINFO", syscall.IPV6_2292PKTINFO)
-	RegisterConstant("IPV6_2292PKTOPTIONS", syscall.IPV6_2292PKTOPTIONS)
-	RegisterConstant("IPV6_2292RTHDR", syscall.IPV6_2292RTHDR)
-	RegisterConstant("IPV6_ADDRFORM", syscall.IPV6_ADDRFORM)
-	RegisterConstant("IPV6_ADD_MEMBERSHIP", syscall.IPV6_ADD_MEMBERSHIP)
-	RegisterConstant("IPV6_AUTHHDR", syscall.IPV6_AUTHHDR)
-	RegisterConstant("IPV6_CHECKSUM", syscall.IPV6_CHECKSUM)
-	RegisterConstant("IPV6_DROP_MEMBERSHIP", syscall.IPV6_DROP_MEMBERSHIP)
-	RegisterConstant("IPV6_DSTOPTS", syscall.IPV6_DSTOPTS)
-	RegisterConstant("IPV6_HOPLIMIT", syscall.IPV6_HOPLIMIT)
-	RegisterConstant("IPV6_HOPOPTS", syscall.IPV6_HOPOPTS)
-	RegisterConstant("IPV6_IPSEC_POLICY", syscall.IPV6_IPSEC_POLICY)
-	RegisterConstant("IPV6_JOIN_ANYCAST", syscall.IPV6_JOIN_ANYCAST)
-	RegisterConstant("IPV6_JOIN_GROUP", syscall.IPV6_JOIN_GROUP)
-	RegisterConstant("IPV6_LEAVE_ANYCAST", syscall.IPV6_LEAVE_ANYCAST)
-	RegisterConstant("IPV6_LEAVE_GROUP", syscall.IPV6_LEAVE_GROUP)
-	RegisterConstant("IPV6_MTU", syscall.IPV6_MTU)
-	RegisterConstant("IPV6_MTU_DISCOVER", syscall.IPV6_MTU_DISCOVER)
-	RegisterConstant("IPV6_MULTICAST_HOPS", syscall.IPV6_MULTICAST_HOPS)
-	RegisterConstant("IPV6_MULTICAST_IF", syscall.IPV6_MULTICAST_IF)
-	RegisterConstant("IPV6_MULTICAST_LOOP", syscall.IPV6_MULTICAST_LOOP)
-	RegisterConstant("IPV6_NEXTHOP", syscall.IPV6_NEXTHOP)
-	RegisterConstant("IPV6_PKTINFO", syscall.IPV6_PKTINFO)
-	RegisterConstant("IPV6_PMTUDISC_DO", syscall.IPV6_PMTUDISC_DO)
-	RegisterConstant("IPV6_PMTUDISC_DONT", syscall.IPV6_PMTUDISC_DONT)
-	RegisterConstant("IPV6_PMTUDISC_PROBE", syscall.IPV6_PMTUDISC_PROBE)
-	RegisterConstant("IPV6_PMTUDISC_WANT", syscall.IPV6_PMTUDISC_WANT)
-	RegisterConstant("IPV6_RECVDSTOPTS", syscall.IPV6_RECVDSTOPTS)
-	RegisterConstant("IPV6_RECVERR", syscall.IPV6_RECVERR)
-	RegisterConstant("IPV6_RECVHOPLIMIT", syscall.IPV6_RECVHOPLIMIT)
-	RegisterConstant("IPV6_RECVHOPOPTS", syscall.IPV6_RECVHOPOPTS)
-	RegisterConstant("IPV6_RECVPKTINFO", syscall.IPV6_RECVPKTINFO)
-	RegisterConstant("IPV6_RECVRTHDR", syscall.IPV6_RECVRTHDR)
-	RegisterConstant("IPV6_RECVTCLASS", syscall.IPV6_RECVTCLASS)
-	RegisterConstant("IPV6_ROUTER_ALERT", syscall.IPV6_ROUTER_ALERT)
-	RegisterConstant("IPV6_RTHDR", syscall.IPV6_RTHDR)
-	RegisterConstant("IPV6_RTHDRDSTOPTS", syscall.IPV6_RTHDRDSTOPTS)
-	RegisterConstant("IPV6_RTHDR_LOOSE", syscall.IPV6_RTHDR_LOOSE)
-	RegisterConstant("IPV6_RTHDR_STRICT", syscall.IPV6_RTHDR_STRICT)
-	RegisterConstant("IPV6_RTHDR_TYPE_0", syscall.IPV6_RTHDR_TYPE_0)
-	RegisterConstant("IPV6_RXDSTOPTS", syscall.IPV6_RXDSTOPTS)
-	RegisterConstant("IPV6_RXHOPOPTS", syscall.IPV6_RXHOPOPTS)
-	RegisterConstant("IPV6_TCLASS", syscall.IPV6_TCLASS)
-	RegisterConstant("IPV6_UNICAST_HOPS", syscall.IPV6_UNICAST_HOPS)
-	RegisterConstant("IPV6_V6ONLY", syscall.IPV6_V6ONLY)
-	RegisterConstant("IPV6_XFRM_POLICY", syscall.IPV6_XFRM_POLICY)
-	RegisterConstant("IP_ADD_MEMBERSHIP", syscall.IP_ADD_MEMBERSHIP)
-	RegisterConstant("IP_ADD_SOURCE_MEMBERSHIP", syscall.IP_ADD_SOURCE_MEMBERSHIP)
-	RegisterConstant("IP_BLOCK_SOURCE", syscall.IP_BLOCK_SOURCE)
-	RegisterConstant("IP_DEFAULT_MULTICAST_LOOP", syscall.IP_DEFAULT_MULTICAST_LOOP)
-	RegisterConstant("IP_DEFAULT_MULTICAST_TTL", syscall.IP_DEFAULT_MULTICAST_TTL)
-	RegisterConstant("IP_DF", syscall.IP_DF)
-	RegisterConstant("IP_DROP_MEMBERSHIP", syscall.IP_DROP_MEMBERSHIP)
-	RegisterConstant("IP_DROP_SOURCE_MEMBERSHIP", syscall.IP_DROP_SOURCE_MEMBERSHIP)
-	RegisterConstant("IP_FREEBIND", syscall.IP_FREEBIND)
-	RegisterConstant("IP_HDRINCL", syscall.IP_HDRINCL)
-	RegisterConstant("IP_IPSEC_POLICY", syscall.IP_IPSEC_POLICY)
-	RegisterConstant("IP_MAXPACKET", syscall.IP_MAXPACKET)
-	RegisterConstant("IP_MAX_MEMBERSHIPS", syscall.IP_MAX_MEMBERSHIPS)
-	RegisterConstant("IP_MF", syscall.IP_MF)
-	RegisterConstant("IP_MINTTL", syscall.IP_MINTTL)
-	RegisterConstant("IP_MSFILTER", syscall.IP_MSFILTER)
-	RegisterConstant("IP_MSS", syscall.IP_MSS)
-	RegisterConstant("IP_MTU", syscall.IP_MTU)
-	RegisterConstant("IP_MTU_DISCOVER", syscall.IP_MTU_DISCOVER)
-	RegisterConstant("IP_MULTICAST_IF", syscall.IP_MULTICAST_IF)
-	RegisterConstant("IP_MULTICAST_LOOP", syscall.IP_MULTICAST_LOOP)
-	RegisterConstant("IP_MULTICAST_TTL", syscall.IP_MULTICAST_TTL)
-	RegisterConstant("IP_OFFMASK", syscall.IP_OFFMASK)
-	RegisterConstant("IP_OPTIONS", syscall.IP_OPTIONS)
-	RegisterConstant("IP_ORIGDSTADDR", syscall.IP_ORIGDSTADDR)
-	RegisterConstant("IP_PASSSEC", syscall.IP_PASSSEC)
-	RegisterConstant("IP_PKTINFO", syscall.IP_PKTINFO)
-	RegisterConstant("IP_PKTOPTIONS", syscall.IP_PKTOPTIONS)
-	RegisterConstant("IP_PMTUDISC", syscall.IP_PMTUDISC)
-	RegisterConstant("IP_PMTUDISC_DO", syscall.IP_PMTUDISC_DO)
-	RegisterConstant("IP_PMTUDISC_DONT", syscall.IP_PMTUDISC_DONT)
-	RegisterConstant("IP_PMTUDISC_PROBE", syscall.IP_PMTUDISC_PROBE)
-	RegisterConstant("IP_PMTUDISC_WANT", syscall.IP_PMTUDISC_WANT)
-	RegisterConstant("IP_RECVERR", syscall.IP_RECVERR)
-	RegisterConstant("IP_RECVOPTS", syscall.IP_RECVOPTS)
-	RegisterConstant("IP_RECVORIGDSTADDR", syscall.IP_RECVORIGDSTADDR)
-	RegisterConstant("IP_RECVRETOPTS", syscall.IP_RECVRETOPTS)
-	RegisterConstant("IP_RECVTOS", syscall.IP_RECVTOS)
-	RegisterConstant("IP_RECVTTL", syscall.IP_RECVTTL)
-	RegisterConstant("IP_RETOPTS", syscall.IP_RETOPTS)
-	RegisterConstant("IP_RF", syscall.IP_RF)
-	RegisterConstant("IP_ROUTER_ALERT", syscall.IP_ROUTER_ALERT)
-	RegisterConstant("IP_TOS", syscall.IP_TOS)
-	RegisterConstant("IP_TRANSPARENT", syscall.IP_TRANSPARENT)
-	RegisterConstant("IP_TTL", syscall.IP_TTL)
-	RegisterConstant("IP_UNBLOCK_SOURCE", syscall.IP_UNBLOCK_SOURCE)
-	RegisterConstant("IP_XFRM_POLICY", syscall.IP_XFRM_POLICY)
-	RegisterConstant("IUCLC", syscall.IUCLC)
-	RegisterConstant("LINUX_REBOOT_CMD_CAD_OFF", syscall.LINUX_REBOOT_CMD_CAD_OFF)
-	RegisterConstant("LINUX_REBOOT_CMD_CAD_ON", syscall.LINUX_REBOOT_CMD_CAD_ON)
-	RegisterConstant("LINUX_REBOOT_CMD_HALT", syscall.LINUX_REBOOT_CMD_HALT)
-	RegisterConstant("LINUX_REBOOT_CMD_KEXEC", syscall.LINUX_REBOOT_CMD_KEXEC)
-	RegisterConstant("LINUX_REBOOT_CMD_POWER_OFF", syscall.LINUX_REBOOT_CMD_POWER_OFF)
-	RegisterConstant("LINUX_REBOOT_CMD_RESTART", syscall.LINUX_REBOOT_CMD_RESTART)
-	RegisterConstant("LINUX_REBOOT_CMD_RESTART2", syscall.LINUX_REBOOT_CMD_RESTART2)
-	RegisterConstant("LINUX_REBOOT_CMD_SW_SUSPEND", syscall.LINUX_REBOOT_CMD_SW_SUSPEND)
-	RegisterConstant("LINUX_REBOOT_MAGIC1", syscall.LINUX_REBOOT_MAGIC1)
-	RegisterConstant("LINUX_REBOOT_MAGIC2", syscall.LINUX_REBOOT_MAGIC2)
-	RegisterConstant("LOCK_EX", syscall.LOCK_EX)
-	RegisterConstant("LOCK_NB", syscall.LOCK_NB)
-	RegisterConstant("LOCK_SH", syscall.LOCK_SH)
-	RegisterConstant("LOCK_UN", syscall.LOCK_UN)
-	RegisterConstant("MADV_DOFORK", syscall.MADV_DOFORK)
-	RegisterConstant("MADV_DONTFORK", syscall.MADV_DONTFORK)
-	RegisterConstant("MADV_DONTNEED", syscall.MADV_DONTNEED)
-	RegisterConstant("MADV_HUGEPAGE", syscall.MADV_HUGEPAGE)
-	RegisterConstant("MADV_HWPOISON", syscall.MADV_HWPOISON)
-	RegisterConstant("MADV_MERGEABLE", syscall.MADV_MERGEABLE)
-	RegisterConstant("MADV_NOHUGEPAGE", syscall.MADV_NOHUGEPAGE)
-	RegisterConstant("MADV_NORMAL", syscall.MADV_NORMAL)
-	RegisterConstant("MADV_RANDOM", syscall.MADV_RANDOM)
-	RegisterConstant("MADV_REMOVE", syscall.MADV_REMOVE)
-	RegisterConstant("MADV_SEQUENTIAL", syscall.MADV_SEQUENTIAL)
-	RegisterConstant("MADV_UNMERGEABLE", syscall.MADV_UNMERGEABLE)
-	RegisterConstant("MADV_WILLNEED", syscall.MADV_WILLNEED)
-	RegisterConstant("MAP_32BIT", syscall.MAP_32BIT)
-	RegisterConstant("MAP_ANON", syscall.MAP_ANON)
-	RegisterConstant("MAP_ANONYMOUS", syscall.MAP_ANONYMOUS)
-	RegisterConstant("MAP_DENYWRITE", syscall.MAP_DENYWRITE)
-	RegisterConstant("MAP_EXECUTABLE", syscall.MAP_EXECUTABLE)
-	RegisterConstant("MAP_FILE", syscall.MAP_FILE)
-	RegisterConstant("MAP_FIXED", syscall.MAP_FIXED)
-	RegisterConstant("MAP_GROWSDOWN", syscall.MAP_GROWSDOWN)
-	RegisterConstant("MAP_HUGETLB", syscall.MAP_HUGETLB)
-	RegisterConstant("MAP_LOCKED", syscall.MAP_LOCKED)
-	RegisterConstant("MAP_NONBLOCK", syscall.MAP_NONBLOCK)
-	RegisterConstant("MAP_NORESERVE", syscall.MAP_NORESERVE)
-	RegisterConstant("MAP_POPULATE", syscall.MAP_POPULATE)
-	RegisterConstant("MAP_PRIVATE", syscall.MAP_PRIVATE)
-	RegisterConstant("MAP_SHARED", syscall.MAP_SHARED)
-	RegisterConstant("MAP_STACK", syscall.MAP_STACK)
-	RegisterConstant("MAP_TYPE", syscall.MAP_TYPE)
-	RegisterConstant("MCL_CURRENT", syscall.MCL_CURRENT)
-	RegisterConstant("MCL_FUTURE", syscall.MCL_FUTURE)
-	RegisterConstant("MNT_DETACH", syscall.MNT_DETACH)
-	RegisterConstant("MNT_EXPIRE", syscall.MNT_EXPIRE)
-	RegisterConstant("MNT_FORCE", syscall.MNT_FORCE)
-	RegisterConstant("MSG_CMSG_CLOEXEC", syscall.MSG_CMSG_CLOEXEC)
-	RegisterConstant("MSG_CONFIRM", syscall.MSG_CONFIRM)
-	RegisterConstant("MSG_CTRUNC", syscall.MSG_CTRUNC)
-	RegisterConstant("MSG_DONTROUTE", syscall.MSG_DONTROUTE)
-	RegisterConstant("MSG_DONTWAIT", syscall.MSG_DONTWAIT)
-	RegisterConstant("MSG_EOR", syscall.MSG_EOR)
-	RegisterConstant("MSG_ERRQUEUE", syscall.MSG_ERRQUEUE)
-	RegisterConstant("MSG_FASTOPEN", syscall.MSG_FASTOPEN)
-	RegisterConstant("MSG_FIN", syscall.MSG_FIN)
-	RegisterConstant("MSG_MORE", syscall.MSG_MORE)
-	RegisterConstant("MSG_NOSIGNAL", syscall.MSG_NOSIGNAL)
-	RegisterConstant("MSG_OOB", syscall.MSG_OOB)
-	RegisterConstant("MSG_PEEK", syscall.MSG_PEEK)
-	RegisterConstant("MSG_PROXY", syscall.MSG_PROXY)
-	RegisterConstant("MSG_RST", syscall.MSG_RST)
-	RegisterConstant("MSG_SYN", syscall.MSG_SYN)
-	RegisterConstant("MSG_TRUNC", syscall.MSG_TRUNC)
-	RegisterConstant("MSG_TRYHARD", syscall.MSG_TRYHARD)
-	RegisterConstant("MSG_WAITALL", syscall.MSG_WAITALL)
-	RegisterConstant("MSG_WAITFORONE", syscall.MSG_WAITFORONE)
-	RegisterConstant("MS_ACTIVE", syscall.MS_ACTIVE)
-	RegisterConstant("MS_ASYNC", syscall.MS_ASYNC)
-	RegisterConstant("MS_BIND", syscall.MS_BIND)
-	RegisterConstant("MS_DIRSYNC", syscall.MS_DIRSYNC)
-	RegisterConstant("MS_INVALIDATE", syscall.MS_INVALIDATE)
-	RegisterConstant("MS_I_VERSION", syscall.MS_I_VERSION)
-	RegisterConstant("MS_KERNMOUNT", syscall.MS_KERNMOUNT)
-	RegisterConstant("MS_MANDLOCK", syscall.MS_MANDLOCK)
-	RegisterConstant("MS_MGC_MSK", syscall.MS_MGC_MSK)
-	RegisterConstant("MS_MGC_VAL", syscall.MS_MGC_VAL)
-	RegisterConstant("MS_MOVE", syscall.MS_MOVE)
-	RegisterConstant("MS_NOATIME", syscall.MS_NOATIME)
-	RegisterConstant("MS_NODEV", syscall.MS_NODEV)
-	RegisterConstant("MS_NODIRATIME", syscall.MS_NODIRATIME)
-	RegisterConstant("MS_NOEXEC", syscall.MS_NOEXEC)
-	RegisterConstant("MS_NOSUID", syscall.MS_NOSUID)
-	RegisterConstant("MS_NOUSER", syscall.MS_NOUSER)
-	RegisterConstant("MS_POSIXACL", syscall.MS_POSIXACL)
-	RegisterConstant("MS_PRIVATE", syscall.MS_PRIVATE)
-	RegisterConstant("MS_RDONLY", syscall.MS_RDONLY)
-	RegisterConstant("MS_REC", syscall.MS_REC)
-	RegisterConstant("MS_RELATIME", syscall.MS_RELATIME)
-	RegisterConstant("MS_REMOUNT", syscall.MS_REMOUNT)
-	RegisterConstant("MS_RMT_MASK", syscall.MS_RMT_MASK)
-	RegisterConstant("MS_SHARED", syscall.MS_SHARED)
-	RegisterConstant("MS_SILENT", syscall.MS_SILENT)
-	RegisterConstant("MS_SLAVE", syscall.MS_SLAVE)
-	RegisterConstant("MS_STRICTATIME", syscall.MS_STRICTATIME)
-	RegisterConstant("MS_SYNC", syscall.MS_SYNC)
-	RegisterConstant("MS_SYNCHRONOUS", syscall.MS_SYNCHRONOUS)
-	RegisterConstant("MS_UNBINDABLE", syscall.MS_UNBINDABLE)
-	RegisterConstant("NAME_MAX", syscall.NAME_MAX)
-	RegisterConstant("NETLINK_ADD_MEMBERSHIP", syscall.NETLINK_ADD_MEMBERSHIP)
-	RegisterConstant("NETLINK_AUDIT", syscall.NETLINK_AUDIT)
-	RegisterConstant("NETLINK_BROADCAST_ERROR", syscall.NETLINK_BROADCAST_ERROR)
-	RegisterConstant("NETLINK_CONNECTOR", syscall.NETLINK_CONNECTOR)
-	RegisterConstant("NETLINK_DNRTMSG", syscall.NETLINK_DNRTMSG)
-	RegisterConstant("NETLINK_DROP_MEMBERSHIP", syscall.NETLINK_DROP_MEMBERSHIP)
-	RegisterConstant("NETLINK_ECRYPTFS", syscall.NETLINK_ECRYPTFS)
-	RegisterConstant("NETLINK_FIB_LOOKUP", syscall.NETLINK_FIB_LOOKUP)
-	RegisterConstant("NETLINK_FIREWALL", syscall.NETLINK_FIREWALL)
-	RegisterConstant("NETLINK_GENERIC", syscall.NETLINK_GENERIC)
-	RegisterConstant("NETLINK_INET_DIAG", syscall.NETLINK_INET_DIAG)
-	RegisterConstant("NETLINK_IP6_FW", syscall.NETLINK_IP6_FW)
-	RegisterConstant("NETLINK_ISCSI", syscall.NETLINK_ISCSI)
-	RegisterConstant("NETLINK_KOBJECT_UEVENT", syscall.NETLINK_KOBJECT_UEVENT)
-	RegisterConstant("NETLINK_NETFILTER", syscall.NETLINK_NETFILTER)
-	RegisterConstant("NETLINK_NFLOG", syscall.NETLINK_NFLOG)
-	RegisterConstant("NETLINK_NO_ENOBUFS", syscall.NETLINK_NO_ENOBUFS)
-	RegisterConstant("NETLINK_PKTINFO", syscall.NETLINK_PKTINFO)
-	RegisterConstant("NETLINK_ROUTE", syscall.NETLINK_ROUTE)
-	RegisterConstant("NETLINK_SCSITRANSPORT", syscall.NETLINK_SCSITRANSPORT)
-	RegisterConstant("NETLINK_SELINUX", syscall.NETLINK_SELINUX)
-	RegisterConstant("NETLINK_UNUSED", syscall.NETLINK_UNUSED)
-	RegisterConstant("NETLINK_USERSOCK", syscall.NETLINK_USERSOCK)
-	RegisterConstant("NETLINK_XFRM", syscall.NETLINK_XFRM)
-	RegisterConstant("NLA_ALIGNTO", syscall.NLA_ALIGNTO)
-	RegisterConstant("NLA_F_NESTED", syscall.NLA_F_NESTED)
-	RegisterConstant("NLA_F_NET_BYTEORDER", syscall.NLA_F_NET_BYTEORDER)
-	RegisterConstant("NLA_HDRLEN", syscall.NLA_HDRLEN)
-	RegisterConstant("NLMSG_ALIGNTO", syscall.NLMSG_ALIGNTO)
-	RegisterConstant("NLMSG_DONE", syscall.NLMSG_DONE)
-	RegisterConstant("NLMSG_ERROR", syscall.NLMSG_ERROR)
-	RegisterConstant("NLMSG_HDRLEN", syscall.NLMSG_HDRLEN)
-	RegisterConstant("NLMSG_MIN_TYPE", syscall.NLMSG_MIN_TYPE)
-	RegisterConstant("NLMSG_NOOP", syscall.NLMSG_NOOP)
-	RegisterConstant("NLMSG_OVERRUN", syscall.NLMSG_OVERRUN)
-	RegisterConstant("NLM_F_ACK", syscall.NLM_F_ACK)
-	RegisterConstant("NLM_F_APPEND", syscall.NLM_F_APPEND)
-	RegisterConstant("NLM_F_ATOMIC", syscall.NLM_F_ATOMIC)
-	RegisterConstant("NLM_F_CREATE", syscall.NLM_F_CREATE)
-	RegisterConstant("NLM_F_DUMP", syscall.NLM_F_DUMP)
-	RegisterConstant("NLM_F_ECHO", syscall.NLM_F_ECHO)
-	RegisterConstant("NLM_F_EXCL", syscall.NLM_F_EXCL)
-	RegisterConstant("NLM_F_MATCH", syscall.NLM_F_MATCH)
-	RegisterConstant("NLM_F_MULTI", syscall.NLM_F_MULTI)
-	RegisterConstant("NLM_F_REPLACE", syscall.NLM_F_REPLACE)
-	RegisterConstant("NLM_F_REQUEST", syscall.NLM_F_REQUEST)
-	RegisterConstant("NLM_F_ROOT", syscall.NLM_F_ROOT)
-	RegisterConstant("OLCUC", syscall.OLCUC)
-	RegisterConstant("O_ACCMODE", syscall.O_ACCMODE)
-	RegisterConstant("O_APPEND", syscall.O_APPEND)
-	RegisterConstant("O_ASYNC", syscall.O_ASYNC)
-	RegisterConstant("O_CLOEXEC", syscall.O_CLOEXEC)
-	RegisterConstant("O_CREAT", syscall.O_CREAT)
-	RegisterConstant("O_DIRECT", syscall.O_DIRECT)
-	RegisterConstant("O_DIRECTORY", syscall.O_DIRECTORY)
-	RegisterConstant("O_DSYNC", syscall.O_DSYNC)
-	RegisterConstant("O_EXCL", syscall.O_EXCL)
-	RegisterConstant("O_FSYNC", syscall.O_FSYNC)
-	RegisterConstant("O_LARGEFILE", syscall.O_LARGEFILE)
-	RegisterConstant("O_NDELAY", syscall.O_NDELAY)
-	RegisterConstant("O_NOATIME", syscall.O_NOATIME)
-	RegisterConstant("O_NOCTTY", syscall.O_NOCTTY)
-	RegisterConstant("O_NOFOLLOW", syscall.O_NOFOLLOW)
-	RegisterConstant("O_NONBLOCK", syscall.O_NONBLOCK)
-	RegisterConstant("O_RDONLY", syscall.O_RDONLY)
-	RegisterConstant("O_RDWR", syscall.O_RDWR)
-	RegisterConstant("O_RSYNC", syscall.O_RSYNC)
-	RegisterConstant("O_SYNC", syscall.O_SYNC)
-	RegisterConstant("O_TRUNC", syscall.O_TRUNC)
-	RegisterConstant("O_WRONLY", syscall.O_WRONLY)
-	RegisterConstant("PACKET_ADD_MEMBERSHIP", syscall.PACKET_ADD_MEMBERSHIP)
-	RegisterConstant("PACKET_BROADCAST", syscall.PACKET_BROADCAST)
-	RegisterConstant("PACKET_DROP_MEMBERSHIP", syscall.PACKET_DROP_MEMBERSHIP)
-	RegisterConstant("PACKET_FASTROUTE", syscall.PACKET_FASTROUTE)
-	RegisterConstant("PACKET_HOST", syscall.PACKET_HOST)
-	RegisterConstant("PACKET_LOOPBACK", syscall.PACKET_LOOPBACK)
-	RegisterConstant("PACKET_MR_ALLMULTI", syscall.PACKET_MR_ALLMULTI)
-	RegisterConstant("PACKET_MR_MULTICAST", syscall.PACKET_MR_MULTICAST)
-	RegisterConstant("PACKET_MR_PROMISC", syscall.PACKET_MR_PROMISC)
-	RegisterConstant("PACKET_MULTICAST", syscall.PACKET_MULTICAST)
-	RegisterConstant("PACKET_OTHERHOST", syscall.PACKET_OTHERHOST)
-	RegisterConstant("PACKET_OUTGOING", syscall.PACKET_OUTGOING)
-	RegisterConstant("PACKET_RECV_OUTPUT", syscall.PACKET_RECV_OUTPUT)
-	RegisterConstant("PACKET_RX_RING", syscall.PACKET_RX_RING)
-	RegisterConstant("PACKET_STATISTICS", syscall.PACKET_STATISTICS)
-	RegisterConstant("PRIO_PGRP", syscall.PRIO_PGRP)
-	RegisterConstant("PRIO_PROCESS", syscall.PRIO_PROCESS)
-	RegisterConstant("PRIO_USER", syscall.PRIO_USER)
-	RegisterConstant("PROT_EXEC", syscall.PROT_EXEC)
-	RegisterConstant("PROT_GROWSDOWN", syscall.PROT_GROWSDOWN)
-	RegisterConstant("PROT_GROWSUP", syscall.PROT_GROWSUP)
-	RegisterConstant("PROT_NONE", syscall.PROT_NONE)
-	RegisterConstant("PROT_READ", syscall.PROT_READ)
-	RegisterConstant("PROT_WRITE", syscall.PROT_WRITE)
-	RegisterConstant("PR_CAPBSET_DROP", syscall.PR_CAPBSET_DROP)
-	RegisterConstant("PR_CAPBSET_READ", syscall.PR_CAPBSET_READ)
-	RegisterConstant("PR_ENDIAN_BIG", syscall.PR_ENDIAN_BIG)
-	RegisterConstant("PR_ENDIAN_LITTLE", syscall.PR_ENDIAN_LITTLE)
-	RegisterConstant("PR_ENDIAN_PPC_LITTLE", syscall.PR_ENDIAN_PPC_LITTLE)
-	RegisterConstant("PR_FPEMU_NOPRINT", syscall.PR_FPEMU_NOPRINT)
-	RegisterConstant("PR_FPEMU_SIGFPE", syscall.PR_FPEMU_SIGFPE)
-	RegisterConstant("PR_FP_EXC_ASYNC", syscall.PR_FP_EXC_ASYNC)
-	RegisterConstant("PR_FP_EXC_DISABLED", syscall.PR_FP_EXC_DISABLED)
-	RegisterConstant("PR_FP_EXC_DIV", syscall.PR_FP_EXC_DIV)
-	RegisterConstant("PR_FP_EXC_INV", syscall.PR_FP_EXC_INV)
-	RegisterConstant("PR_FP_EXC_NONRECOV", syscall.PR_FP_EXC_NONRECOV)
-	RegisterConstant("PR_FP_EXC_OVF", syscall.PR_FP_EXC_OVF)
-	RegisterConstant("PR_FP_EXC_PRECISE", syscall.PR_FP_EXC_PRECISE)
-	RegisterConstant("PR_FP_EXC_RES", syscall.PR_FP_EXC_RES)
-	RegisterConstant("PR_FP_EXC_SW_ENABLE", syscall.PR_FP_EXC_SW_ENABLE)
-	RegisterConstant("PR_FP_EXC_UND", syscall.PR_FP_EXC_UND)
-	RegisterConstant("PR_GET_DUMPABLE", syscall.PR_GET_DUMPABLE)
-	RegisterConstant("PR_GET_ENDIAN", syscall.PR_GET_ENDIAN)
-	RegisterConstant("PR_GET_FPEMU", syscall.PR_GET_FPEMU)
-	RegisterConstant("PR_GET_FPEXC", syscall.PR_GET_FPEXC)
-	RegisterConstant("PR_GET_KEEPCAPS", syscall.PR_GET_KEEPCAPS)
-	RegisterConstant("PR_GET_NAME", syscall.PR_GET_NAME)
-	RegisterConstant("PR_GET_PDEATHSIG", syscall.PR_GET_PDEATHSIG)
-	RegisterConstant("PR_GET_SECCOMP", syscall.PR_GET_SECCOMP)
-	RegisterConstant("PR_GET_SECUREBITS", syscall.PR_GET_SECUREBITS)
-	RegisterConstant("PR_GET_TIMERSLACK", syscall.PR_GET_TIMERSLACK)
-	RegisterConstant("PR_GET_TIMING", syscall.PR_GET_TIMING)
-	RegisterConstant("PR_GET_TSC", syscall.PR_GET_TSC)
-	RegisterConstant("PR_GET_UNALIGN", syscall.PR_GET_UNALIGN)
-	RegisterConstant("PR_MCE_KILL", syscall.PR_MCE_KILL)
-	RegisterConstant("PR_MCE_KILL_CLEAR", syscall.PR_MCE_KILL_CLEAR)
-	RegisterConstant("PR_MCE_KILL_DEFAULT", syscall.PR_MCE_KILL_DEFAULT)
-	RegisterConstant("PR_MCE_KILL_EARLY", syscall.PR_MCE_KILL_EARLY)
-	RegisterConstant("PR_MCE_KILL_GET", syscall.PR_MCE_KILL_GET)
-	RegisterConstant("PR_MCE_KILL_LATE", syscall.PR_MCE_KILL_LATE)
-	RegisterConstant("PR_MCE_KILL_SET", syscall.PR_MCE_KILL_SET)
-	RegisterConstant("PR_SET_DUMPABLE", syscall.PR_SET_DUMPABLE)
-	RegisterConstant("PR_SET_ENDIAN", syscall.PR_SET_ENDIAN)
-	RegisterConstant("PR_SET_FPEMU", syscall.PR_SET_FPEMU)
-	RegisterConstant("PR_SET_FPEXC", syscall.PR_SET_FPEXC)
-	RegisterConstant("PR_SET_KEEPCAPS", syscall.PR_SET_KEEPCAPS)
-	RegisterConstant("PR_SET_NAME", syscall.PR_SET_NAME)
-	RegisterConstant("PR_SET_PDEATHSIG", syscall.PR_SET_PDEATHSIG)
-	RegisterConstant("PR_SET_PTRACER", syscall.PR_SET_PTRACER)
-	RegisterConstant("PR_SET_SECCOMP", syscall.PR_SET_SECCOMP)
-	RegisterConstant("PR_SET_SECUREBITS", syscall.PR_SET_SECUREBITS)
-	RegisterConstant("PR_SET_TIMERSLACK", syscall.PR_SET_TIMERSLACK)
-	RegisterConstant("PR_SET_TIMING", syscall.PR_SET_TIMING)
-	RegisterConstant("PR_SET_TSC", syscall.PR_SET_TSC)
-	RegisterConstant("PR_SET_UNALIGN", syscall.PR_SET_UNALIGN)
-	RegisterConstant("PR_TASK_PERF_EVENTS_DISABLE", syscall.PR_TASK_PERF_EVENTS_DISABLE)
-	RegisterConstant("PR_TASK_PERF_EVENTS_ENABLE", syscall.PR_TASK_PERF_EVENTS_ENABLE)
-	RegisterConstant("PR_TIMING_STATISTICAL", syscall.PR_TIMING_STATISTICAL)
-	RegisterConstant("PR_TIMING_TIMESTAMP", syscall.PR_TIMING_TIMESTAMP)
-	RegisterConstant("PR_TSC_ENABLE", syscall.PR_TSC_ENABLE)
-	RegisterConstant("PR_TSC_SIGSEGV", syscall.PR_TSC_SIGSEGV)
-	RegisterConstant("PR_UNALIGN_NOPRINT", syscall.PR_UNALIGN_NOPRINT)
-	RegisterConstant("PR_UNALIGN_SIGBUS", syscall.PR_UNALIGN_SIGBUS)
-	RegisterConstant("PTRACE_ARCH_PRCTL", syscall.PTRACE_ARCH_PRCTL)
-	RegisterConstant("PTRACE_ATTACH", syscall.PTRACE_ATTACH)
-	RegisterConstant("PTRACE_CONT", syscall.PTRACE_CONT)
-	RegisterConstant("PTRACE_DETACH", syscall.PTRACE_DETACH)
-	RegisterConstant("PTRACE_EVENT_CLONE", syscall.PTRACE_EVENT_CLONE)
-	RegisterConstant("PTRACE_EVENT_EXEC", syscall.PTRACE_EVENT_EXEC)
-	RegisterConstant("PTRACE_EVENT_EXIT", syscall.PTRACE_EVENT_EXIT)
-	RegisterConstant("PTRACE_EVENT_FORK", syscall.PTRACE_EVENT_FORK)
-	RegisterConstant("PTRACE_EVENT_VFORK", syscall.PTRACE_EVENT_VFORK)
-	RegisterConstant("PTRACE_EVENT_VFORK_DONE", syscall.PTRACE_EVENT_VFORK_DONE)
-	RegisterConstant("PTRACE_GETEVENTMSG", syscall.PTRACE_GETEVENTMSG)
-	RegisterConstant("PTRACE_GETFPREGS", syscall.PTRACE_GETFPREGS)
-	RegisterConstant("PTRACE_GETFPXREGS", syscall.PTRACE_GETFPXREGS)
-	RegisterConstant("PTRACE_GETREGS", syscall.PTRACE_GETREGS)
-	RegisterConstant("PTRACE_GETREGSET", syscall.PTRACE_GETREGSET)
-	RegisterConstant("PTRACE_GETSIGINFO", syscall.PTRACE_GETSIGINFO)
-	RegisterConstant("PTRACE_GET_THREAD_AREA", syscall.PTRACE_GET_THREAD_AREA)
-	RegisterConstant("PTRACE_KILL", syscall.PTRACE_KILL)
-	RegisterConstant("PTRACE_OLDSETOPTIONS", syscall.PTRACE_OLDSETOPTIONS)
-	RegisterConstant("PTRACE_O_MASK", syscall.PTRACE_O_MASK)
-	RegisterConstant("PTRACE_O_TRACECLONE", syscall.PTRACE_O_TRACECLONE)
-	RegisterConstant("PTRACE_O_TRACEEXEC", syscall.PTRACE_O_TRACEEXEC)
-	RegisterConstant("PTRACE_O_TRACEEXIT", syscall.PTRACE_O_TRACEEXIT)
-	RegisterConstant("PTRACE_O_TRACEFORK", syscall.PTRACE_O_TRACEFORK)
-	RegisterConstant("PTRACE_O_TRACESYSGOOD", syscall.PTRACE_O_TRACESYSGOOD)
-	RegisterConstant("PTRACE_O_TRACEVFORK", syscall.PTRACE_O_TRACEVFORK)
-	RegisterConstant("PTRACE_O_TRACEVFORKDONE", syscall.PTRACE_O_TRACEVFORKDONE)
-	RegisterConstant("PTRACE_PEEKDATA", syscall.PTRACE_PEEKDATA)
-	RegisterConstant("PTRACE_PEEKTEXT", syscall.PTRACE_PEEKTEXT)
-	RegisterConstant("PTRACE_PEEKUSR", syscall.PTRACE_PEEKUSR)
-	RegisterConstant("PTRACE_POKEDATA", syscall.PTRACE_POKEDATA)
-	RegisterConstant("PTRACE_POKETEXT", syscall.PTRACE_POKETEXT)
-	RegisterConstant("PTRACE_POKEUSR", syscall.PTRACE_POKEUSR)
-	RegisterConstant("PTRACE_SETFPREGS", syscall.PTRACE_SETFPREGS)
-	RegisterConstant("PTRACE_SETFPXREGS", syscall.PTRACE_SETFPXREGS)
-	RegisterConstant("PTRACE_SETOPTIONS", syscall.PTRACE_SETOPTIONS)
-	RegisterConstant("PTRACE_SETREGS", syscall.PTRACE_SETREGS)
-	RegisterConstant("PTRACE_SETREGSET", syscall.PTRACE_SETREGSET)
-	RegisterConstant("PTRACE_SETSIGINFO", syscall.PTRACE_SETSIGINFO)
-	RegisterConstant("PTRACE_SET_THREAD_AREA", syscall.PTRACE_SET_THREAD_AREA)
-	RegisterConstant("PTRACE_SINGLEBLOCK", syscall.PTRACE_SINGLEBLOCK)
-	RegisterConstant("PTRACE_SINGLESTEP", syscall.PTRACE_SINGLESTEP)
-	RegisterConstant("PTRACE_SYSCALL", syscall.PTRACE_SYSCALL)
-	RegisterConstant("PTRACE_SYSEMU", syscall.PTRACE_SYSEMU)
-	RegisterConstant("PTRACE_SYSEMU_SINGLESTEP", syscall.PTRACE_SYSEMU_SINGLESTEP)
-	RegisterConstant("PTRACE_TRACEME", syscall.PTRACE_TRACEME)
-	RegisterConstant("RLIMIT_AS", syscall.RLIMIT_AS)
-	RegisterConstant("RLIMIT_CORE", syscall.RLIMIT_CORE)
-	RegisterConstant("RLIMIT_CPU", syscall.RLIMIT_CPU)
-	RegisterConstant("RLIMIT_DATA", syscall.RLIMIT_DATA)
-	RegisterConstant("RLIMIT_FSIZE", syscall.RLIMIT_FSIZE)
-	RegisterConstant("RLIMIT_NOFILE", syscall.RLIMIT_NOFILE)
-	RegisterConstant("RLIMIT_STACK", syscall.RLIMIT_STACK)
-	RegisterConstant("RLIM_INFINITY", syscall.RLIM_INFINITY)
-	RegisterConstant("RTAX_ADVMSS", syscall.RTAX_ADVMSS)
-	RegisterConstant("RTAX_CWND", syscall.RTAX_CWND)
-	RegisterConstant("RTAX_FEATURES", syscall.RTAX_FEATURES)
-	RegisterConstant("RTAX_FEATURE_ALLFRAG", syscall.RTAX_FEATURE_ALLFRAG)
-	RegisterConstant("RTAX_FEATURE_ECN", syscall.RTAX_FEATURE_ECN)
-	RegisterConstant("RTAX_FEATURE_SACK", syscall.RTAX_FEATURE_SACK)
-	RegisterConstant("RTAX_FEATURE_TIMESTAMP", syscall.RTAX_FEATURE_TIMESTAMP)
-	RegisterConstant("RTAX_HOPLIMIT", syscall.RTAX_HOPLIMIT)
-	RegisterConstant("RTAX_INITCWND", syscall.RTAX_INITCWND)
-	RegisterConstant("RTAX_INITRWND", syscall.RTAX_INITRWND)
-	RegisterConstant("RTAX_LOCK", syscall.RTAX_LOCK)
-	RegisterConstant("RTAX_MAX", syscall.RTAX_MAX)
-	RegisterConstant("RTAX_MTU", syscall.RTAX_MTU)
-	RegisterConstant("RTAX_REORDERING", syscall.RTAX_REORDERING)
-	RegisterConstant("RTAX_RTO_MIN", syscall.RTAX_RTO_MIN)
-	RegisterConstant("RTAX_RTT", syscall.RTAX_RTT)
-	RegisterConstant("RTAX_RTTVAR", syscall.RTAX_RTTVAR)
-	RegisterConstant("RTAX_SSTHRESH", syscall.RTAX_SSTHRESH)
-	RegisterConstant("RTAX_UNSPEC", syscall.RTAX_UNSPEC)
-	RegisterConstant("RTAX_WINDOW", syscall.RTAX_WINDOW)
-	RegisterConstant("RTA_ALIGNTO", syscall.RTA_ALIGNTO)
-	RegisterConstant("RTA_MAX", syscall.RTA_MAX)
-	RegisterConstant("RTCF_DIRECTSRC", syscall.RTCF_DIRECTSRC)
-	RegisterConstant("RTCF_DOREDIRECT", syscall.RTCF_DOREDIRECT)
-	RegisterConstant("RTCF_LOG", syscall.RTCF_LOG)
-	RegisterConstant("RTCF_MASQ", syscall.RTCF_MASQ)
-	RegisterConstant("RTCF_NAT", syscall.RTCF_NAT)
-	RegisterConstant("RTCF_VALVE", syscall.RTCF_VALVE)
-	RegisterConstant("RTF_ADDRCLASSMASK", syscall.RTF_ADDRCLASSMASK)
-	RegisterConstant("RTF_ADDRCONF", syscall.RTF_ADDRCONF)
-	RegisterConstant("RTF_ALLONLINK", syscall.RTF_ALLONLINK)
-	RegisterConstant("RTF_BROADCAST", syscall.RTF_BROADCAST)
-	RegisterConstant("RTF_CACHE", syscall.RTF_CACHE)
-	RegisterConstant("RTF_DEFAULT", syscall.RTF_DEFAULT)
-	RegisterConstant("RTF_DYNAMIC", syscall.RTF_DYNAMIC)
-	RegisterConstant("RTF_FLOW", syscall.RTF_FLOW)
-	RegisterConstant("RTF_GATEWAY", syscall.RTF_GATEWAY)
-	RegisterConstant("RTF_HOST", syscall.RTF_HOST)
-	RegisterConstant("RTF_INTERFACE", syscall.RTF_INTERFACE)
-	RegisterConstant("RTF_IRTT", syscall.RTF_IRTT)
-	RegisterConstant("RTF_LINKRT", syscall.RTF_LINKRT)
-	RegisterConstant("RTF_LOCAL", syscall.RTF_LOCAL)
-	RegisterConstant("RTF_MODIFIED", syscall.RTF_MODIFIED)
-	RegisterConstant("RTF_MSS", syscall.RTF_MSS)
-	RegisterConstant("RTF_MTU", syscall.RTF_MTU)
-	RegisterConstant("RTF_MULTICAST", syscall.RTF_MULTICAST)
-	RegisterConstant("RTF_NAT", syscall.RTF_NAT)
-	RegisterConstant("RTF_NOFORWARD", syscall.RTF_NOFORWARD)
-	RegisterConstant("RTF_NONEXTHOP", syscall.RTF_NONEXTHOP)
-	RegisterConstant("RTF_NOPMTUDISC", syscall.RTF_NOPMTUDISC)
-	RegisterConstant("RTF_POLICY", syscall.RTF_POLICY)
-	RegisterConstant("RTF_REINSTATE", syscall.RTF_REINSTATE)
-	RegisterConstant("RTF_REJECT", syscall.RTF_REJECT)
-	RegisterConstant("RTF_STATIC", syscall.RTF_STATIC)
-	RegisterConstant("RTF_THROW", syscall.RTF_THROW)
-	RegisterConstant("RTF_UP", syscall.RTF_UP)
-	RegisterConstant("RTF_WINDOW", syscall.RTF_WINDOW)
-	RegisterConstant("RTF_XRESOLVE", syscall.RTF_XRESOLVE)
-	RegisterConstant("RTM_BASE", syscall.RTM_BASE)
-	RegisterConstant("RTM_DELACTION", syscall.RTM_DELACTION)
-	RegisterConstant("RTM_DELADDR", syscall.RTM_DELADDR)
-	RegisterConstant("RTM_DELADDRLABEL", syscall.RTM_DELADDRLABEL)
-	RegisterConstant("RTM_DELLINK", syscall.RTM_DELLINK)
-	RegisterConstant("RTM_DELNEIGH", syscall.RTM_DELNEIGH)
-	RegisterConstant("RTM_DELQDISC", syscall.RTM_DELQDISC)
-	RegisterConstant("RTM_DELROUTE", syscall.RTM_DELROUTE)
-	RegisterConstant("RTM_DELRULE", syscall.RTM_DELRULE)
-	RegisterConstant("RTM_DELTCLASS", syscall.RTM_DELTCLASS)
-	RegisterConstant("RTM_DELTFILTER", syscall.RTM_DELTFILTER)
-	RegisterConstant("RTM_F_CLONED", syscall.RTM_F_CLONED)
-	RegisterConstant("RTM_F_EQUALIZE", syscall.RTM_F_EQUALIZE)
-	RegisterConstant("RTM_F_NOTIFY", syscall.RTM_F_NOTIFY)
-	RegisterConstant("RTM_F_PREFIX", syscall.RTM_F_PREFIX)
-	RegisterConstant("RTM_GETACTION", syscall.RTM_GETACTION)
-	RegisterConstant("RTM_GETADDR", syscall.RTM_GETADDR)
-	RegisterConstant("RTM_GETADDRLABEL", syscall.RTM_GETADDRLABEL)
-	RegisterConstant("RTM_GETANYCAST", syscall.RTM_GETANYCAST)
-	RegisterConstant("RTM_GETDCB", syscall.RTM_GETDCB)
-	RegisterConstant("RTM_GETLINK", syscall.RTM_GETLINK)
-	RegisterConstant("RTM_GETMULTICAST", syscall.RTM_GETMULTICAST)
-	RegisterConstant("RTM_GETNEIGH", syscall.RTM_GETNEIGH)
-	RegisterConstant("RTM_GETNEIGHTBL", syscall.RTM_GETNEIGHTBL)
-	RegisterConstant("RTM_GETQDISC", syscall.RTM_GETQDISC)
-	RegisterConstant("RTM_GETROUTE", syscall.RTM_GETROUTE)
-	RegisterConstant("RTM_GETRULE", syscall.RTM_GETRULE)
-	RegisterConstant("RTM_GETTCLASS", syscall.RTM_GETTCLASS)
-	RegisterConstant("RTM_GETTFILTER", syscall.RTM_GETTFILTER)
-	RegisterConstant("RTM_MAX", syscall.RTM_MAX)
-	RegisterConstant("RTM_NEWACTION", syscall.RTM_NEWACTION)
-	RegisterConstant("RTM_NEWADDR", syscall.RTM_NEWADDR)
-	RegisterConstant("RTM_NEWADDRLABEL", syscall.RTM_NEWADDRLABEL)
-	RegisterConstant("RTM_NEWLINK", syscall.RTM_NEWLINK)
-	RegisterConstant("RTM_NEWNDUSEROPT", syscall.RTM_NEWNDUSEROPT)
-	RegisterConstant("RTM_NEWNEIGH", syscall.RTM_NEWNEIGH)
-	RegisterConstant("RTM_NEWNEIGHTBL", syscall.RTM_NEWNEIGHTBL)
-	RegisterConstant("RTM_NEWPREFIX", syscall.RTM_NEWPREFIX)
-	RegisterConstant("RTM_NEWQDISC", syscall.RTM_NEWQDISC)
-	RegisterConstant("RTM_NEWROUTE", syscall.RTM_NEWROUTE)
-	RegisterConstant("RTM_NEWRULE", syscall.RTM_NEWRULE)
-	RegisterConstant("RTM_NEWTCLASS", syscall.RTM_NEWTCLASS)
-	RegisterConstant("RTM_NEWTFILTER", syscall.RTM_NEWTFILTER)
-	RegisterConstant("RTM_NR_FAMILIES", syscall.RTM_NR_FAMILIES)
-	RegisterConstant("RTM_NR_MSGTYPES", syscall.RTM_NR_MSGTYPES)
-	RegisterConstant("RTM_SETDCB", syscall.RTM_SETDCB)
-	RegisterConstant("RTM_SETLINK", syscall.RTM_SETLINK)
-	RegisterConstant("RTM_SETNEIGHTBL", syscall.RTM_SETNEIGHTBL)
-	RegisterConstant("RTNH_ALIGNTO", syscall.RTNH_ALIGNTO)
-	RegisterConstant("RTNH_F_DEAD", syscall.RTNH_F_DEAD)
-	RegisterConstant("RTNH_F_ONLINK", syscall.RTNH_F_ONLINK)
-	RegisterConstant("RTNH_F_PERVASIVE", syscall.RTNH_F_PERVASIVE)
-	RegisterConstant("RTN_MAX", syscall.RTN_MAX)
-	RegisterConstant("RTPROT_BIRD", syscall.RTPROT_BIRD)
-	RegisterConstant("RTPROT_BOOT", syscall.RTPROT_BOOT)
-	RegisterConstant("RTPROT_DHCP", syscall.RTPROT_DHCP)
-	RegisterConstant("RTPROT_DNROUTED", syscall.RTPROT_DNROUTED)
-	RegisterConstant("RTPROT_GATED", syscall.RTPROT_GATED)
-	RegisterConstant("RTPROT_KERNEL", syscall.RTPROT_KERNEL)
-	RegisterConstant("RTPROT_MRT", syscall.RTPROT_MRT)
-	RegisterConstant("RTPROT_NTK", syscall.RTPROT_NTK)
-	RegisterConstant("RTPROT_RA", syscall.RTPROT_RA)
-	RegisterConstant("RTPROT_REDIRECT", syscall.RTPROT_REDIRECT)
-	RegisterConstant("RTPROT_STATIC", syscall.RTPROT_STATIC)
-	RegisterConstant("RTPROT_UNSPEC", syscall.RTPROT_UNSPEC)
-	RegisterConstant("RTPROT_XORP", syscall.RTPROT_XORP)
-	RegisterConstant("RTPROT_ZEBRA", syscall.RTPROT_ZEBRA)
-	RegisterConstant("RT_CLASS_DEFAULT", syscall.RT_CLASS_DEFAULT)
-	RegisterConstant("RT_CLASS_LOCAL", syscall.RT_CLASS_LOCAL)
-	RegisterConstant("RT_CLASS_MAIN", syscall.RT_CLASS_MAIN)
-	RegisterConstant("RT_CLASS_MAX", syscall.RT_CLASS_MAX)
-	RegisterConstant("RT_CLASS_UNSPEC", syscall.RT_CLASS_UNSPEC)
-	RegisterConstant("RUSAGE_CHILDREN", syscall.RUSAGE_CHILDREN)
-	RegisterConstant("RUSAGE_SELF", syscall.RUSAGE_SELF)
-	RegisterConstant("RUSAGE_THREAD", syscall.RUSAGE_THREAD)
-	RegisterConstant("SCM_CREDENTIALS", syscall.SCM_CREDENTIALS)
-	RegisterConstant("SCM_RIGHTS", syscall.SCM_RIGHTS)
-	RegisterConstant("SCM_TIMESTAMP", syscall.SCM_TIMESTAMP)
-	RegisterConstant("SCM_TIMESTAMPING", syscall.SCM_TIMESTAMPING)
-	RegisterConstant("SCM_TIMESTAMPNS", syscall.SCM_TIMESTAMPNS)
-	RegisterConstant("SHUT_RD", syscall.SHUT_RD)
-	RegisterConstant("SHUT_RDWR", syscall.SHUT_RDWR)
-	RegisterConstant("SHUT_WR", syscall.SHUT_WR)
-	RegisterConstant("SIOCADDDLCI", syscall.SIOCADDDLCI)
-	RegisterConstant("SIOCADDMULTI", syscall.SIOCADDMULTI)
-	RegisterConstant("SIOCADDRT", syscall.SIOCADDRT)
-	RegisterConstant("SIOCATMARK", syscall.SIOCATMARK)
-	RegisterConstant("SIOCDARP", syscall.SIOCDARP)
-	RegisterConstant("SIOCDELDLCI", syscall.SIOCDELDLCI)
-	RegisterConstant("SIOCDELMULTI", syscall.SIOCDELMULTI)
-	RegisterConstant("SIOCDELRT", syscall.SIOCDELRT)
-	RegisterConstant("SIOCDEVPRIVATE", syscall.SIOCDEVPRIVATE)
-	RegisterConstant("SIOCDIFADDR", syscall.SIOCDIFADDR)
-	RegisterConstant("SIOCDRARP", syscall.SIOCDRARP)
-	RegisterConstant("SIOCGARP", syscall.SIOCGARP)
-	RegisterConstant("SIOCGIFADDR", syscall.SIOCGIFADDR)
-	RegisterConstant("SIOCGIFBR", syscall.SIOCGIFBR)
-	RegisterConstant("SIOCGIFBRDADDR", syscall.SIOCGIFBRDADDR)
-	RegisterConstant("SIOCGIFCONF", syscall.SIOCGIFCONF)
-	RegisterConstant("SIOCGIFCOUNT", syscall.SIOCGIFCOUNT)
-	RegisterConstant("SIOCGIFDSTADDR", syscall.SIOCGIFDSTADDR)
-	RegisterConstant("SIOCGIFENCAP", syscall.SIOCGIFENCAP)
-	RegisterConstant("SIOCGIFFLAGS", syscall.SIOCGIFFLAGS)
-	RegisterConstant("SIOCGIFHWADDR", syscall.SIOCGIFHWADDR)
-	RegisterConstant("SIOCGIFINDEX", syscall.SIOCGIFINDEX)
-	RegisterConstant("SIOCGIFMAP", syscall.SIOCGIFMAP)
-	RegisterConstant("SIOCGIFMEM", syscall.SIOCGIFMEM)
-	RegisterConstant("SIOCGIFMETRIC", syscall.SIOCGIFMETRIC)
-	RegisterConstant("SIOCGIFMTU", syscall.SIOCGIFMTU)
-	RegisterConstant("SIOCGIFNAME", syscall.SIOCGIFNAME)
-	RegisterConstant("SIOCGIFNETMASK", syscall.SIOCGIFNETMASK)
-	RegisterConstant("SIOCGIFPFLAGS", syscall.SIOCGIFPFLAGS)
-	RegisterConstant("SIOCGIFSLAVE", syscall.SIOCGIFSLAVE)
-	RegisterConstant("SIOCGIFTXQLEN", syscall.SIOCGIFTXQLEN)
-	RegisterConstant("SIOCGPGRP", syscall.SIOCGPGRP)
-	RegisterConstant("SIOCGRARP", syscall.SIOCGRARP)
-	RegisterConstant("SIOCGSTAMP", syscall.SIOCGSTAMP)
-	RegisterConstant("SIOCGSTAMPNS", syscall.SIOCGSTAMPNS)
-	RegisterConstant("SIOCPROTOPRIVATE", syscall.SIOCPROTOPRIVATE)
-	RegisterConstant("SIOCRTMSG", syscall.SIOCRTMSG)
-	RegisterConstant("SIOCSARP", syscall.SIOCSARP)
-	RegisterConstant("SIOCSIFADDR", syscall.SIOCSIFADDR)
-	RegisterConstant("SIOCSIFBR", syscall.SIOCSIFBR)
-	RegisterConstant("SIOCSIFBRDADDR", syscall.SIOCSIFBRDADDR)
-	RegisterConstant("SIOCSIFDSTADDR", syscall.SIOCSIFDSTADDR)
-	RegisterConstant("SIOCSIFENCAP", syscall.SIOCSIFENCAP)
-	RegisterConstant("SIOCSIFFLAGS", syscall.SIOCSIFFLAGS)
-	RegisterConstant("SIOCSIFHWADDR", syscall.SIOCSIFHWADDR)
-	RegisterConstant("SIOCSIFHWBROADCAST", syscall.SIOCSIFHWBROADCAST)
-	RegisterConstant("SIOCSIFLINK", syscall.SIOCSIFLINK)
-	RegisterConstant("SIOCSIFMAP", syscall.SIOCSIFMAP)
-	RegisterConstant("SIOCSIFMEM", syscall.SIOCSIFMEM)
-	RegisterConstant("SIOCSIFMETRIC", syscall.SIOCSIFMETRIC)
-	RegisterConstant("SIOCSIFMTU", syscall.SIOCSIFMTU)
-	RegisterConstant("SIOCSIFNAME", syscall.SIOCSIFNAME)
-	RegisterConstant("SIOCSIFNETMASK", syscall.SIOCSIFNETMASK)
-	RegisterConstant("SIOCSIFPFLAGS", syscall.SIOCSIFPFLAGS)
-	RegisterConstant("SIOCSIFSLAVE", syscall.SIOCSIFSLAVE)
-	RegisterConstant("SIOCSIFTXQLEN", syscall.SIOCSIFTXQLEN)
-	RegisterConstant("SIOCSPGRP", syscall.SIOCSPGRP)
-	RegisterConstant("SIOCSRARP", syscall.SIOCSRARP)
-	RegisterConstant("SOCK_CLOEXEC", syscall.SOCK_CLOEXEC)
-	RegisterConstant("SOCK_DCCP", syscall.SOCK_DCCP)
-	RegisterConstant("SOCK_DGRAM", syscall.SOCK_DGRAM)
-	RegisterConstant("SOCK_NONBLOCK", syscall.SOCK_NONBLOCK)
-	RegisterConstant("SOCK_PACKET", syscall.SOCK_PACKET)
-	RegisterConstant("SOCK_RAW", syscall.SOCK_RAW)
-	RegisterConstant("SOCK_RDM", syscall.SOCK_RDM)
-	RegisterConstant("SOCK_SEQPACKET", syscall.SOCK_SEQPACKET)
-	RegisterConstant("SOCK_STREAM", syscall.SOCK_STREAM)
-	RegisterConstant("SOL_AAL", syscall.SOL_AAL)
-	RegisterConstant("SOL_ATM", syscall.SOL_ATM)
-	RegisterConstant("SOL_DECNET", syscall.SOL_DECNET)
-	RegisterConstant("SOL_ICMPV6", syscall.SOL_ICMPV6)
-	RegisterConstant("SOL_IP", syscall.SOL_IP)
-	RegisterConstant("SOL_IPV6", syscall.SOL_IPV6)
-	RegisterConstant("SOL_IRDA", syscall.SOL_IRDA)
-	RegisterConstant("SOL_PACKET", syscall.SOL_PACKET)
-	RegisterConstant("SOL_RAW", syscall.SOL_RAW)
-	RegisterConstant("SOL_SOCKET", syscall.SOL_SOCKET)
-	RegisterConstant("SOL_TCP", syscall.SOL_TCP)
-	RegisterConstant("SOL_X25", syscall.SOL_X25)
-	RegisterConstant("SOMAXCONN", syscall.SOMAXCONN)
-	RegisterConstant("SO_ACCEPTCONN", syscall.SO_ACCEPTCONN)
-	RegisterConstant("SO_ATTACH_FILTER", syscall.SO_ATTACH_FILTER)
-	RegisterConstant("SO_BINDTODEVICE", syscall.SO_BINDTODEVICE)
-	RegisterConstant("SO_BROADCAST", syscall.SO_BROADCAST)
-	RegisterConstant("SO_BSDCOMPAT", syscall.SO_BSDCOMPAT)
-	RegisterConstant("SO_DEBUG", syscall.SO_DEBUG)
-	RegisterConstant("SO_DETACH_FILTER", syscall.SO_DETACH_FILTER)
-	RegisterConstant("SO_DOMAIN", syscall.SO_DOMAIN)
-	RegisterConstant("SO_DONTROUTE", syscall.SO_DONTROUTE)
-	RegisterConstant("SO_ERROR", syscall.SO_ERROR)
-	RegisterConstant("SO_KEEPALIVE", syscall.SO_KEEPALIVE)
-	RegisterConstant("SO_LINGER", syscall.SO_LINGER)
-	RegisterConstant("SO_MARK", syscall.SO_MARK)
-	RegisterConstant("SO_NO_CHECK", syscall.SO_NO_CHECK)
-	RegisterConstant("SO_OOBINLINE", syscall.SO_OOBINLINE)
-	RegisterConstant("SO_PASSCRED", syscall.SO_PASSCRED)
-	RegisterConstant("SO_PASSSEC", syscall.SO_PASSSEC)
-	RegisterConstant("SO_PEERCRED", syscall.SO_PEERCRED)
-	RegisterConstant("SO_PEERNAME", syscall.SO_PEERNAME)
-	RegisterConstant("SO_PEERSEC", syscall.SO_PEERSEC)
-	RegisterConstant("SO_PRIORITY", syscall.SO_PRIORITY)
-	RegisterConstant("SO_PROTOCOL", syscall.SO_PROTOCOL)
-	RegisterConstant("SO_RCVBUF", syscall.SO_RCVBUF)
-	RegisterConstant("SO_RCVBUFFORCE", syscall.SO_RCVBUFFORCE)
-	RegisterConstant("SO_RCVLOWAT", syscall.SO_RCVLOWAT)
-	RegisterConstant("SO_RCVTIMEO", syscall.SO_RCVTIMEO)
-	RegisterConstant("SO_REUSEADDR", syscall.SO_REUSEADDR)
-	RegisterConstant("SO_RXQ_OVFL", syscall.SO_RXQ_OVFL)
-	RegisterConstant("SO_SECURITY_AUTHENTICATION", syscall.SO_SECURITY_AUTHENTICATION)
-	RegisterConstant("SO_SECURITY_ENCRYPTION_NETWORK", syscall.SO_SECURITY_ENCRYPTION_NETWORK)
-	RegisterConstant("SO_SECURITY_ENCRYPTION_TRANSPORT", syscall.SO_SECURITY_ENCRYPTION_TRANSPORT)
-	RegisterConstant("SO_SNDBUF", syscall.SO_SNDBUF)
-	RegisterConstant("SO_SNDBUFFORCE", syscall.SO_SNDBUFFORCE)
-	RegisterConstant("SO_SNDLOWAT", syscall.SO_SNDLOWAT)
-	RegisterConstant("SO_SNDTIMEO", syscall.SO_SNDTIMEO)
-	RegisterConstant("SO_TIMESTAMP", syscall.SO_TIMESTAMP)
-	RegisterConstant("SO_TIMESTAMPING", syscall.SO_TIMESTAMPING)
-	RegisterConstant("SO_TIMESTAMPNS", syscall.SO_TIMESTAMPNS)
-	RegisterConstant("SO_TYPE", syscall.SO_TYPE)
-	RegisterConstant("S_BLKSIZE", syscall.S_BLKSIZE)
-	RegisterConstant("S_IEXEC", syscall.S_IEXEC)
-	RegisterConstant("S_IFBLK", syscall.S_IFBLK)
-	RegisterConstant("S_IFCHR", syscall.S_IFCHR)
-	RegisterConstant("S_IFDIR", syscall.S_IFDIR)
-	RegisterConstant("S_IFIFO", syscall.S_IFIFO)
-	RegisterConstant("S_IFLNK", syscall.S_IFLNK)
-	RegisterConstant("S_IFMT", syscall.S_IFMT)
-	RegisterConstant("S_IFREG", syscall.S_IFREG)
-	RegisterConstant("S_IFSOCK", syscall.S_IFSOCK)
-	RegisterConstant("S_IREAD", syscall.S_IREAD)
-	RegisterConstant("S_IRGRP", syscall.S_IRGRP)
-	RegisterConstant("S_IROTH", syscall.S_IROTH)
-	RegisterConstant("S_IRUSR", syscall.S_IRUSR)
-	RegisterConstant("S_IRWXG", syscall.S_IRWXG)
-	RegisterConstant("S_IRWXO", syscall.S_IRWXO)
-	RegisterConstant("S_IRWXU", syscall.S_IRWXU)
-	RegisterConstant("S_ISGID", syscall.S_ISGID)
-	RegisterConstant("S_ISUID", syscall.S_ISUID)
-	RegisterConstant("S_ISVTX", syscall.S_ISVTX)
-	RegisterConstant("S_IWGRP", syscall.S_IWGRP)
-	RegisterConstant("S_IWOTH", syscall.S_IWOTH)
-	RegisterConstant("S_IWRITE", syscall.S_IWRITE)
-	RegisterConstant("S_IWUSR", syscall.S_IWUSR)
-	RegisterConstant("S_IXGRP", syscall.S_IXGRP)
-	RegisterConstant("S_IXOTH", syscall.S_IXOTH)
-	RegisterConstant("S_IXUSR", syscall.S_IXUSR)
-	RegisterConstant("TCGETS", syscall.TCGETS)
-	RegisterConstant("TCIFLUSH", syscall.TCIFLUSH)
-	RegisterConstant("TCIOFLUSH", syscall.TCIOFLUSH)
-	RegisterConstant("TCOFLUSH", syscall.TCOFLUSH)
-	RegisterConstant("TCP_CONGESTION", syscall.TCP_CONGESTION)
-	RegisterConstant("TCP_CORK", syscall.TCP_CORK)
-	RegisterConstant("TCP_DEFER_ACCEPT", syscall.TCP_DEFER_ACCEPT)
-	RegisterConstant("TCP_INFO", syscall.TCP_INFO)
-	RegisterConstant("TCP_KEEPCNT", syscall.TCP_KEEPCNT)
-	RegisterConstant("TCP_KEEPIDLE", syscall.TCP_KEEPIDLE)
-	RegisterConstant("TCP_KEEPINTVL", syscall.TCP_KEEPINTVL)
-	RegisterConstant("TCP_LINGER2", syscall.TCP_LINGER2)
-	RegisterConstant("TCP_MAXSEG", syscall.TCP_MAXSEG)
-	RegisterConstant("TCP_MAXWIN", syscall.TCP_MAXWIN)
-	RegisterConstant("TCP_MAX_WINSHIFT", syscall.TCP_MAX_WINSHIFT)
-	RegisterConstant("TCP_MD5SIG", syscall.TCP_MD5SIG)
-	RegisterConstant("TCP_MD5SIG_MAXKEYLEN", syscall.TCP_MD5SIG_MAXKEYLEN)
-	RegisterConstant("TCP_MSS", syscall.TCP_MSS)
-	RegisterConstant("TCP_NODELAY", syscall.TCP_NODELAY)
-	RegisterConstant("TCP_QUICKACK", syscall.TCP_QUICKACK)
-	RegisterConstant("TCP_SYNCNT", syscall.TCP_SYNCNT)
-	RegisterConstant("TCP_WINDOW_CLAMP", syscall.TCP_WINDOW_CLAMP)
-	RegisterConstant("TCSETS", syscall.TCSETS)
-	RegisterConstant("TIOCCBRK", syscall.TIOCCBRK)
-	RegisterConstant("TIOCCONS", syscall.TIOCCONS)
-	RegisterConstant("TIOCEXCL", syscall.TIOCEXCL)
-	RegisterConstant("TIOCGDEV", syscall.TIOCGDEV)
-	RegisterConstant("TIOCGETD", syscall.TIOCGETD)
-	RegisterConstant("TIOCGICOUNT", syscall.TIOCGICOUNT)
-	RegisterConstant("TIOCGLCKTRMIOS", syscall.TIOCGLCKTRMIOS)
-	RegisterConstant("TIOCGPGRP", syscall.TIOCGPGRP)
-	RegisterConstant("TIOCGPTN", syscall.TIOCGPTN)
-	RegisterConstant("TIOCGRS485", syscall.TIOCGRS485)
-	RegisterConstant("TIOCGSERIAL", syscall.TIOCGSERIAL)
-	RegisterConstant("TIOCGSID", syscall.TIOCGSID)
-	RegisterConstant("TIOCGSOFTCAR", syscall.TIOCGSOFTCAR)
-	RegisterConstant("TIOCGWINSZ", syscall.TIOCGWINSZ)
-	RegisterConstant("TIOCINQ", syscall.TIOCINQ)
-	RegisterConstant("TIOCLINUX", syscall.TIOCLINUX)
-	RegisterConstant("TIOCMBIC", syscall.TIOCMBIC)
-	RegisterConstant("TIOCMBIS", syscall.TIOCMBIS)
-	RegisterConstant("TIOCMGET", syscall.TIOCMGET)
-	RegisterConstant("TIOCMIWAIT", syscall.TIOCMIWAIT)
-	RegisterConstant("TIOCMSET", syscall.TIOCMSET)
-	RegisterConstant("TIOCM_CAR", syscall.TIOCM_CAR)
-	RegisterConstant("TIOCM_CD", syscall.TIOCM_CD)
-	RegisterConstant("TIOCM_CTS", syscall.TIOCM_CTS)
-	RegisterConstant("TIOCM_DSR", syscall.TIOCM_DSR)
-	RegisterConstant("TIOCM_DTR", syscall.TIOCM_DTR)
-	RegisterConstant("TIOCM_LE", syscall.TIOCM_LE)
-	RegisterConstant("TIOCM_RI", syscall.TIOCM_RI)
-	RegisterConstant("TIOCM_RNG", syscall.TIOCM_RNG)
-	RegisterConstant("TIOCM_RTS", syscall.TIOCM_RTS)
-	RegisterConstant("TIOCM_SR", syscall.TIOCM_SR)
-	RegisterConstant("TIOCM_ST", syscall.TIOCM_ST)
-	RegisterConstant("TIOCNOTTY", syscall.TIOCNOTTY)
-	RegisterConstant("TIOCNXCL", syscall.TIOCNXCL)
-	RegisterConstant("TIOCOUTQ", syscall.TIOCOUTQ)
-	RegisterConstant("TIOCPKT", syscall.TIOCPKT)
-	RegisterConstant("TIOCPKT_DATA", syscall.TIOCPKT_DATA)
-	RegisterConstant("TIOCPKT_DOSTOP", syscall.TIOCPKT_DOSTOP)
-	RegisterConstant("TIOCPKT_FLUSHREAD", syscall.TIOCPKT_FLUSHREAD)
-	RegisterConstant("TIOCPKT_FLUSHWRITE", syscall.TIOCPKT_FLUSHWRITE)
-	RegisterConstant("TIOCPKT_IOCTL", syscall.TIOCPKT_IOCTL)
-	RegisterConstant("TIOCPKT_NOSTOP", syscall.TIOCPKT_NOSTOP)
-	RegisterConstant("TIOCPKT_START", syscall.TIOCPKT_START)
-	RegisterConstant("TIOCPKT_STOP", syscall.TIOCPKT_STOP)
-	RegisterConstant("TIOCSBRK", syscall.TIOCSBRK)
-	RegisterConstant("TIOCSCTTY", syscall.TIOCSCTTY)
-	RegisterConstant("TIOCSERCONFIG", syscall.TIOCSERCONFIG)
-	RegisterConstant("TIOCSERGETLSR", syscall.TIOCSERGETLSR)
-	RegisterConstant("TIOCSERGETMULTI", syscall.TIOCSERGETMULTI)
-	RegisterConstant("TIOCSERGSTRUCT", syscall.TIOCSERGSTRUCT)
-	RegisterConstant("TIOCSERGWILD", syscall.TIOCSERGWILD)
-	RegisterConstant("TIOCSERSETMULTI", syscall.TIOCSERSETMULTI)
-	RegisterConstant("TIOCSERSWILD", syscall.TIOCSERSWILD)
-	RegisterConstant("TIOCSER_TEMT", syscall.TIOCSER_TEMT)
-	RegisterConstant("TIOCSETD", syscall.TIOCSETD)
-	RegisterConstant("TIOCSIG", syscall.TIOCSIG)
-	RegisterConstant("TIOCSLCKTRMIOS", syscall.TIOCSLCKTRMIOS)
-	RegisterConstant("TIOCSPGRP", syscall.TIOCSPGRP)
-	RegisterConstant("TIOCSPTLCK", syscall.TIOCSPTLCK)
-	RegisterConstant("TIOCSRS485", syscall.TIOCSRS485)
-	RegisterConstant("TIOCSSERIAL", syscall.TIOCSSERIAL)
-	RegisterConstant("TIOCSSOFTCAR", syscall.TIOCSSOFTCAR)
-	RegisterConstant("TIOCSTI", syscall.TIOCSTI)
-	RegisterConstant("TIOCSWINSZ", syscall.TIOCSWINSZ)
-	RegisterConstant("TUNATTACHFILTER", syscall.TUNATTACHFILTER)
-	RegisterConstant("TUNDETACHFILTER", syscall.TUNDETACHFILTER)
-	RegisterConstant("TUNGETFEATURES", syscall.TUNGETFEATURES)
-	RegisterConstant("TUNGETIFF", syscall.TUNGETIFF)
-	RegisterConstant("TUNGETSNDBUF", syscall.TUNGETSNDBUF)
-	RegisterConstant("TUNGETVNETHDRSZ", syscall.TUNGETVNETHDRSZ)
-	RegisterConstant("TUNSETDEBUG", syscall.TUNSETDEBUG)
-	RegisterConstant("TUNSETGROUP", syscall.TUNSETGROUP)
-	RegisterConstant("TUNSETIFF", syscall.TUNSETIFF)
-	RegisterConstant("TUNSETLINK", syscall.TUNSETLINK)
-	RegisterConstant("TUNSETNOCSUM", syscall.TUNSETNOCSUM)
-	RegisterConstant("TUNSETOFFLOAD", syscall.TUNSETOFFLOAD)
-	RegisterConstant("TUNSETOWNER", syscall.TUNSETOWNER)
-	RegisterConstant("TUNSETPERSIST", syscall.TUNSETPERSIST)
-	RegisterConstant("TUNSETSNDBUF", syscall.TUNSETSNDBUF)
-	RegisterConstant("TUNSETTXFILTER", syscall.TUNSETTXFILTER)
-	RegisterConstant("TUNSETVNETHDRSZ", syscall.TUNSETVNETHDRSZ)
-	RegisterConstant("WALL", syscall.WALL)
-	RegisterConstant("WCLONE", syscall.WCLONE)
-	RegisterConstant("WCONTINUED", syscall.WCONTINUED)
-	RegisterConstant("WEXITED", syscall.WEXITED)
-	RegisterConstant("WNOHANG", syscall.WNOHANG)
-	RegisterConstant("WNOTHREAD", syscall.WNOTHREAD)
-	RegisterConstant("WNOWAIT", syscall.WNOWAIT)
-	RegisterConstant("WORDSIZE", syscall.WORDSIZE)
-	RegisterConstant("WSTOPPED", syscall.WSTOPPED)
-	RegisterConstant("WUNTRACED", syscall.WUNTRACED)
-	RegisterConstant("XCASE", syscall.XCASE)
-
-	RegisterError("E2BIG", int(syscall.E2BIG))
-	RegisterError("EACCES", int(syscall.EACCES))
-	RegisterError("EADDRINUSE", int(syscall.EADDRINUSE))
-	RegisterError("EADDRNOTAVAIL", int(syscall.EADDRNOTAVAIL))
-	RegisterError("EADV", int(syscall.EADV))
-	RegisterError("EAFNOSUPPORT", int(syscall.EAFNOSUPPORT))
-	RegisterError("EAGAIN", int(syscall.EAGAIN))
-	RegisterError("EALREADY", int(syscall.EALREADY))
-	RegisterError("EBADE", int(syscall.EBADE))
-	RegisterError("EBADF", int(syscall.EBADF))
-	RegisterError("EBADFD", int(syscall.EBADFD))
-	RegisterError("EBADMSG", int(syscall.EBADMSG))
-	RegisterError("EBADR", int(syscall.EBADR))
-	RegisterError("EBADRQC", int(syscall.EBADRQC))
-	RegisterError("EBADSLT", int(syscall.EBADSLT))
-	RegisterError("EBFONT", int(syscall.EBFONT))
-	RegisterError("EBUSY", int(syscall.EBUSY))
-	RegisterError("ECANCELED", int(syscall.ECANCELED))
-	RegisterError("ECHILD", int(syscall.ECHILD))
-	RegisterError("ECHRNG", int(syscall.ECHRNG))
-	RegisterError("ECOMM", int(syscall.ECOMM))
-	RegisterError("ECONNABORTED", int(syscall.ECONNABORTED))
-	RegisterError("ECONNREFUSED", int(syscall.ECONNREFUSED))
-	RegisterError("ECONNRESET", int(syscall.ECONNRESET))
-	RegisterError("EDEADLK", int(syscall.EDEADLK))
-	RegisterError("EDEADLOCK", int(syscall.EDEADLOCK))
-	RegisterError("EDESTADDRREQ", int(syscall.EDESTADDRREQ))
-	RegisterError("EDOM", int(syscall.EDOM))
-	RegisterError("EDOTDOT", int(syscall.EDOTDOT))
-	RegisterError("EDQUOT", int(syscall.EDQUOT))
-	RegisterError("EEXIST", int(syscall.EEXIST))
-	RegisterError("EFAULT", int(syscall.EFAULT))
-	RegisterError("EFBIG", int(syscall.EFBIG))
-	RegisterError("EHOSTDOWN", int(syscall.EHOSTDOWN))
-	RegisterError("EHOSTUNREACH", int(syscall.EHOSTUNREACH))
-	RegisterError("EIDRM", int(syscall.EIDRM))
-	RegisterError("EILSEQ", int(syscall.EILSEQ))
-	RegisterError("EINPROGRESS", int(syscall.EINPROGRESS))
-	RegisterError("EINTR", int(syscall.EINTR))
-	RegisterError("EINVAL", int(syscall.EINVAL))
-	RegisterError("EIO", int(syscall.EIO))
-	RegisterError("EISCONN", int(syscall.EISCONN))
-	RegisterError("EISDIR", int(syscall.EISDIR))
-	RegisterError("EISNAM", int(syscall.EISNAM))
-	RegisterError("EKEYEXPIRED", int(syscall.EKEYEXPIRED))
-	RegisterError("EKEYREJECTED", int(syscall.EKEYREJECTED))
-	RegisterError("EKEYREVOKED", int(syscall.EKEYREVOKED))
-	RegisterError("EL2HLT", int(syscall.EL2HLT))
-	RegisterError("EL2NSYNC", int(syscall.EL2NSYNC))
-	RegisterError("EL3HLT", int(syscall.EL3HLT))
-	RegisterError("EL3RST", int(syscall.EL3RST))
-	RegisterError("ELIBACC", int(syscall.ELIBACC))
-	RegisterError("ELIBBAD", int(syscall.ELIBBAD))
-	RegisterError("ELIBEXEC", int(syscall.ELIBEXEC))
-	RegisterError("ELIBMAX", int(syscall.ELIBMAX))
-	RegisterError("ELIBSCN", int(syscall.ELIBSCN))
-	RegisterError("ELNRNG", int(syscall.ELNRNG))
-	RegisterError("ELOOP", int(syscall.ELOOP))
-	RegisterError("EMEDIUMTYPE", int(syscall.EMEDIUMTYPE))
-	RegisterError("EMFILE", int(syscall.EMFILE))
-	RegisterError("EMLINK", int(syscall.EMLINK))
-	RegisterError("EMSGSIZE", int(syscall.EMSGSIZE))
-	RegisterError("EMULTIHOP", int(syscall.EMULTIHOP))
-	RegisterError("ENAMETOOLONG", int(syscall.ENAMETOOLONG))
-	RegisterError("ENAVAIL", int(syscall.ENAVAIL))
-	RegisterError("ENETDOWN", int(syscall.ENETDOWN))
-	RegisterError("ENETRESET", int(syscall.ENETRESET))
-	RegisterError("ENETUNREACH", int(syscall.ENETUNREACH))
-	RegisterError("ENFILE", int(syscall.ENFILE))
-	RegisterError("ENOANO", int(syscall.ENOANO))
-	RegisterError("ENOBUFS", int(syscall.ENOBUFS))
-	RegisterError("ENOCSI", int(syscall.ENOCSI))
-	RegisterError("ENODATA", int(syscall.ENODATA))
-	RegisterError("ENODEV", int(syscall.ENODEV))
-	RegisterError("ENOENT", int(syscall.ENOENT))
-	RegisterError("ENOEXEC", int(syscall.ENOEXEC))
-	RegisterError("ENOKEY", int(syscall.ENOKEY))
-	RegisterError("ENOLCK", int(syscall.ENOLCK))
-	RegisterError("ENOLINK", int(syscall.ENOLINK))
-	RegisterError("ENOMEDIUM", int(syscall.ENOMEDIUM))
-	RegisterError("ENOMEM", int(syscall.ENOMEM))
-	RegisterError("ENOMSG", int(syscall.ENOMSG))
-	RegisterError("ENONET", int(syscall.ENONET))
-	RegisterError("ENOPKG", int(syscall.ENOPKG))
-	RegisterError("ENOPROTOOPT", int(syscall.ENOPROTOOPT))
-	RegisterError("ENOSPC", int(syscall.ENOSPC))
-	RegisterError("ENOSR", int(syscall.ENOSR))
-	RegisterError("ENOSTR", int(syscall.ENOSTR))
-	RegisterError("ENOSYS", int(syscall.ENOSYS))
-	RegisterError("ENOTBLK", int(syscall.ENOTBLK))
-	RegisterError("ENOTCONN", int(syscall.ENOTCONN))
-	RegisterError("ENOTDIR", int(syscall.ENOTDIR))
-	RegisterError("ENOTEMPTY", int(syscall.ENOTEMPTY))
-	RegisterError("ENOTNAM", int(syscall.ENOTNAM))
-	RegisterError("ENOTRECOVERABLE", int(syscall.ENOTRECOVERABLE))
-	RegisterError("ENOTSOCK", int(syscall.ENOTSOCK))
-	RegisterError("ENOTSUP", int(syscall.ENOTSUP))
-	RegisterError("ENOTTY", int(syscall.ENOTTY))
-	RegisterError("ENOTUNIQ", int(syscall.ENOTUNIQ))
-	RegisterError("ENXIO", int(syscall.ENXIO))
-	RegisterError("EOPNOTSUPP", int(syscall.EOPNOTSUPP))
-	RegisterError("EOVERFLOW", int(syscall.EOVERFLOW))
-	RegisterError("EOWNERDEAD", int(syscall.EOWNERDEAD))
-	RegisterError("EPERM", int(syscall.EPERM))
-	RegisterError("EPFNOSUPPORT", int(syscall.EPFNOSUPPORT))
-	RegisterError("EPIPE", int(syscall.EPIPE))
-	RegisterError("EPROTO", int(syscall.EPROTO))
-	RegisterError("EPROTONOSUPPORT", int(syscall.EPROTONOSUPPORT))
-	RegisterError("EPROTOTYPE", int(syscall.EPROTOTYPE))
-	RegisterError("ERANGE", int(syscall.ERANGE))
-	RegisterError("EREMCHG", int(syscall.EREMCHG))
-	RegisterError("EREMOTE", int(syscall.EREMOTE))
-	RegisterError("EREMOTEIO", int(syscall.EREMOTEIO))
-	RegisterError("ERESTART", int(syscall.ERESTART))
-	RegisterError("ERFKILL", int(syscall.ERFKILL))
-	RegisterError("EROFS", int(syscall.EROFS))
-	RegisterError("ESHUTDOWN", int(syscall.ESHUTDOWN))
-	RegisterError("ESOCKTNOSUPPORT", int(syscall.ESOCKTNOSUPPORT))
-	RegisterError("ESPIPE", int(syscall.ESPIPE))
-	RegisterError("ESRCH", int(syscall.ESRCH))
-	RegisterError("ESRMNT", int(syscall.ESRMNT))
-	RegisterError("ESTALE", int(syscall.ESTALE))
-	RegisterError("ESTRPIPE", int(syscall.ESTRPIPE))
-	RegisterError("ETIME", int(syscall.ETIME))
-	RegisterError("ETIMEDOUT", int(syscall.ETIMEDOUT))
-	RegisterError("ETOOMANYREFS", int(syscall.ETOOMANYREFS))
-	RegisterError("ETXTBSY", int(syscall.ETXTBSY))
-	RegisterError("EUCLEAN", int(syscall.EUCLEAN))
-	RegisterError("EUNATCH", int(syscall.EUNATCH))
-	RegisterError("EUSERS", int(syscall.EUSERS))
-	RegisterError("EWOULDBLOCK", int(syscall.EWOULDBLOCK))
-	RegisterError("EXDEV", int(syscall.EXDEV))
-	RegisterError("EXFULL", int(syscall.EXFULL))
-
-	RegisterConstant("SIGABRT", int(syscall.SIGABRT))
-	RegisterConstant("SIGALRM", int(syscall.SIGALRM))
-	RegisterConstant("SIGBUS", int(syscall.SIGBUS))
-	RegisterConstant("SIGCHLD", int(syscall.SIGCHLD))
-	RegisterConstant("SIGCLD", int(syscall.SIGCLD))
-	RegisterConstant("SIGCONT", int(syscall.SIGCONT))
-	RegisterConstant("SIGFPE", int(syscall.SIGFPE))
-	RegisterConstant("SIGHUP", int(syscall.SIGHUP))
-	RegisterConstant("SIGILL", int(syscall.SIGILL))
-	RegisterConstant("SIGINT", int(syscall.SIGINT))
-	RegisterConstant("SIGIO", int(syscall.SIGIO))
-	RegisterConstant("SIGIOT", int(syscall.SIGIOT))
-	RegisterConstant("SIGKILL", int(syscall.SIGKILL))
-	RegisterConstant("SIGPIPE", int(syscall.SIGPIPE))
-	RegisterConstant("SIGPOLL", int(syscall.SIGPOLL))
-	RegisterConstant("SIGPROF", int(syscall.SIGPROF))
-	RegisterConstant("SIGPWR", int(syscall.SIGPWR))
-	RegisterConstant("SIGQUIT", int(syscall.SIGQUIT))
-	RegisterConstant("SIGSEGV", int(syscall.SIGSEGV))
-	RegisterConstant("SIGSTKFLT", int(syscall.SIGSTKFLT))
-	RegisterConstant("SIGSTOP", int(syscall.SIGSTOP))
-	RegisterConstant("SIGSYS", int(syscall.SIGSYS))
-	RegisterConstant("SIGTERM", int(syscall.SIGTERM))
-	RegisterConstant("SIGTRAP", int(syscall.SIGTRAP))
-	RegisterConstant("SIGTSTP", int(syscall.SIGTSTP))
-	RegisterConstant("SIGTTIN", int(syscall.SIGTTIN))
-	RegisterConstant("SIGTTOU", int(syscall.SIGTTOU))
-	RegisterConstant("SIGUNUSED", int(syscall.SIGUNUSED))
-	RegisterConstant("SIGURG", int(syscall.SIGURG))
-	RegisterConstant("SIGUSR1", int(syscall.SIGUSR1))
-	RegisterConstant("SIGUSR2", int(syscall.SIGUSR2))
-	RegisterConstant("SIGVTALRM", int(syscall.SIGVTALRM))
-	RegisterConstant("SIGWINCH", int(syscall.SIGWINCH))
-	RegisterConstant("SIGXCPU", int(syscall.SIGXCPU))
-	RegisterConstant("SIGXFSZ", int(syscall.SIGXFSZ))
-
-	RegisterSyscall("read", 0)
-	RegisterSyscall("write", 1)
-	RegisterSyscall("open", 2)
-	RegisterSyscall("close", 3)
-	RegisterSyscall("stat", 4)
-	RegisterSyscall("fstat", 5)
-	RegisterSyscall("lstat", 6)
-	RegisterSyscall("poll", 7)
-	RegisterSyscall("lseek", 8)
-	RegisterSyscall("mmap", 9)
-	RegisterSyscall("mprotect", 10)
-	RegisterSyscall("munmap", 11)
-	RegisterSyscall("brk", 12)
-	RegisterSyscall("rt_sigaction", 13)
-	RegisterSyscall("rt_sigprocmask", 14)
-	RegisterSyscall("rt_sigreturn", 15)
-	RegisterSyscall("ioctl", 16)
-	RegisterSyscall("pread64", 17)
-	RegisterSyscall("pwrite64", 18)
-	RegisterSyscall("readv", 19)
-	RegisterSyscall("writev", 20)
-	RegisterSyscall("access", 21)
-	RegisterSyscall("pipe", 22)
-	RegisterSyscall("select", 23)
-	RegisterSyscall("sched_yield", 24)
-	RegisterSyscall("mremap", 25)
-	RegisterSyscall("msync", 26)
-	RegisterSyscall("mincore", 27)
-	RegisterSyscall("madvise", 28)
-	RegisterSyscall("shmget", 29)
-	RegisterSyscall("shmat", 30)
-	RegisterSyscall("shmctl", 31)
-	RegisterSyscall("dup", 32)
-	RegisterSyscall("dup2", 33)
-	RegisterSyscall("pause", 34)
-	RegisterSyscall("nanosleep", 35)
-	RegisterSyscall("getitimer", 36)
-	RegisterSyscall("alarm", 37)
-	RegisterSyscall("setitimer", 38)
-	RegisterSyscall("getpid", 39)
-	RegisterSyscall("sendfile", 40)
-	RegisterSyscall("socket", 41)
-	RegisterSyscall("connect", 42)
-	RegisterSyscall("accept", 43)
-	RegisterSyscall("sendto", 44)
-	RegisterSyscall("recvfrom", 45)
-	RegisterSyscall("sendmsg", 46)
-	RegisterSyscall("recvmsg", 47)
-	RegisterSyscall("shutdown", 48)
-	RegisterSyscall("bind", 49)
-	RegisterSyscall("listen", 50)
-	RegisterSyscall("getsockname", 51)
-	RegisterSyscall("getpeername", 52)
-	RegisterSyscall("socketpair", 53)
-	RegisterSyscall("setsockopt", 54)
-	RegisterSyscall("getsockopt", 55)
-	RegisterSyscall("clone", 56)
-	RegisterSyscall("fork", 57)
-	RegisterSyscall("vfork", 58)
-	RegisterSyscall("execve", 59)
-	RegisterSyscall("exit", 60)
-	RegisterSyscall("wait4", 61)
-	RegisterSyscall("kill", 62)
-	RegisterSyscall("uname", 63)
-	RegisterSyscall("semget", 64)
-	RegisterSyscall("semop", 65)
-	RegisterSyscall("semctl", 66)
-	RegisterSyscall("shmdt", 67)
-	RegisterSyscall("msgget", 68)
-	RegisterSyscall("msgsnd", 69)
-	RegisterSyscall("msgrcv", 70)
-	RegisterSyscall("msgctl", 71)
-	RegisterSyscall("fcntl", 72)
-	RegisterSyscall("flock", 73)
-	RegisterSyscall("fsync", 74)
-	RegisterSyscall("fdatasync", 75)
-	RegisterSyscall("truncate", 76)
-	RegisterSyscall("ftruncate", 77)
-	RegisterSyscall("getdents", 78)
-	RegisterSyscall("getcwd", 79)
-	RegisterSyscall("chdir", 80)
-	RegisterSyscall("fchdir", 81)
-	RegisterSyscall("rename", 82)
-	RegisterSyscall("mkdir", 83)
-	RegisterSyscall("rmdir", 84)
-	RegisterSyscall("creat", 85)
-	RegisterSyscall("link", 86)
-	RegisterSyscall("unlink", 87)
-	RegisterSyscall("symlink", 88)
-	RegisterSyscall("readlink", 89)
-	RegisterSyscall("chmod", 90)
-	RegisterSyscall("fchmod", 91)
-	RegisterSyscall("chown", 92)
-	RegisterSyscall("fchown", 93)
-	RegisterSyscall("lchown", 94)
-	RegisterSyscall("umask", 95)
-	RegisterSyscall("gettimeofday", 96)
-	RegisterSyscall("getrlimit", 97)
-	RegisterSyscall("getrusage", 98)
-	RegisterSyscall("sysinfo", 99)
-	RegisterSyscall("times", 100)
-	RegisterSyscall("ptrace", 101)
-	RegisterSyscall("getuid", 102)
-	RegisterSyscall("syslog", 103)
-	RegisterSyscall("getgid", 104)
-	RegisterSyscall("setuid", 105)
-	RegisterSyscall("setgid", 106)
-	RegisterSyscall("geteuid", 107)
-	RegisterSyscall("getegid", 108)
-	RegisterSyscall("setpgid", 109)
-	RegisterSyscall("getppid", 110)
-	RegisterSyscall("getpgrp", 111)
-	RegisterSyscall("setsid", 112)
-	RegisterSyscall("setreuid", 113)
-	RegisterSyscall("setregid", 114)
-	RegisterSyscall("getgroups", 115)
-	RegisterSyscall("setgroups", 116)
-	RegisterSyscall("setresuid", 117)
-	RegisterSyscall("getresuid", 118)
-	RegisterSyscall("setresgid", 119)
-	RegisterSyscall("getresgid", 120)
-	RegisterSyscall("getpgid", 121)
-	RegisterSyscall("setfsuid", 122)
-	RegisterSyscall("setfsgid", 123)
-	RegisterSyscall("getsid", 124)
-	RegisterSyscall("capget", 125)
-	RegisterSyscall("capset", 126)
-	RegisterSyscall("rt_sigpending", 127)
-	RegisterSyscall("rt_sigtimedwait", 128)
-	RegisterSyscall("rt_sigqueueinfo", 129)
-	RegisterSyscall("rt_sigsuspend", 130)
-	RegisterSyscall("sigaltstack", 131)
-	RegisterSyscall("utime", 132)
-	RegisterSyscall("mknod", 133)
-	RegisterSyscall("uselib", 134)
-	RegisterSyscall("personality", 135)
-	RegisterSyscall("ustat", 136)
-	RegisterSyscall("statfs", 137)
-	RegisterSyscall("fstatfs", 138)
-	RegisterSyscall("sysfs", 139)
-	RegisterSyscall("getpriority", 140)
-	RegisterSyscall("setpriority", 141)
-	RegisterSyscall("sched_setparam", 142)
-	RegisterSyscall("sched_getparam", 143)
-	RegisterSyscall("sched_setscheduler", 144)
-	RegisterSyscall("sched_getscheduler", 145)
-	RegisterSyscall("sched_get_priority_max", 146)
-	RegisterSyscall("sched_get_priority_min", 147)
-	RegisterSyscall("sched_rr_get_interval", 148)
-	RegisterSyscall("mlock", 149)
-	RegisterSyscall("munlock", 150)
-	RegisterSyscall("mlockall", 151)
-	RegisterSyscall("munlockall", 152)
-	RegisterSyscall("vhangup", 153)
-	RegisterSyscall("modify_ldt", 154)
-	RegisterSyscall("pivot_root", 155)
-	RegisterSyscall("_sysctl", 156)
-	RegisterSyscall("prctl", 157)
-	RegisterSyscall("arch_prctl", 158)
-	RegisterSyscall("adjtimex", 159)
-	RegisterSyscall("setrlimit", 160)
-	RegisterSyscall("chroot", 161)
-	RegisterSyscall("sync", 162)
-	RegisterSyscall("acct", 163)
-	RegisterSyscall("settimeofday", 164)
-	RegisterSyscall("mount", 165)
-	RegisterSyscall("umount2", 166)
-	RegisterSyscall("swapon", 167)
-	RegisterSyscall("swapoff", 168)
-	RegisterSyscall("reboot", 169)
-	RegisterSyscall("sethostname", 170)
-	RegisterSyscall("setdomainname", 171)
-	RegisterSyscall("iopl", 172)
-	RegisterSyscall("ioperm", 173)
-	RegisterSyscall("create_module", 174)
-	RegisterSyscall("init_module", 175)
-	RegisterSyscall("delete_module", 176)
-	RegisterSyscall("get_kernel_syms", 177)
-	RegisterSyscall("query_module", 178)
-	RegisterSyscall("quotactl", 179)
-	RegisterSyscall("nfsservctl", 180)
-	RegisterSyscall("getpmsg", 181)
-	RegisterSyscall("putpmsg", 182)
-	RegisterSyscall("afs_syscall", 183)
-	RegisterSyscall("tuxcall", 184)
-	RegisterSyscall("security", 185)
-	RegisterSyscall("gettid", 186)
-	RegisterSyscall("readahead", 187)
-	RegisterSyscall("setxattr", 188)
-	RegisterSyscall("lsetxattr", 189)
-	RegisterSyscall("fsetxattr", 190)
-	RegisterSyscall("getxattr", 191)
-	RegisterSyscall("lgetxattr", 192)
-	RegisterSyscall("fgetxattr", 193)
-	RegisterSyscall("listxattr", 194)
-	RegisterSyscall("llistxattr", 195)
-	RegisterSyscall("flistxattr", 196)
-	RegisterSyscall("removexattr", 197)
-	RegisterSyscall("lremovexattr", 198)
-	RegisterSyscall("fremovexattr", 199)
-	RegisterSyscall("tkill", 200)
-	RegisterSyscall("time", 201)
-	RegisterSyscall("futex", 202)
-	RegisterSyscall("sched_setaffinity", 203)
-	RegisterSyscall("sched_getaffinity", 204)
-	RegisterSyscall("set_thread_area", 205)
-	RegisterSyscall("io_setup", 206)
-	RegisterSyscall("io_destroy", 207)
-	RegisterSyscall("io_getevents", 208)
-	RegisterSyscall("io_submit", 209)
-	RegisterSyscall("io_cancel", 210)
-	RegisterSyscall("get_thread_area", 211)
-	RegisterSyscall("lookup_dcookie", 212)
-	RegisterSyscall("epoll_create", 213)
-	RegisterSyscall("epoll_ctl_old", 214)
-	RegisterSyscall("epoll_wait_old", 215)
-	RegisterSyscall("remap_file_pages", 216)
-	RegisterSyscall("getdents64", 217)
-	RegisterSyscall("set_tid_address", 218)
-	RegisterSyscall("restart_syscall", 219)
-	RegisterSyscall("semtimedop", 220)
-	RegisterSyscall("fadvise64", 221)
-	RegisterSyscall("timer_create", 222)
-	RegisterSyscall("timer_settime", 223)
-	RegisterSyscall("timer_gettime", 224)
-	RegisterSyscall("timer_getoverrun", 225)
-	RegisterSyscall("timer_delete", 226)
-	RegisterSyscall("clock_settime", 227)
-	RegisterSyscall("clock_gettime", 228)
-	RegisterSyscall("clock_getres", 229)
-	RegisterSyscall("clock_nanosleep", 230)
-	RegisterSyscall("exit_group", 231)
-	RegisterSyscall("epoll_wait", 232)
-	RegisterSyscall("epoll_ctl", 233)
-	RegisterSyscall("tgkill", 234)
-	RegisterSyscall("utimes", 235)
-	RegisterSyscall("vserver", 236)
-	RegisterSyscall("mbind", 237)
-	RegisterSyscall("set_mempolicy", 238)
-	RegisterSyscall("get_mempolicy", 239)
-	RegisterSyscall("mq_open", 240)
-	RegisterSyscall("mq_unlink", 241)
-	RegisterSyscall("mq_timedsend", 242)
-	RegisterSyscall("mq_timedreceive", 243)
-	RegisterSyscall("mq_notify", 244)
-	RegisterSyscall("mq_getsetattr", 245)
-	RegisterSyscall("kexec_load", 246)
-	RegisterSyscall("waitid", 247)
-	RegisterSyscall("add_key", 248)
-	RegisterSyscall("request_key", 249)
-	RegisterSyscall("keyctl", 250)
-	RegisterSyscall("ioprio_set", 251)
-	RegisterSyscall("ioprio_get", 252)
-	RegisterSyscall("inotify_init", 253)
-	RegisterSyscall("inotify_add_watch", 254)
-	RegisterSyscall("inotify_rm_watch", 255)
-	RegisterSyscall("migrate_pages", 256)
-	RegisterSyscall("openat", 257)
-	RegisterSyscall("mkdirat", 258)
-	RegisterSyscall("mknodat", 259)
-	RegisterSyscall("fchownat", 260)
-	RegisterSyscall("futimesat", 261)
-	RegisterSyscall("newfstatat", 262)
-	RegisterSyscall("unlinkat", 263)
-	RegisterSyscall("renameat", 264)
-	RegisterSyscall("linkat", 265)
-	RegisterSyscall("symlinkat", 266)
-	RegisterSyscall("readlinkat", 267)
-	RegisterSyscall("fchmodat", 268)
-	RegisterSyscall("faccessat", 269)
-	RegisterSyscall("pselect6", 270)
-	RegisterSyscall("ppoll", 271)
-	RegisterSyscall("unshare", 272)
-	RegisterSyscall("set_robust_list", 273)
-	RegisterSyscall("get_robust_list", 274)
-	RegisterSyscall("splice", 275)
-	RegisterSyscall("tee", 276)
-	RegisterSyscall("sync_file_range", 277)
-	RegisterSyscall("vmsplice", 278)
-	RegisterSyscall("move_pages", 279)
-	RegisterSyscall("utimensat", 280)
-	RegisterSyscall("epoll_pwait", 281)
-	RegisterSyscall("signalfd", 282)
-	RegisterSyscall("timerfd_create", 283)
-	RegisterSyscall("eventfd", 284)
-	RegisterSyscall("fallocate", 285)
-	RegisterSyscall("timerfd_settime", 286)
-	RegisterSyscall("timerfd_gettime", 287)
-	RegisterSyscall("accept4", 288)
-	RegisterSyscall("signalfd4", 289)
-	RegisterSyscall("eventfd2", 290)
-	RegisterSyscall("epoll_create1", 291)
-	RegisterSyscall("dup3", 292)
-	RegisterSyscall("pipe2", 293)
-	RegisterSyscall("inotify_init1", 294)
-	RegisterSyscall("preadv", 295)
-	RegisterSyscall("pwritev", 296)
-	RegisterSyscall("rt_tgsigqueueinfo", 297)
-	RegisterSyscall("perf_event_open", 298)
-	RegisterSyscall("recvmmsg", 299)
-	RegisterSyscall("fanotify_init", 300)
-	RegisterSyscall("fanotify_mark", 301)
-	RegisterSyscall("prlimit64", 302)
-	RegisterSyscall("name_to_handle_at", 303)
-	RegisterSyscall("open_by_handle_at", 304)
-	RegisterSyscall("clock_adjtime", 305)
-	RegisterSyscall("syncfs", 306)
-	RegisterSyscall("sendmmsg", 307)
-	RegisterSyscall("setns", 308)
-	RegisterSyscall("getcpu", 309)
-	RegisterSyscall("process_vm_readv", 310)
-	RegisterSyscall("process_vm_writev", 311)
-	RegisterSyscall("kcmp", 312)
-	RegisterSyscall("finit_module", 313)
-	RegisterSyscall("sched_setattr", 314)
-	RegisterSyscall("sched_getattr", 315)
-	RegisterSyscall("renameat2", 316)
-	RegisterSyscall("seccomp", 317)
-	RegisterSyscall("getrandom", 318)
-	RegisterSyscall("memfd_create", 319)
-	RegisterSyscall("kexec_file_load", 320)
-	RegisterSyscall("bpf", 321)
-	RegisterSyscall("execveat", 322)
+	for _, s := range Native.Syscalls {
+		RegisterSyscall(s.Name, s.Number)
+	}
+	for _, e := range Native.Errors {
+		RegisterError(e.Name, int(e.Value))
+	}
+	for _, c := range Native.Constants {
+		RegisterConstant(c.Name, int(c.Value))
+	}
 }
 
-// GetSyscall returns the syscall number for the given name if it exists
+// GetSyscall returns the syscall number for the given name if it exists. Names created by RawSyscallName
+// always exist, and refer to the syscall with that number
 func GetSyscall(name string) (uint32, bool) {
+	if nr, ok := rawSyscallNumber(name); ok {
+		return nr, true
+	}
 	res, ok := Syscalls[strings.ToLower(name)]
 	return uint32(res), ok
 }
@@ -1749,8 +71,12 @@ func GetError(name string) (uint32, bool) {
 	return uint32(res), ok
 }
 
-// GetConstant returns the constant for the given name if it exists
-func GetConstant(name string) (uint32, bool) {
+// GetConstant returns the constant for the given name if it exists. Negative constants, like AT_FDCWD, are
+// C ints, so they are returned as 32 bit two's complement values
+func GetConstant(name string) (uint64, bool) {
 	res, ok := AllConstants[strings.ToUpper(name)]
-	return uint32(res), ok
+	if res < 0 {
+		return uint64(uint32(res)), ok
+	}
+	return uint64(res), ok
 }
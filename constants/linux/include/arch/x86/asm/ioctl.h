@@ -0,0 +1 @@
+#include <asm-generic/ioctl.h>
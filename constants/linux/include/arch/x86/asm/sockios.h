@@ -0,0 +1 @@
+#include <asm-generic/sockios.h>
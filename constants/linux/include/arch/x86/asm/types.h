@@ -0,0 +1 @@
+#include <asm-generic/types.h>
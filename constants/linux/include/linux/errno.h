@@ -0,0 +1 @@
+#include <asm/errno.h>
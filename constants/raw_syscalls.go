@@ -0,0 +1,28 @@
+package constants
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+var rawSyscallRE = regexp.MustCompile(`^syscall\(([0-9]+)\)$`)
+
+// RawSyscallName returns the name used for a syscall given by number instead of by name. This makes it possible
+// to refer to syscalls that don't have a name in this package yet.
+func RawSyscallName(nr uint32) string {
+	return fmt.Sprintf("syscall(%d)", nr)
+}
+
+// rawSyscallNumber returns the syscall number for a name created by RawSyscallName
+func rawSyscallNumber(name string) (uint32, bool) {
+	match := rawSyscallRE.FindStringSubmatch(name)
+	if match == nil {
+		return 0, false
+	}
+	nr, err := strconv.ParseUint(match[1], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(nr), true
+}
@@ -0,0 +1,97 @@
+package constants
+
+import "strings"
+
+// SyscallGroups contain a mapping from the name of each syscall group to the names in it. The names can
+// be either syscalls or other groups. A group can list syscalls that don't exist on every architecture.
+var SyscallGroups = make(map[string][]string)
+
+// RegisterSyscallGroup puts the given group in the map of all syscall groups. Group names always start with an @
+func RegisterSyscallGroup(name string, syscalls ...string) {
+	SyscallGroups[strings.ToLower(name)] = syscalls
+}
+
+// GetSyscallGroup returns the names of all syscalls in the given group, including the ones from nested groups.
+// Only the syscalls that are defined for the native architecture will be returned.
+func GetSyscallGroup(name string) ([]string, bool) {
+	return Native.GetSyscallGroup(name)
+}
+
+// GetSyscallGroup returns the names of all syscalls in the given group, including the ones from nested groups.
+// Only the syscalls that are defined for the architecture will be returned.
+func (a *Arch) GetSyscallGroup(name string) ([]string, bool) {
+	if _, ok := SyscallGroups[strings.ToLower(name)]; !ok {
+		return nil, false
+	}
+	result := []string{}
+	seen := make(map[string]bool)
+	a.collectSyscallGroup(strings.ToLower(name), seen, &result)
+	return result, true
+}
+
+func (a *Arch) collectSyscallGroup(name string, seen map[string]bool, result *[]string) {
+	if seen[name] {
+		return
+	}
+	seen[name] = true
+	for _, s := range SyscallGroups[name] {
+		s = strings.ToLower(s)
+		if strings.HasPrefix(s, "@") {
+			a.collectSyscallGroup(s, seen, result)
+		} else if _, ok := a.GetSyscall(s); ok && !seen[s] {
+			seen[s] = true
+			*result = append(*result, s)
+		}
+	}
+}
+
+func init() {
+	RegisterSyscallGroup("@basic-io",
+		"_llseek", "close", "dup", "dup2", "dup3", "lseek", "pread64", "preadv", "pwrite64", "pwritev",
+		"read", "readv", "write", "writev")
+
+	RegisterSyscallGroup("@file-system",
+		"access", "chdir", "chmod", "close", "creat", "faccessat", "fallocate", "fchdir", "fchmod", "fchmodat",
+		"fcntl", "fcntl64", "fgetxattr", "flistxattr", "fremovexattr", "fsetxattr", "fstat", "fstat64", "fstatat64",
+		"fstatfs", "fstatfs64", "ftruncate", "ftruncate64", "futimesat", "getcwd", "getdents", "getdents64",
+		"getxattr", "inotify_add_watch", "inotify_init", "inotify_init1", "inotify_rm_watch", "lgetxattr", "link",
+		"linkat", "listxattr", "llistxattr", "lremovexattr", "lsetxattr", "lstat", "lstat64", "mkdir", "mkdirat",
+		"mknod", "mknodat", "mmap", "mmap2", "munmap", "newfstatat", "oldfstat", "oldlstat", "oldstat", "open",
+		"openat", "readlink", "readlinkat", "removexattr", "rename", "renameat", "renameat2", "rmdir", "setxattr",
+		"stat", "stat64", "statfs", "statfs64", "symlink", "symlinkat", "truncate", "truncate64", "unlink",
+		"unlinkat", "utime", "utimensat", "utimes")
+
+	RegisterSyscallGroup("@io-event",
+		"epoll_create", "epoll_create1", "epoll_ctl", "epoll_ctl_old", "epoll_pwait", "epoll_wait",
+		"epoll_wait_old", "eventfd", "eventfd2", "poll", "ppoll", "pselect6", "select")
+
+	RegisterSyscallGroup("@ipc",
+		"ipc", "memfd_create", "mq_getsetattr", "mq_notify", "mq_open", "mq_timedreceive", "mq_timedsend",
+		"mq_unlink", "msgctl", "msgget", "msgrcv", "msgsnd", "pipe", "pipe2", "process_vm_readv",
+		"process_vm_writev", "semctl", "semget", "semop", "semtimedop", "shmat", "shmctl", "shmdt", "shmget")
+
+	RegisterSyscallGroup("@network-io",
+		"accept", "accept4", "bind", "connect", "getpeername", "getsockname", "getsockopt", "listen", "recv",
+		"recvfrom", "recvmmsg", "recvmsg", "send", "sendmmsg", "sendmsg", "sendto", "setsockopt", "shutdown",
+		"socket", "socketcall", "socketpair")
+
+	RegisterSyscallGroup("@process",
+		"arch_prctl", "capget", "clone", "execve", "execveat", "exit", "exit_group", "fork", "getrusage", "kill",
+		"prctl", "rt_sigqueueinfo", "rt_tgsigqueueinfo", "setns", "tgkill", "times", "tkill", "unshare", "vfork",
+		"wait4", "waitid", "waitpid")
+
+	RegisterSyscallGroup("@privileged",
+		"_sysctl", "acct", "adjtimex", "bpf", "capset", "chroot", "clock_adjtime", "clock_settime",
+		"delete_module", "fanotify_init", "finit_module", "init_module", "ioperm", "iopl", "kexec_file_load",
+		"kexec_load", "mount", "nfsservctl", "pivot_root", "quotactl", "reboot", "setdomainname", "setfsgid",
+		"setfsuid", "setgid", "setgroups", "sethostname", "setregid", "setresgid", "setresuid", "setreuid",
+		"settimeofday", "setuid", "swapoff", "swapon", "umount", "umount2", "vhangup")
+
+	RegisterSyscallGroup("@signal",
+		"rt_sigaction", "rt_sigpending", "rt_sigprocmask", "rt_sigsuspend", "rt_sigtimedwait", "sigaction",
+		"sigaltstack", "signal", "signalfd", "signalfd4", "sigpending", "sigprocmask", "sigsuspend")
+
+	RegisterSyscallGroup("@timer",
+		"alarm", "getitimer", "setitimer", "timer_create", "timer_delete", "timer_getoverrun", "timer_gettime",
+		"timer_settime", "timerfd_create", "timerfd_gettime", "timerfd_settime", "times")
+}
@@ -0,0 +1,392 @@
+package constants
+
+import "strings"
+
+// SyscallArgument describes one argument of a syscall
+type SyscallArgument struct {
+	// Name is the name the argument has in the documentation of the syscall
+	Name string
+	// Type is the C type of the argument, as the kernel receives it
+	Type string
+}
+
+// SyscallSignatures contain a mapping from each syscall name to the arguments it takes. The signatures describe
+// the raw syscalls, not the libc wrappers - so for example clone takes its flags first, and rt_sigaction takes
+// the size of the signal set as its last argument. Syscalls that are not implemented by the kernel are not included.
+// The signatures are the ones of x86_64.
+var SyscallSignatures = map[string][]SyscallArgument{
+	"read":                    {{"fd", "int"}, {"buf", "void *"}, {"count", "size_t"}},
+	"write":                   {{"fd", "int"}, {"buf", "const void *"}, {"count", "size_t"}},
+	"open":                    {{"pathname", "const char *"}, {"flags", "int"}, {"mode", "mode_t"}},
+	"close":                   {{"fd", "int"}},
+	"stat":                    {{"pathname", "const char *"}, {"statbuf", "struct stat *"}},
+	"fstat":                   {{"fd", "int"}, {"statbuf", "struct stat *"}},
+	"lstat":                   {{"pathname", "const char *"}, {"statbuf", "struct stat *"}},
+	"poll":                    {{"fds", "struct pollfd *"}, {"nfds", "nfds_t"}, {"timeout", "int"}},
+	"lseek":                   {{"fd", "int"}, {"offset", "off_t"}, {"whence", "int"}},
+	"mmap":                    {{"addr", "void *"}, {"length", "size_t"}, {"prot", "int"}, {"flags", "int"}, {"fd", "int"}, {"offset", "off_t"}},
+	"mprotect":                {{"addr", "void *"}, {"len", "size_t"}, {"prot", "int"}},
+	"munmap":                  {{"addr", "void *"}, {"length", "size_t"}},
+	"brk":                     {{"addr", "void *"}},
+	"rt_sigaction":            {{"signum", "int"}, {"act", "const struct sigaction *"}, {"oldact", "struct sigaction *"}, {"sigsetsize", "size_t"}},
+	"rt_sigprocmask":          {{"how", "int"}, {"set", "const kernel_sigset_t *"}, {"oldset", "kernel_sigset_t *"}, {"sigsetsize", "size_t"}},
+	"rt_sigreturn":            {},
+	"ioctl":                   {{"fd", "int"}, {"request", "unsigned long"}, {"arg", "unsigned long"}},
+	"pread64":                 {{"fd", "int"}, {"buf", "void *"}, {"count", "size_t"}, {"offset", "off_t"}},
+	"pwrite64":                {{"fd", "int"}, {"buf", "const void *"}, {"count", "size_t"}, {"offset", "off_t"}},
+	"readv":                   {{"fd", "int"}, {"iov", "const struct iovec *"}, {"iovcnt", "int"}},
+	"writev":                  {{"fd", "int"}, {"iov", "const struct iovec *"}, {"iovcnt", "int"}},
+	"access":                  {{"pathname", "const char *"}, {"mode", "int"}},
+	"pipe":                    {{"pipefd", "int *"}},
+	"select":                  {{"nfds", "int"}, {"readfds", "fd_set *"}, {"writefds", "fd_set *"}, {"exceptfds", "fd_set *"}, {"timeout", "struct timeval *"}},
+	"sched_yield":             {},
+	"mremap":                  {{"old_address", "void *"}, {"old_size", "size_t"}, {"new_size", "size_t"}, {"flags", "int"}, {"new_address", "void *"}},
+	"msync":                   {{"addr", "void *"}, {"length", "size_t"}, {"flags", "int"}},
+	"mincore":                 {{"addr", "void *"}, {"length", "size_t"}, {"vec", "unsigned char *"}},
+	"madvise":                 {{"addr", "void *"}, {"length", "size_t"}, {"advice", "int"}},
+	"shmget":                  {{"key", "key_t"}, {"size", "size_t"}, {"shmflg", "int"}},
+	"shmat":                   {{"shmid", "int"}, {"shmaddr", "const void *"}, {"shmflg", "int"}},
+	"shmctl":                  {{"shmid", "int"}, {"cmd", "int"}, {"buf", "struct shmid_ds *"}},
+	"dup":                     {{"oldfd", "int"}},
+	"dup2":                    {{"oldfd", "int"}, {"newfd", "int"}},
+	"pause":                   {},
+	"nanosleep":               {{"req", "const struct timespec *"}, {"rem", "struct timespec *"}},
+	"getitimer":               {{"which", "int"}, {"curr_value", "struct itimerval *"}},
+	"alarm":                   {{"seconds", "unsigned int"}},
+	"setitimer":               {{"which", "int"}, {"new_value", "const struct itimerval *"}, {"old_value", "struct itimerval *"}},
+	"getpid":                  {},
+	"sendfile":                {{"out_fd", "int"}, {"in_fd", "int"}, {"offset", "off_t *"}, {"count", "size_t"}},
+	"socket":                  {{"domain", "int"}, {"type", "int"}, {"protocol", "int"}},
+	"connect":                 {{"sockfd", "int"}, {"addr", "const struct sockaddr *"}, {"addrlen", "socklen_t"}},
+	"accept":                  {{"sockfd", "int"}, {"addr", "struct sockaddr *"}, {"addrlen", "socklen_t *"}},
+	"sendto":                  {{"sockfd", "int"}, {"buf", "const void *"}, {"len", "size_t"}, {"flags", "int"}, {"dest_addr", "const struct sockaddr *"}, {"addrlen", "socklen_t"}},
+	"recvfrom":                {{"sockfd", "int"}, {"buf", "void *"}, {"len", "size_t"}, {"flags", "int"}, {"src_addr", "struct sockaddr *"}, {"addrlen", "socklen_t *"}},
+	"sendmsg":                 {{"sockfd", "int"}, {"msg", "const struct msghdr *"}, {"flags", "int"}},
+	"recvmsg":                 {{"sockfd", "int"}, {"msg", "struct msghdr *"}, {"flags", "int"}},
+	"shutdown":                {{"sockfd", "int"}, {"how", "int"}},
+	"bind":                    {{"sockfd", "int"}, {"addr", "const struct sockaddr *"}, {"addrlen", "socklen_t"}},
+	"listen":                  {{"sockfd", "int"}, {"backlog", "int"}},
+	"getsockname":             {{"sockfd", "int"}, {"addr", "struct sockaddr *"}, {"addrlen", "socklen_t *"}},
+	"getpeername":             {{"sockfd", "int"}, {"addr", "struct sockaddr *"}, {"addrlen", "socklen_t *"}},
+	"socketpair":              {{"domain", "int"}, {"type", "int"}, {"protocol", "int"}, {"sv", "int *"}},
+	"setsockopt":              {{"sockfd", "int"}, {"level", "int"}, {"optname", "int"}, {"optval", "const void *"}, {"optlen", "socklen_t"}},
+	"getsockopt":              {{"sockfd", "int"}, {"level", "int"}, {"optname", "int"}, {"optval", "void *"}, {"optlen", "socklen_t *"}},
+	"clone":                   {{"flags", "unsigned long"}, {"stack", "void *"}, {"parent_tid", "int *"}, {"child_tid", "int *"}, {"tls", "unsigned long"}},
+	"fork":                    {},
+	"vfork":                   {},
+	"execve":                  {{"pathname", "const char *"}, {"argv", "char *const *"}, {"envp", "char *const *"}},
+	"exit":                    {{"status", "int"}},
+	"wait4":                   {{"pid", "pid_t"}, {"wstatus", "int *"}, {"options", "int"}, {"rusage", "struct rusage *"}},
+	"kill":                    {{"pid", "pid_t"}, {"sig", "int"}},
+	"uname":                   {{"buf", "struct utsname *"}},
+	"semget":                  {{"key", "key_t"}, {"nsems", "int"}, {"semflg", "int"}},
+	"semop":                   {{"semid", "int"}, {"sops", "struct sembuf *"}, {"nsops", "size_t"}},
+	"semctl":                  {{"semid", "int"}, {"semnum", "int"}, {"cmd", "int"}, {"arg", "unsigned long"}},
+	"shmdt":                   {{"shmaddr", "const void *"}},
+	"msgget":                  {{"key", "key_t"}, {"msgflg", "int"}},
+	"msgsnd":                  {{"msqid", "int"}, {"msgp", "const void *"}, {"msgsz", "size_t"}, {"msgflg", "int"}},
+	"msgrcv":                  {{"msqid", "int"}, {"msgp", "void *"}, {"msgsz", "size_t"}, {"msgtyp", "long"}, {"msgflg", "int"}},
+	"msgctl":                  {{"msqid", "int"}, {"cmd", "int"}, {"buf", "struct msqid_ds *"}},
+	"fcntl":                   {{"fd", "int"}, {"cmd", "int"}, {"arg", "unsigned long"}},
+	"flock":                   {{"fd", "int"}, {"operation", "int"}},
+	"fsync":                   {{"fd", "int"}},
+	"fdatasync":               {{"fd", "int"}},
+	"truncate":                {{"path", "const char *"}, {"length", "off_t"}},
+	"ftruncate":               {{"fd", "int"}, {"length", "off_t"}},
+	"getdents":                {{"fd", "unsigned int"}, {"dirp", "struct linux_dirent *"}, {"count", "unsigned int"}},
+	"getcwd":                  {{"buf", "char *"}, {"size", "size_t"}},
+	"chdir":                   {{"path", "const char *"}},
+	"fchdir":                  {{"fd", "int"}},
+	"rename":                  {{"oldpath", "const char *"}, {"newpath", "const char *"}},
+	"mkdir":                   {{"pathname", "const char *"}, {"mode", "mode_t"}},
+	"rmdir":                   {{"pathname", "const char *"}},
+	"creat":                   {{"pathname", "const char *"}, {"mode", "mode_t"}},
+	"link":                    {{"oldpath", "const char *"}, {"newpath", "const char *"}},
+	"unlink":                  {{"pathname", "const char *"}},
+	"symlink":                 {{"target", "const char *"}, {"linkpath", "const char *"}},
+	"readlink":                {{"pathname", "const char *"}, {"buf", "char *"}, {"bufsiz", "size_t"}},
+	"chmod":                   {{"pathname", "const char *"}, {"mode", "mode_t"}},
+	"fchmod":                  {{"fd", "int"}, {"mode", "mode_t"}},
+	"chown":                   {{"pathname", "const char *"}, {"owner", "uid_t"}, {"group", "gid_t"}},
+	"fchown":                  {{"fd", "int"}, {"owner", "uid_t"}, {"group", "gid_t"}},
+	"lchown":                  {{"pathname", "const char *"}, {"owner", "uid_t"}, {"group", "gid_t"}},
+	"umask":                   {{"mask", "mode_t"}},
+	"gettimeofday":            {{"tv", "struct timeval *"}, {"tz", "struct timezone *"}},
+	"getrlimit":               {{"resource", "int"}, {"rlim", "struct rlimit *"}},
+	"getrusage":               {{"who", "int"}, {"usage", "struct rusage *"}},
+	"sysinfo":                 {{"info", "struct sysinfo *"}},
+	"times":                   {{"buf", "struct tms *"}},
+	"ptrace":                  {{"request", "enum __ptrace_request"}, {"pid", "pid_t"}, {"addr", "void *"}, {"data", "void *"}},
+	"getuid":                  {},
+	"syslog":                  {{"type", "int"}, {"bufp", "char *"}, {"len", "int"}},
+	"getgid":                  {},
+	"setuid":                  {{"uid", "uid_t"}},
+	"setgid":                  {{"gid", "gid_t"}},
+	"geteuid":                 {},
+	"getegid":                 {},
+	"setpgid":                 {{"pid", "pid_t"}, {"pgid", "pid_t"}},
+	"getppid":                 {},
+	"getpgrp":                 {},
+	"setsid":                  {},
+	"setreuid":                {{"ruid", "uid_t"}, {"euid", "uid_t"}},
+	"setregid":                {{"rgid", "gid_t"}, {"egid", "gid_t"}},
+	"getgroups":               {{"size", "int"}, {"list", "gid_t *"}},
+	"setgroups":               {{"size", "size_t"}, {"list", "const gid_t *"}},
+	"setresuid":               {{"ruid", "uid_t"}, {"euid", "uid_t"}, {"suid", "uid_t"}},
+	"getresuid":               {{"ruid", "uid_t *"}, {"euid", "uid_t *"}, {"suid", "uid_t *"}},
+	"setresgid":               {{"rgid", "gid_t"}, {"egid", "gid_t"}, {"sgid", "gid_t"}},
+	"getresgid":               {{"rgid", "gid_t *"}, {"egid", "gid_t *"}, {"sgid", "gid_t *"}},
+	"getpgid":                 {{"pid", "pid_t"}},
+	"setfsuid":                {{"fsuid", "uid_t"}},
+	"setfsgid":                {{"fsgid", "gid_t"}},
+	"getsid":                  {{"pid", "pid_t"}},
+	"capget":                  {{"hdrp", "cap_user_header_t"}, {"datap", "cap_user_data_t"}},
+	"capset":                  {{"hdrp", "cap_user_header_t"}, {"datap", "const cap_user_data_t"}},
+	"rt_sigpending":           {{"set", "sigset_t *"}, {"sigsetsize", "size_t"}},
+	"rt_sigtimedwait":         {{"set", "const sigset_t *"}, {"info", "siginfo_t *"}, {"timeout", "const struct timespec *"}, {"sigsetsize", "size_t"}},
+	"rt_sigqueueinfo":         {{"tgid", "pid_t"}, {"sig", "int"}, {"info", "siginfo_t *"}},
+	"rt_sigsuspend":           {{"mask", "const sigset_t *"}, {"sigsetsize", "size_t"}},
+	"sigaltstack":             {{"ss", "const stack_t *"}, {"old_ss", "stack_t *"}},
+	"utime":                   {{"filename", "const char *"}, {"times", "const struct utimbuf *"}},
+	"mknod":                   {{"pathname", "const char *"}, {"mode", "mode_t"}, {"dev", "dev_t"}},
+	"uselib":                  {{"library", "const char *"}},
+	"personality":             {{"persona", "unsigned long"}},
+	"ustat":                   {{"dev", "dev_t"}, {"ubuf", "struct ustat *"}},
+	"statfs":                  {{"path", "const char *"}, {"buf", "struct statfs *"}},
+	"fstatfs":                 {{"fd", "int"}, {"buf", "struct statfs *"}},
+	"sysfs":                   {{"option", "int"}, {"fs_index", "unsigned int"}, {"buf", "char *"}},
+	"getpriority":             {{"which", "int"}, {"who", "id_t"}},
+	"setpriority":             {{"which", "int"}, {"who", "id_t"}, {"prio", "int"}},
+	"sched_setparam":          {{"pid", "pid_t"}, {"param", "const struct sched_param *"}},
+	"sched_getparam":          {{"pid", "pid_t"}, {"param", "struct sched_param *"}},
+	"sched_setscheduler":      {{"pid", "pid_t"}, {"policy", "int"}, {"param", "const struct sched_param *"}},
+	"sched_getscheduler":      {{"pid", "pid_t"}},
+	"sched_get_priority_max":  {{"policy", "int"}},
+	"sched_get_priority_min":  {{"policy", "int"}},
+	"sched_rr_get_interval":   {{"pid", "pid_t"}, {"tp", "struct timespec *"}},
+	"mlock":                   {{"addr", "const void *"}, {"len", "size_t"}},
+	"munlock":                 {{"addr", "const void *"}, {"len", "size_t"}},
+	"mlockall":                {{"flags", "int"}},
+	"munlockall":              {},
+	"vhangup":                 {},
+	"modify_ldt":              {{"func", "int"}, {"ptr", "void *"}, {"bytecount", "unsigned long"}},
+	"pivot_root":              {{"new_root", "const char *"}, {"put_old", "const char *"}},
+	"_sysctl":                 {{"args", "struct __sysctl_args *"}},
+	"prctl":                   {{"option", "int"}, {"arg2", "unsigned long"}, {"arg3", "unsigned long"}, {"arg4", "unsigned long"}, {"arg5", "unsigned long"}},
+	"arch_prctl":              {{"code", "int"}, {"addr", "unsigned long"}},
+	"adjtimex":                {{"buf", "struct timex *"}},
+	"setrlimit":               {{"resource", "int"}, {"rlim", "const struct rlimit *"}},
+	"chroot":                  {{"path", "const char *"}},
+	"sync":                    {},
+	"acct":                    {{"filename", "const char *"}},
+	"settimeofday":            {{"tv", "const struct timeval *"}, {"tz", "const struct timezone *"}},
+	"mount":                   {{"source", "const char *"}, {"target", "const char *"}, {"filesystemtype", "const char *"}, {"mountflags", "unsigned long"}, {"data", "const void *"}},
+	"umount2":                 {{"target", "const char *"}, {"flags", "int"}},
+	"swapon":                  {{"path", "const char *"}, {"swapflags", "int"}},
+	"swapoff":                 {{"path", "const char *"}},
+	"reboot":                  {{"magic", "int"}, {"magic2", "int"}, {"cmd", "int"}, {"arg", "void *"}},
+	"sethostname":             {{"name", "const char *"}, {"len", "size_t"}},
+	"setdomainname":           {{"name", "const char *"}, {"len", "size_t"}},
+	"iopl":                    {{"level", "int"}},
+	"ioperm":                  {{"from", "unsigned long"}, {"num", "unsigned long"}, {"turn_on", "int"}},
+	"create_module":           {{"name", "const char *"}, {"size", "size_t"}},
+	"init_module":             {{"module_image", "void *"}, {"len", "unsigned long"}, {"param_values", "const char *"}},
+	"delete_module":           {{"name", "const char *"}, {"flags", "unsigned int"}},
+	"get_kernel_syms":         {{"table", "struct kernel_sym *"}},
+	"query_module":            {{"name", "const char *"}, {"which", "int"}, {"buf", "void *"}, {"bufsize", "size_t"}, {"ret", "size_t *"}},
+	"quotactl":                {{"cmd", "int"}, {"special", "const char *"}, {"id", "int"}, {"addr", "caddr_t"}},
+	"nfsservctl":              {{"cmd", "int"}, {"argp", "struct nfsctl_arg *"}, {"resp", "union nfsctl_res *"}},
+	"gettid":                  {},
+	"readahead":               {{"fd", "int"}, {"offset", "off64_t"}, {"count", "size_t"}},
+	"setxattr":                {{"path", "const char *"}, {"name", "const char *"}, {"value", "const void *"}, {"size", "size_t"}, {"flags", "int"}},
+	"lsetxattr":               {{"path", "const char *"}, {"name", "const char *"}, {"value", "const void *"}, {"size", "size_t"}, {"flags", "int"}},
+	"fsetxattr":               {{"fd", "int"}, {"name", "const char *"}, {"value", "const void *"}, {"size", "size_t"}, {"flags", "int"}},
+	"getxattr":                {{"path", "const char *"}, {"name", "const char *"}, {"value", "void *"}, {"size", "size_t"}},
+	"lgetxattr":               {{"path", "const char *"}, {"name", "const char *"}, {"value", "void *"}, {"size", "size_t"}},
+	"fgetxattr":               {{"fd", "int"}, {"name", "const char *"}, {"value", "void *"}, {"size", "size_t"}},
+	"listxattr":               {{"path", "const char *"}, {"list", "char *"}, {"size", "size_t"}},
+	"llistxattr":              {{"path", "const char *"}, {"list", "char *"}, {"size", "size_t"}},
+	"flistxattr":              {{"fd", "int"}, {"list", "char *"}, {"size", "size_t"}},
+	"removexattr":             {{"path", "const char *"}, {"name", "const char *"}},
+	"lremovexattr":            {{"path", "const char *"}, {"name", "const char *"}},
+	"fremovexattr":            {{"fd", "int"}, {"name", "const char *"}},
+	"tkill":                   {{"tid", "pid_t"}, {"sig", "int"}},
+	"time":                    {{"tloc", "time_t *"}},
+	"futex":                   {{"uaddr", "uint32_t *"}, {"futex_op", "int"}, {"val", "uint32_t"}, {"timeout", "const struct timespec *"}, {"uaddr2", "uint32_t *"}, {"val3", "uint32_t"}},
+	"sched_setaffinity":       {{"pid", "pid_t"}, {"cpusetsize", "size_t"}, {"mask", "const cpu_set_t *"}},
+	"sched_getaffinity":       {{"pid", "pid_t"}, {"cpusetsize", "size_t"}, {"mask", "cpu_set_t *"}},
+	"set_thread_area":         {{"u_info", "struct user_desc *"}},
+	"io_setup":                {{"nr_events", "unsigned int"}, {"ctx_idp", "aio_context_t *"}},
+	"io_destroy":              {{"ctx_id", "aio_context_t"}},
+	"io_getevents":            {{"ctx_id", "aio_context_t"}, {"min_nr", "long"}, {"nr", "long"}, {"events", "struct io_event *"}, {"timeout", "struct timespec *"}},
+	"io_submit":               {{"ctx_id", "aio_context_t"}, {"nr", "long"}, {"iocbpp", "struct iocb **"}},
+	"io_cancel":               {{"ctx_id", "aio_context_t"}, {"iocb", "struct iocb *"}, {"result", "struct io_event *"}},
+	"get_thread_area":         {{"u_info", "struct user_desc *"}},
+	"lookup_dcookie":          {{"cookie", "uint64_t"}, {"buffer", "char *"}, {"len", "size_t"}},
+	"epoll_create":            {{"size", "int"}},
+	"remap_file_pages":        {{"addr", "void *"}, {"size", "size_t"}, {"prot", "int"}, {"pgoff", "size_t"}, {"flags", "int"}},
+	"getdents64":              {{"fd", "int"}, {"dirp", "void *"}, {"count", "size_t"}},
+	"set_tid_address":         {{"tidptr", "int *"}},
+	"restart_syscall":         {},
+	"semtimedop":              {{"semid", "int"}, {"sops", "struct sembuf *"}, {"nsops", "size_t"}, {"timeout", "const struct timespec *"}},
+	"fadvise64":               {{"fd", "int"}, {"offset", "off_t"}, {"len", "off_t"}, {"advice", "int"}},
+	"timer_create":            {{"clockid", "clockid_t"}, {"sevp", "struct sigevent *"}, {"timerid", "timer_t *"}},
+	"timer_settime":           {{"timerid", "timer_t"}, {"flags", "int"}, {"new_value", "const struct itimerspec *"}, {"old_value", "struct itimerspec *"}},
+	"timer_gettime":           {{"timerid", "timer_t"}, {"curr_value", "struct itimerspec *"}},
+	"timer_getoverrun":        {{"timerid", "timer_t"}},
+	"timer_delete":            {{"timerid", "timer_t"}},
+	"clock_settime":           {{"clockid", "clockid_t"}, {"tp", "const struct timespec *"}},
+	"clock_gettime":           {{"clockid", "clockid_t"}, {"tp", "struct timespec *"}},
+	"clock_getres":            {{"clockid", "clockid_t"}, {"res", "struct timespec *"}},
+	"clock_nanosleep":         {{"clockid", "clockid_t"}, {"flags", "int"}, {"request", "const struct timespec *"}, {"remain", "struct timespec *"}},
+	"exit_group":              {{"status", "int"}},
+	"epoll_wait":              {{"epfd", "int"}, {"events", "struct epoll_event *"}, {"maxevents", "int"}, {"timeout", "int"}},
+	"epoll_ctl":               {{"epfd", "int"}, {"op", "int"}, {"fd", "int"}, {"event", "struct epoll_event *"}},
+	"tgkill":                  {{"tgid", "pid_t"}, {"tid", "pid_t"}, {"sig", "int"}},
+	"utimes":                  {{"filename", "const char *"}, {"times", "const struct timeval *"}},
+	"mbind":                   {{"addr", "void *"}, {"len", "unsigned long"}, {"mode", "int"}, {"nodemask", "const unsigned long *"}, {"maxnode", "unsigned long"}, {"flags", "unsigned int"}},
+	"set_mempolicy":           {{"mode", "int"}, {"nodemask", "const unsigned long *"}, {"maxnode", "unsigned long"}},
+	"get_mempolicy":           {{"mode", "int *"}, {"nodemask", "unsigned long *"}, {"maxnode", "unsigned long"}, {"addr", "void *"}, {"flags", "unsigned long"}},
+	"mq_open":                 {{"name", "const char *"}, {"oflag", "int"}, {"mode", "mode_t"}, {"attr", "struct mq_attr *"}},
+	"mq_unlink":               {{"name", "const char *"}},
+	"mq_timedsend":            {{"mqdes", "mqd_t"}, {"msg_ptr", "const char *"}, {"msg_len", "size_t"}, {"msg_prio", "unsigned int"}, {"abs_timeout", "const struct timespec *"}},
+	"mq_timedreceive":         {{"mqdes", "mqd_t"}, {"msg_ptr", "char *"}, {"msg_len", "size_t"}, {"msg_prio", "unsigned int *"}, {"abs_timeout", "const struct timespec *"}},
+	"mq_notify":               {{"mqdes", "mqd_t"}, {"sevp", "const struct sigevent *"}},
+	"mq_getsetattr":           {{"mqdes", "mqd_t"}, {"newattr", "const struct mq_attr *"}, {"oldattr", "struct mq_attr *"}},
+	"kexec_load":              {{"entry", "unsigned long"}, {"nr_segments", "unsigned long"}, {"segments", "struct kexec_segment *"}, {"flags", "unsigned long"}},
+	"waitid":                  {{"idtype", "idtype_t"}, {"id", "id_t"}, {"infop", "siginfo_t *"}, {"options", "int"}, {"rusage", "struct rusage *"}},
+	"add_key":                 {{"type", "const char *"}, {"description", "const char *"}, {"payload", "const void *"}, {"plen", "size_t"}, {"keyring", "key_serial_t"}},
+	"request_key":             {{"type", "const char *"}, {"description", "const char *"}, {"callout_info", "const char *"}, {"dest_keyring", "key_serial_t"}},
+	"keyctl":                  {{"operation", "int"}, {"arg2", "unsigned long"}, {"arg3", "unsigned long"}, {"arg4", "unsigned long"}, {"arg5", "unsigned long"}},
+	"ioprio_set":              {{"which", "int"}, {"who", "int"}, {"ioprio", "int"}},
+	"ioprio_get":              {{"which", "int"}, {"who", "int"}},
+	"inotify_init":            {},
+	"inotify_add_watch":       {{"fd", "int"}, {"pathname", "const char *"}, {"mask", "uint32_t"}},
+	"inotify_rm_watch":        {{"fd", "int"}, {"wd", "int"}},
+	"migrate_pages":           {{"pid", "int"}, {"maxnode", "unsigned long"}, {"old_nodes", "const unsigned long *"}, {"new_nodes", "const unsigned long *"}},
+	"openat":                  {{"dirfd", "int"}, {"pathname", "const char *"}, {"flags", "int"}, {"mode", "mode_t"}},
+	"mkdirat":                 {{"dirfd", "int"}, {"pathname", "const char *"}, {"mode", "mode_t"}},
+	"mknodat":                 {{"dirfd", "int"}, {"pathname", "const char *"}, {"mode", "mode_t"}, {"dev", "dev_t"}},
+	"fchownat":                {{"dirfd", "int"}, {"pathname", "const char *"}, {"owner", "uid_t"}, {"group", "gid_t"}, {"flags", "int"}},
+	"futimesat":               {{"dirfd", "int"}, {"pathname", "const char *"}, {"times", "const struct timeval *"}},
+	"newfstatat":              {{"dirfd", "int"}, {"pathname", "const char *"}, {"statbuf", "struct stat *"}, {"flags", "int"}},
+	"unlinkat":                {{"dirfd", "int"}, {"pathname", "const char *"}, {"flags", "int"}},
+	"renameat":                {{"olddirfd", "int"}, {"oldpath", "const char *"}, {"newdirfd", "int"}, {"newpath", "const char *"}},
+	"linkat":                  {{"olddirfd", "int"}, {"oldpath", "const char *"}, {"newdirfd", "int"}, {"newpath", "const char *"}, {"flags", "int"}},
+	"symlinkat":               {{"target", "const char *"}, {"newdirfd", "int"}, {"linkpath", "const char *"}},
+	"readlinkat":              {{"dirfd", "int"}, {"pathname", "const char *"}, {"buf", "char *"}, {"bufsiz", "size_t"}},
+	"fchmodat":                {{"dirfd", "int"}, {"pathname", "const char *"}, {"mode", "mode_t"}, {"flags", "int"}},
+	"faccessat":               {{"dirfd", "int"}, {"pathname", "const char *"}, {"mode", "int"}, {"flags", "int"}},
+	"pselect6":                {{"nfds", "int"}, {"readfds", "fd_set *"}, {"writefds", "fd_set *"}, {"exceptfds", "fd_set *"}, {"timeout", "const struct timespec *"}, {"sigmask", "void *"}},
+	"ppoll":                   {{"fds", "struct pollfd *"}, {"nfds", "nfds_t"}, {"tmo_p", "const struct timespec *"}, {"sigmask", "const sigset_t *"}, {"sigsetsize", "size_t"}},
+	"unshare":                 {{"flags", "int"}},
+	"set_robust_list":         {{"head", "struct robust_list_head *"}, {"len", "size_t"}},
+	"get_robust_list":         {{"pid", "int"}, {"head_ptr", "struct robust_list_head **"}, {"len_ptr", "size_t *"}},
+	"splice":                  {{"fd_in", "int"}, {"off_in", "off64_t *"}, {"fd_out", "int"}, {"off_out", "off64_t *"}, {"len", "size_t"}, {"flags", "unsigned int"}},
+	"tee":                     {{"fd_in", "int"}, {"fd_out", "int"}, {"len", "size_t"}, {"flags", "unsigned int"}},
+	"sync_file_range":         {{"fd", "int"}, {"offset", "off64_t"}, {"nbytes", "off64_t"}, {"flags", "unsigned int"}},
+	"vmsplice":                {{"fd", "int"}, {"iov", "const struct iovec *"}, {"nr_segs", "size_t"}, {"flags", "unsigned int"}},
+	"move_pages":              {{"pid", "int"}, {"count", "unsigned long"}, {"pages", "void **"}, {"nodes", "const int *"}, {"status", "int *"}, {"flags", "int"}},
+	"utimensat":               {{"dirfd", "int"}, {"pathname", "const char *"}, {"times", "const struct timespec *"}, {"flags", "int"}},
+	"epoll_pwait":             {{"epfd", "int"}, {"events", "struct epoll_event *"}, {"maxevents", "int"}, {"timeout", "int"}, {"sigmask", "const sigset_t *"}, {"sigsetsize", "size_t"}},
+	"signalfd":                {{"fd", "int"}, {"mask", "const sigset_t *"}, {"sizemask", "size_t"}},
+	"timerfd_create":          {{"clockid", "int"}, {"flags", "int"}},
+	"eventfd":                 {{"initval", "unsigned int"}},
+	"fallocate":               {{"fd", "int"}, {"mode", "int"}, {"offset", "off_t"}, {"len", "off_t"}},
+	"timerfd_settime":         {{"fd", "int"}, {"flags", "int"}, {"new_value", "const struct itimerspec *"}, {"old_value", "struct itimerspec *"}},
+	"timerfd_gettime":         {{"fd", "int"}, {"curr_value", "struct itimerspec *"}},
+	"accept4":                 {{"sockfd", "int"}, {"addr", "struct sockaddr *"}, {"addrlen", "socklen_t *"}, {"flags", "int"}},
+	"signalfd4":               {{"fd", "int"}, {"mask", "const sigset_t *"}, {"sizemask", "size_t"}, {"flags", "int"}},
+	"eventfd2":                {{"initval", "unsigned int"}, {"flags", "int"}},
+	"epoll_create1":           {{"flags", "int"}},
+	"dup3":                    {{"oldfd", "int"}, {"newfd", "int"}, {"flags", "int"}},
+	"pipe2":                   {{"pipefd", "int *"}, {"flags", "int"}},
+	"inotify_init1":           {{"flags", "int"}},
+	"preadv":                  {{"fd", "int"}, {"iov", "const struct iovec *"}, {"iovcnt", "int"}, {"offset", "off_t"}},
+	"pwritev":                 {{"fd", "int"}, {"iov", "const struct iovec *"}, {"iovcnt", "int"}, {"offset", "off_t"}},
+	"rt_tgsigqueueinfo":       {{"tgid", "pid_t"}, {"tid", "pid_t"}, {"sig", "int"}, {"info", "siginfo_t *"}},
+	"perf_event_open":         {{"attr", "struct perf_event_attr *"}, {"pid", "pid_t"}, {"cpu", "int"}, {"group_fd", "int"}, {"flags", "unsigned long"}},
+	"recvmmsg":                {{"sockfd", "int"}, {"msgvec", "struct mmsghdr *"}, {"vlen", "unsigned int"}, {"flags", "int"}, {"timeout", "struct timespec *"}},
+	"fanotify_init":           {{"flags", "unsigned int"}, {"event_f_flags", "unsigned int"}},
+	"fanotify_mark":           {{"fanotify_fd", "int"}, {"flags", "unsigned int"}, {"mask", "uint64_t"}, {"dirfd", "int"}, {"pathname", "const char *"}},
+	"prlimit64":               {{"pid", "pid_t"}, {"resource", "int"}, {"new_limit", "const struct rlimit *"}, {"old_limit", "struct rlimit *"}},
+	"name_to_handle_at":       {{"dirfd", "int"}, {"pathname", "const char *"}, {"handle", "struct file_handle *"}, {"mount_id", "int *"}, {"flags", "int"}},
+	"open_by_handle_at":       {{"mount_fd", "int"}, {"handle", "struct file_handle *"}, {"flags", "int"}},
+	"clock_adjtime":           {{"clk_id", "clockid_t"}, {"buf", "struct timex *"}},
+	"syncfs":                  {{"fd", "int"}},
+	"sendmmsg":                {{"sockfd", "int"}, {"msgvec", "struct mmsghdr *"}, {"vlen", "unsigned int"}, {"flags", "int"}},
+	"setns":                   {{"fd", "int"}, {"nstype", "int"}},
+	"getcpu":                  {{"cpu", "unsigned int *"}, {"node", "unsigned int *"}, {"tcache", "void *"}},
+	"process_vm_readv":        {{"pid", "pid_t"}, {"local_iov", "const struct iovec *"}, {"liovcnt", "unsigned long"}, {"remote_iov", "const struct iovec *"}, {"riovcnt", "unsigned long"}, {"flags", "unsigned long"}},
+	"process_vm_writev":       {{"pid", "pid_t"}, {"local_iov", "const struct iovec *"}, {"liovcnt", "unsigned long"}, {"remote_iov", "const struct iovec *"}, {"riovcnt", "unsigned long"}, {"flags", "unsigned long"}},
+	"kcmp":                    {{"pid1", "pid_t"}, {"pid2", "pid_t"}, {"type", "int"}, {"idx1", "unsigned long"}, {"idx2", "unsigned long"}},
+	"finit_module":            {{"fd", "int"}, {"param_values", "const char *"}, {"flags", "int"}},
+	"sched_setattr":           {{"pid", "pid_t"}, {"attr", "struct sched_attr *"}, {"flags", "unsigned int"}},
+	"sched_getattr":           {{"pid", "pid_t"}, {"attr", "struct sched_attr *"}, {"size", "unsigned int"}, {"flags", "unsigned int"}},
+	"renameat2":               {{"olddirfd", "int"}, {"oldpath", "const char *"}, {"newdirfd", "int"}, {"newpath", "const char *"}, {"flags", "unsigned int"}},
+	"seccomp":                 {{"operation", "unsigned int"}, {"flags", "unsigned int"}, {"args", "void *"}},
+	"getrandom":               {{"buf", "void *"}, {"buflen", "size_t"}, {"flags", "unsigned int"}},
+	"memfd_create":            {{"name", "const char *"}, {"flags", "unsigned int"}},
+	"kexec_file_load":         {{"kernel_fd", "int"}, {"initrd_fd", "int"}, {"cmdline_len", "unsigned long"}, {"cmdline", "const char *"}, {"flags", "unsigned long"}},
+	"bpf":                     {{"cmd", "int"}, {"attr", "union bpf_attr *"}, {"size", "unsigned int"}},
+	"execveat":                {{"dirfd", "int"}, {"pathname", "const char *"}, {"argv", "char *const *"}, {"envp", "char *const *"}, {"flags", "int"}},
+	"userfaultfd":             {{"flags", "int"}},
+	"membarrier":              {{"cmd", "int"}, {"flags", "unsigned int"}, {"cpu_id", "int"}},
+	"mlock2":                  {{"addr", "const void *"}, {"len", "size_t"}, {"flags", "unsigned int"}},
+	"copy_file_range":         {{"fd_in", "int"}, {"off_in", "loff_t *"}, {"fd_out", "int"}, {"off_out", "loff_t *"}, {"len", "size_t"}, {"flags", "unsigned int"}},
+	"preadv2":                 {{"fd", "int"}, {"iov", "const struct iovec *"}, {"iovcnt", "int"}, {"pos_l", "unsigned long"}, {"pos_h", "unsigned long"}, {"flags", "int"}},
+	"pwritev2":                {{"fd", "int"}, {"iov", "const struct iovec *"}, {"iovcnt", "int"}, {"pos_l", "unsigned long"}, {"pos_h", "unsigned long"}, {"flags", "int"}},
+	"pkey_mprotect":           {{"addr", "void *"}, {"len", "size_t"}, {"prot", "int"}, {"pkey", "int"}},
+	"pkey_alloc":              {{"flags", "unsigned int"}, {"access_rights", "unsigned int"}},
+	"pkey_free":               {{"pkey", "int"}},
+	"statx":                   {{"dirfd", "int"}, {"pathname", "const char *"}, {"flags", "int"}, {"mask", "unsigned int"}, {"statxbuf", "struct statx *"}},
+	"io_pgetevents":           {{"ctx_id", "aio_context_t"}, {"min_nr", "long"}, {"nr", "long"}, {"events", "struct io_event *"}, {"timeout", "struct timespec *"}, {"usig", "const struct __aio_sigset *"}},
+	"rseq":                    {{"rseq", "struct rseq *"}, {"rseq_len", "uint32_t"}, {"flags", "int"}, {"sig", "uint32_t"}},
+	"pidfd_send_signal":       {{"pidfd", "int"}, {"sig", "int"}, {"info", "siginfo_t *"}, {"flags", "unsigned int"}},
+	"io_uring_setup":          {{"entries", "uint32_t"}, {"p", "struct io_uring_params *"}},
+	"io_uring_enter":          {{"fd", "unsigned int"}, {"to_submit", "unsigned int"}, {"min_complete", "unsigned int"}, {"flags", "unsigned int"}, {"argp", "const void *"}, {"argsz", "size_t"}},
+	"io_uring_register":       {{"fd", "unsigned int"}, {"opcode", "unsigned int"}, {"arg", "void *"}, {"nr_args", "unsigned int"}},
+	"open_tree":               {{"dirfd", "int"}, {"pathname", "const char *"}, {"flags", "unsigned int"}},
+	"move_mount":              {{"from_dirfd", "int"}, {"from_pathname", "const char *"}, {"to_dirfd", "int"}, {"to_pathname", "const char *"}, {"flags", "unsigned int"}},
+	"fsopen":                  {{"fsname", "const char *"}, {"flags", "unsigned int"}},
+	"fsconfig":                {{"fd", "int"}, {"cmd", "unsigned int"}, {"key", "const char *"}, {"value", "const void *"}, {"aux", "int"}},
+	"fsmount":                 {{"fd", "int"}, {"flags", "unsigned int"}, {"attr_flags", "unsigned int"}},
+	"fspick":                  {{"dirfd", "int"}, {"pathname", "const char *"}, {"flags", "unsigned int"}},
+	"pidfd_open":              {{"pid", "pid_t"}, {"flags", "unsigned int"}},
+	"clone3":                  {{"cl_args", "struct clone_args *"}, {"size", "size_t"}},
+	"close_range":             {{"first", "unsigned int"}, {"last", "unsigned int"}, {"flags", "unsigned int"}},
+	"openat2":                 {{"dirfd", "int"}, {"pathname", "const char *"}, {"how", "struct open_how *"}, {"size", "size_t"}},
+	"pidfd_getfd":             {{"pidfd", "int"}, {"targetfd", "int"}, {"flags", "unsigned int"}},
+	"faccessat2":              {{"dirfd", "int"}, {"pathname", "const char *"}, {"mode", "int"}, {"flags", "int"}},
+	"process_madvise":         {{"pidfd", "int"}, {"iovec", "const struct iovec *"}, {"vlen", "size_t"}, {"advice", "int"}, {"flags", "unsigned int"}},
+	"epoll_pwait2":            {{"epfd", "int"}, {"events", "struct epoll_event *"}, {"maxevents", "int"}, {"timeout", "const struct timespec *"}, {"sigmask", "const sigset_t *"}, {"sigsetsize", "size_t"}},
+	"mount_setattr":           {{"dirfd", "int"}, {"pathname", "const char *"}, {"flags", "unsigned int"}, {"attr", "struct mount_attr *"}, {"size", "size_t"}},
+	"quotactl_fd":             {{"fd", "unsigned int"}, {"cmd", "unsigned int"}, {"id", "id_t"}, {"addr", "void *"}},
+	"landlock_create_ruleset": {{"attr", "const struct landlock_ruleset_attr *"}, {"size", "size_t"}, {"flags", "uint32_t"}},
+	"landlock_add_rule":       {{"ruleset_fd", "int"}, {"rule_type", "enum landlock_rule_type"}, {"rule_attr", "const void *"}, {"flags", "uint32_t"}},
+	"landlock_restrict_self":  {{"ruleset_fd", "int"}, {"flags", "uint32_t"}},
+	"memfd_secret":            {{"flags", "unsigned int"}},
+	"process_mrelease":        {{"pidfd", "int"}, {"flags", "unsigned int"}},
+	"futex_waitv":             {{"waiters", "struct futex_waitv *"}, {"nr_futexes", "unsigned int"}, {"flags", "unsigned int"}, {"timeout", "struct timespec *"}, {"clockid", "clockid_t"}},
+	"set_mempolicy_home_node": {{"start", "unsigned long"}, {"len", "unsigned long"}, {"home_node", "unsigned long"}, {"flags", "unsigned long"}},
+}
+
+// GetSyscallSignature returns the arguments of the given syscall, if they are known. Names created by RawSyscallName
+// have the signature of the syscall with that number
+func GetSyscallSignature(name string) ([]SyscallArgument, bool) {
+	if nr, ok := rawSyscallNumber(name); ok {
+		name = SyscallNumbers[int(nr)]
+	}
+	res, ok := SyscallSignatures[strings.ToLower(name)]
+	return res, ok
+}
+
+// GetSyscallSignature returns the arguments of the given syscall on the architecture, if they are known. Only the
+// signatures of x86_64 are known. The arguments can be in another order on other architectures - clone takes the
+// tls before the child_tid on i386 - so there are no signatures for them.
+func (a *Arch) GetSyscallSignature(name string) ([]SyscallArgument, bool) {
+	if a != X86_64 {
+		return nil, false
+	}
+	return GetSyscallSignature(name)
+}
@@ -77,9 +77,9 @@ func (e *emulator) getFromWorkingMemory(ix uint32) uint32 {
 	case 4:
 		return e.data.Arch
 	case 8:
-		return uint32(e.data.InstructionPointer >> 32)
+		return uint32(e.data.InstructionPointer & 0xFFFFFFFF)
 	case 12:
-		return uint32(e.data.InstructionPointer & 0xFFFF)
+		return uint32(e.data.InstructionPointer >> 32)
 	case 16:
 		return uint32(e.data.Args[0] & 0xFFFFFFFF)
 	case 20:
@@ -185,6 +185,11 @@ func (e *emulator) execAlu(current unix.SockFilter) (uint32, bool) {
 		panic(fmt.Sprintf("Invalid source for right hand side of operation: %d", bpfSrc(cd)))
 	}
 
+	if (bpfOp(cd) == syscall.BPF_DIV || bpfOp(cd) == BPF_MOD) && right == 0 {
+		// Just like the kernel, a division by zero returns 0 from the filter
+		return 0, true
+	}
+
 	switch bpfOp(cd) {
 	case syscall.BPF_ADD:
 		e.A += right
@@ -160,10 +160,10 @@ func (s *EmulatorSuite) Test_loadWorkingMemory(c *C) {
 	c.Assert(e.A, Equals, uint32(0xF))
 
 	e.next()
-	c.Assert(e.A, Equals, uint32(0xA))
+	c.Assert(e.A, Equals, uint32(0x8FFC87AE))
 
 	e.next()
-	c.Assert(e.A, Equals, uint32(0x87AE))
+	c.Assert(e.A, Equals, uint32(0xA))
 
 	e.next()
 	c.Assert(e.A, Equals, uint32(0x1E162))
@@ -617,3 +617,24 @@ func (s *EmulatorSuite) Test_storeXInScratchMemory(c *C) {
 
 	c.Assert(e.M[1], Equals, uint32(4))
 }
+
+func (s *EmulatorSuite) Test_divisionByZeroReturnsZero(c *C) {
+	for _, op := range []uint16{syscall.BPF_DIV, BPF_MOD} {
+		e := &emulator{
+			data: data.SeccompWorkingMemory{},
+			filters: []unix.SockFilter{
+				unix.SockFilter{
+					Code: syscall.BPF_ALU | syscall.BPF_X | op,
+				},
+			},
+			pointer: 0,
+			A:       10,
+			X:       0,
+		}
+
+		res, finished := e.next()
+
+		c.Assert(finished, Equals, true)
+		c.Assert(res, Equals, uint32(0))
+	}
+}
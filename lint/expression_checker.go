@@ -0,0 +1,178 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/twtiger/gosecco/simplifier"
+	"github.com/twtiger/gosecco/tree"
+)
+
+// expressionChecker finds comparisons whose result is decided by the width of the values compared
+type expressionChecker struct {
+	messages []string
+}
+
+func checkExpression(x tree.Expression) []string {
+	ec := &expressionChecker{}
+	x.Accept(ec)
+	return ec.messages
+}
+
+func (e *expressionChecker) check(xs ...tree.Expression) {
+	for _, x := range xs {
+		e.messages = append(e.messages, checkExpression(x)...)
+	}
+}
+
+// fold returns the value of the expression if it can be calculated statically
+func fold(x tree.Numeric) (uint64, bool) {
+	v, ok := simplifier.Simplify(x).(tree.NumericLiteral)
+	return v.Value, ok
+}
+
+// maxValue returns the largest value the expression can have at runtime. Only full arguments are 64 bits wide, everything
+// else is calculated using 32 bits
+func maxValue(x tree.Numeric) uint64 {
+	if containsFullArgument(x) {
+		return 0xFFFFFFFFFFFFFFFF
+	}
+	return 0xFFFFFFFF
+}
+
+var flippedOperator = map[tree.ComparisonType]tree.ComparisonType{
+	tree.EQL:    tree.EQL,
+	tree.NEQL:   tree.NEQL,
+	tree.GT:     tree.LT,
+	tree.GTE:    tree.LTE,
+	tree.LT:     tree.GT,
+	tree.LTE:    tree.GTE,
+	tree.BITSET: tree.BITSET,
+}
+
+// decidedByWidth returns the result of comparing the runtime value x with the literal k, if the result
+// is always the same because of the largest value x can have
+func decidedByWidth(x tree.Numeric, op tree.ComparisonType, k uint64) (result bool, decided bool) {
+	max := maxValue(x)
+	if max == 0xFFFFFFFF && k >= 0xFFFFFFFF80000000 {
+		// Negative values such as -1 are cut down to 32 bits by the simplifier when compared with 32 bit values
+		k &= max
+	}
+	switch op {
+	case tree.EQL:
+		return false, k > max
+	case tree.NEQL:
+		return true, k > max
+	case tree.GT:
+		return false, k >= max
+	case tree.GTE:
+		if k == 0 {
+			return true, true
+		}
+		return false, k > max
+	case tree.LT:
+		if k == 0 {
+			return false, true
+		}
+		return true, k > max
+	case tree.LTE:
+		return true, k >= max
+	case tree.BITSET:
+		if k == 0 {
+			return true, true
+		}
+		return false, k&^max != 0
+	}
+	return false, false
+}
+
+// AcceptAnd implements Visitor
+func (e *expressionChecker) AcceptAnd(v tree.And) {
+	e.check(v.Left, v.Right)
+}
+
+// AcceptArgument implements Visitor
+func (e *expressionChecker) AcceptArgument(v tree.Argument) {}
+
+// AcceptArithmetic implements Visitor
+func (e *expressionChecker) AcceptArithmetic(v tree.Arithmetic) {
+	e.check(v.Left, v.Right)
+}
+
+// AcceptBinaryNegation implements Visitor
+func (e *expressionChecker) AcceptBinaryNegation(v tree.BinaryNegation) {
+	e.check(v.Operand)
+}
+
+// AcceptBooleanLiteral implements Visitor
+func (e *expressionChecker) AcceptBooleanLiteral(v tree.BooleanLiteral) {}
+
+// AcceptCall implements Visitor
+func (e *expressionChecker) AcceptCall(v tree.Call) {}
+
+// AcceptComparison implements Visitor
+func (e *expressionChecker) AcceptComparison(v tree.Comparison) {
+	l, lok := fold(v.Left)
+	r, rok := fold(v.Right)
+	var result, decided bool
+	if !lok && rok {
+		result, decided = decidedByWidth(v.Left, v.Op, r)
+	} else if lok && !rok {
+		if op, ok := flippedOperator[v.Op]; ok {
+			result, decided = decidedByWidth(v.Right, op, l)
+		}
+	}
+	if decided && result {
+		e.messages = append(e.messages, fmt.Sprintf("Comparison is always true: %s", tree.ExpressionString(v)))
+	} else if decided {
+		e.messages = append(e.messages, fmt.Sprintf("Comparison can never be true: %s", tree.ExpressionString(v)))
+	}
+	e.check(v.Left, v.Right)
+}
+
+// AcceptInclusion implements Visitor
+func (e *expressionChecker) AcceptInclusion(v tree.Inclusion) {
+	e.check(v.Left)
+	for _, r := range v.Rights {
+		e.check(r)
+	}
+	for _, r := range v.Ranges {
+		e.check(r.Low, r.High)
+	}
+}
+
+// AcceptNegation implements Visitor
+func (e *expressionChecker) AcceptNegation(v tree.Negation) {
+	e.check(v.Operand)
+}
+
+// AcceptNumericLiteral implements Visitor
+func (e *expressionChecker) AcceptNumericLiteral(v tree.NumericLiteral) {}
+
+// AcceptOr implements Visitor
+func (e *expressionChecker) AcceptOr(v tree.Or) {
+	e.check(v.Left, v.Right)
+}
+
+// AcceptVariable implements Visitor
+func (e *expressionChecker) AcceptVariable(v tree.Variable) {}
+
+// fullArgumentFinder records if an expression refers to a full 64 bit argument
+type fullArgumentFinder struct {
+	tree.EmptyTransformer
+	found bool
+}
+
+func containsFullArgument(x tree.Expression) bool {
+	f := &fullArgumentFinder{}
+	f.RealSelf = f
+	f.Transform(x)
+	return f.found
+}
+
+// AcceptArgument implements Visitor
+func (f *fullArgumentFinder) AcceptArgument(v tree.Argument) {
+	if v.Type == tree.Full {
+		f.found = true
+	}
+	f.EmptyTransformer.AcceptArgument(v)
+}
@@ -0,0 +1,143 @@
+package lint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/twtiger/gosecco/constants"
+	"github.com/twtiger/gosecco/tree"
+)
+
+// Check looks for rules in the policy that are probably not what the author intended, even though they can be compiled,
+// and returns warnings for them. The policy should have been simplified, and original should contain the same rules as
+// they were before the simplification, in the same order. The original rules are used to tell apart conditions that
+// were written as constants from conditions that turned out to be constant, and to report expressions as they were written.
+func Check(p tree.Policy, original []tree.Rule) []tree.Warning {
+	l := &linter{policy: p}
+	for ix, r := range p.Rules {
+		l.checkRule(r, original[ix])
+	}
+	return l.warnings
+}
+
+type linter struct {
+	policy   tree.Policy
+	warnings []tree.Warning
+}
+
+func (l *linter) warn(r *tree.Rule, format string, args ...interface{}) {
+	l.warnings = append(l.warnings, tree.Warning{Position: r.Position, Message: fmt.Sprintf(format, args...)})
+}
+
+func (l *linter) checkRule(r *tree.Rule, original tree.Rule) {
+	if len(r.Outcomes) > 0 {
+		l.checkOutcomes(r, original)
+		return
+	}
+
+	for _, m := range checkExpression(original.Body) {
+		l.warn(r, "%s in rule for '%s'", m, r.Name)
+	}
+
+	positive := actionOrDefault(r.PositiveAction, l.policy.DefaultPositiveAction)
+	negative := actionOrDefault(r.NegativeAction, l.policy.DefaultNegativeAction)
+	possible := []string{positive, negative}
+	if v, ok := constantValue(r.Body); ok {
+		if v {
+			possible = []string{positive}
+		} else {
+			possible = []string{negative}
+		}
+		if !isLiteral(original.Body) {
+			l.warn(r, "Rule for '%s' is always %v", r.Name, v)
+		}
+	}
+
+	if allSameAs(possible, l.policy.DefaultPolicyAction) {
+		l.warn(r, "Rule for '%s' always results in '%s', which is the default policy action - the rule is redundant", r.Name, l.policy.DefaultPolicyAction)
+	} else if len(possible) == 2 && sameAction(positive, negative) {
+		l.warn(r, "Rule for '%s' has the same positive and negative action '%s' - its condition doesn't matter", r.Name, positive)
+	}
+}
+
+func (l *linter) checkOutcomes(r *tree.Rule, original tree.Rule) {
+	possible := []string{}
+	reachable := true
+	for ix, o := range r.Outcomes {
+		for _, m := range checkExpression(original.Outcomes[ix].Condition) {
+			l.warn(r, "%s in outcome %d of rule for '%s'", m, ix+1, r.Name)
+		}
+		if !reachable {
+			continue
+		}
+		v, ok := constantValue(o.Condition)
+		if ok && !isLiteral(original.Outcomes[ix].Condition) {
+			l.warn(r, "Condition of outcome %d of rule for '%s' is always %v", ix+1, r.Name, v)
+		}
+		if !ok || v {
+			possible = append(possible, o.Action)
+		}
+		reachable = !ok || !v
+	}
+	if reachable {
+		possible = append(possible, actionOrDefault(r.NegativeAction, l.policy.DefaultNegativeAction))
+	}
+
+	if allSameAs(possible, l.policy.DefaultPolicyAction) {
+		l.warn(r, "Rule for '%s' always results in '%s', which is the default policy action - the rule is redundant", r.Name, l.policy.DefaultPolicyAction)
+	}
+}
+
+// actionOrDefault returns the action to use, taking the default into account
+func actionOrDefault(a, def string) string {
+	if a == "" {
+		return def
+	}
+	return a
+}
+
+// constantValue returns the value of a condition if it is a literal - a numeric literal is true when it is not zero
+func constantValue(x tree.Expression) (bool, bool) {
+	switch v := x.(type) {
+	case tree.BooleanLiteral:
+		return v.Value, true
+	case tree.NumericLiteral:
+		return v.Value != 0, true
+	case tree.Constant:
+		return v.Value != 0, true
+	}
+	return false, false
+}
+
+func isLiteral(x tree.Expression) bool {
+	switch x.(type) {
+	case tree.BooleanLiteral, tree.NumericLiteral, tree.Constant:
+		return true
+	}
+	return false
+}
+
+func allSameAs(actions []string, a string) bool {
+	for _, b := range actions {
+		if !sameAction(a, b) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameAction returns true if the two actions will result in the same return value, so that for example EPERM and 1 are the same
+func sameAction(a, b string) bool {
+	return a != "" && normalizeAction(a) == normalizeAction(b)
+}
+
+func normalizeAction(a string) string {
+	if res, err := strconv.ParseUint(a, 0, 16); err == nil {
+		return strconv.FormatUint(res, 10)
+	}
+	if res, ok := constants.GetError(a); ok {
+		return strconv.FormatUint(uint64(res), 10)
+	}
+	return strings.ToLower(a)
+}
@@ -0,0 +1,118 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/twtiger/gosecco/simplifier"
+	"github.com/twtiger/gosecco/tree"
+
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type LintSuite struct{}
+
+var _ = Suite(&LintSuite{})
+
+func warningsFor(p tree.Policy) []string {
+	original := make([]tree.Rule, len(p.Rules))
+	for ix, r := range p.Rules {
+		original[ix] = *r
+	}
+	simplifier.SimplifyPolicy(&p)
+
+	result := []string{}
+	for _, w := range Check(p, original) {
+		result = append(result, w.String())
+	}
+	return result
+}
+
+func lowArg(ix int) tree.Argument {
+	return tree.Argument{Type: tree.Low, Index: ix}
+}
+
+func (s *LintSuite) Test_noWarningsForOrdinaryRules(c *C) {
+	p := tree.Policy{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill", Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: tree.BooleanLiteral{true}},
+		&tree.Rule{Name: "write", Body: tree.Comparison{Op: tree.EQL, Left: tree.Argument{Index: 0}, Right: tree.NumericLiteral{0x100000000}}},
+		&tree.Rule{Name: "close", Body: tree.Comparison{Op: tree.GT, Left: lowArg(0), Right: tree.NumericLiteral{0xFFFFFFFE}}},
+		&tree.Rule{Name: "mmap", Body: tree.Comparison{Op: tree.EQL, Left: lowArg(4), Right: tree.Arithmetic{Op: tree.MINUS, Left: tree.NumericLiteral{0}, Right: tree.NumericLiteral{1}}}},
+	}}
+
+	c.Assert(warningsFor(p), DeepEquals, []string{})
+}
+
+func (s *LintSuite) Test_warnsAboutConstantConditions(c *C) {
+	p := tree.Policy{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: tree.Comparison{Op: tree.EQL, Left: tree.NumericLiteral{1}, Right: tree.NumericLiteral{2}},
+			Position: tree.Position{File: "policy", Line: 1}},
+		&tree.Rule{Name: "write", Outcomes: []tree.Outcome{
+			tree.Outcome{Condition: tree.Comparison{Op: tree.EQL, Left: lowArg(0), Right: tree.NumericLiteral{1}}, Action: "EPERM"},
+			tree.Outcome{Condition: tree.Comparison{Op: tree.GT, Left: tree.NumericLiteral{2}, Right: tree.NumericLiteral{1}}, Action: "allow"},
+			tree.Outcome{Condition: tree.Comparison{Op: tree.GT, Left: tree.NumericLiteral{2}, Right: tree.NumericLiteral{1}}, Action: "trace"},
+		}},
+	}}
+
+	c.Assert(warningsFor(p), DeepEquals, []string{
+		"policy:1: Rule for 'read' is always false",
+		"Condition of outcome 2 of rule for 'write' is always true",
+	})
+}
+
+func (s *LintSuite) Test_warnsAboutComparisonsDecidedByWidth(c *C) {
+	p := tree.Policy{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: tree.Comparison{Op: tree.GT, Left: lowArg(0), Right: tree.NumericLiteral{0xFFFFFFFF}}},
+		&tree.Rule{Name: "write", Body: tree.Comparison{Op: tree.LT, Left: tree.NumericLiteral{0xFFFFFFFF}, Right: lowArg(1)}},
+		&tree.Rule{Name: "close", Body: tree.Comparison{Op: tree.GTE, Left: tree.Argument{Index: 2}, Right: tree.NumericLiteral{0}}},
+		&tree.Rule{Name: "fstat", Body: tree.Comparison{Op: tree.BITSET, Left: lowArg(1), Right: tree.NumericLiteral{0}}},
+		&tree.Rule{Name: "lseek", Body: tree.Comparison{Op: tree.BITSET, Left: lowArg(1), Right: tree.NumericLiteral{0x100000001}}},
+		&tree.Rule{Name: "mmap", Body: tree.Comparison{Op: tree.BITSET, Left: tree.Argument{Index: 1}, Right: tree.NumericLiteral{0x100000001}}},
+		&tree.Rule{Name: "open", Outcomes: []tree.Outcome{
+			tree.Outcome{Condition: tree.Comparison{Op: tree.NEQL,
+				Left:  tree.Arithmetic{Op: tree.PLUS, Left: lowArg(1), Right: tree.NumericLiteral{1}},
+				Right: tree.Arithmetic{Op: tree.LSH, Left: tree.NumericLiteral{1}, Right: tree.NumericLiteral{32}}}, Action: "EPERM"},
+		}},
+	}}
+
+	c.Assert(warningsFor(p), DeepEquals, []string{
+		"Comparison can never be true: (gt argL0 4294967295) in rule for 'read'",
+		"Comparison can never be true: (lt 4294967295 argL1) in rule for 'write'",
+		"Comparison is always true: (gte arg2 0) in rule for 'close'",
+		"Comparison is always true: (bitset argL1 0) in rule for 'fstat'",
+		"Comparison can never be true: (bitset argL1 4294967297) in rule for 'lseek'",
+		"Comparison is always true: (neq (plus argL1 1) (lsh 1 32)) in outcome 1 of rule for 'open'",
+	})
+}
+
+func (s *LintSuite) Test_warnsAboutRulesWithTheSameActions(c *C) {
+	p := tree.Policy{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "trace", Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: tree.Comparison{Op: tree.EQL, Left: lowArg(0), Right: tree.NumericLiteral{1}}, PositiveAction: "EPERM", NegativeAction: "1"},
+		&tree.Rule{Name: "write", Body: tree.Comparison{Op: tree.EQL, Left: lowArg(0), Right: tree.NumericLiteral{1}}, PositiveAction: "Kill"},
+	}}
+
+	c.Assert(warningsFor(p), DeepEquals, []string{
+		"Rule for 'read' has the same positive and negative action 'EPERM' - its condition doesn't matter",
+		"Rule for 'write' has the same positive and negative action 'Kill' - its condition doesn't matter",
+	})
+}
+
+func (s *LintSuite) Test_warnsAboutRulesMatchingTheDefaultPolicy(c *C) {
+	p := tree.Policy{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill", Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: tree.Comparison{Op: tree.EQL, Left: lowArg(0), Right: tree.NumericLiteral{1}}, PositiveAction: "kill"},
+		&tree.Rule{Name: "write", Body: tree.BooleanLiteral{false}},
+		&tree.Rule{Name: "close", Outcomes: []tree.Outcome{
+			tree.Outcome{Condition: tree.Comparison{Op: tree.EQL, Left: lowArg(0), Right: tree.NumericLiteral{1}}, Action: "kill"},
+		}},
+		&tree.Rule{Name: "open", Outcomes: []tree.Outcome{
+			tree.Outcome{Condition: tree.Comparison{Op: tree.EQL, Left: lowArg(0), Right: tree.NumericLiteral{1}}, Action: "allow"},
+		}},
+	}}
+
+	c.Assert(warningsFor(p), DeepEquals, []string{
+		"Rule for 'read' always results in 'kill', which is the default policy action - the rule is redundant",
+		"Rule for 'write' always results in 'kill', which is the default policy action - the rule is redundant",
+		"Rule for 'close' always results in 'kill', which is the default policy action - the rule is redundant",
+	})
+}
@@ -2,13 +2,15 @@ package parser
 
 import (
 	"errors"
+	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/twtiger/gosecco/tree"
 )
 
-var parseBindingHeadRE = regexp.MustCompile(`^[[:space:]]*([[:word:]]+)[[:space:]]*(?:\((.*)\))?[[:space:]]*$`)
+var parseBindingHeadRE = regexp.MustCompile(`^[[:space:]]*(?:(override)[[:space:]]+)?([[:word:]]+)[[:space:]]*(?:\((.*)\))?[[:space:]]*$`)
 
 func parseArgumentNames(s string) []string {
 	ss := strings.Split(s, ",")
@@ -25,10 +27,11 @@ func parseBindingHead(s string) (tree.Macro, bool) {
 	match := parseBindingHeadRE.FindStringSubmatch(s)
 	if match != nil {
 		m := tree.Macro{
-			Name: match[1],
+			Name:     match[2],
+			Override: match[1] != "",
 		}
-		if len(match) > 2 {
-			bla := strings.TrimSpace(match[2])
+		if len(match) > 3 {
+			bla := strings.TrimSpace(match[3])
 			if len(bla) > 0 {
 				m.ArgumentNames = parseArgumentNames(bla)
 			}
@@ -52,3 +55,26 @@ func parseBinding(s string) (tree.Macro, error) {
 	binding.Body = x
 	return binding, nil
 }
+
+// parseDefaultAssignment parses an assignment to DEFAULT_POSITIVE, DEFAULT_NEGATIVE or DEFAULT_POLICY,
+// and makes sure the value is a valid action
+func parseDefaultAssignment(s string) (tree.Macro, error) {
+	binding, err := parseBinding(s)
+	if err != nil {
+		return tree.Macro{}, err
+	}
+
+	action := ""
+	switch f := binding.Body.(type) {
+	case tree.NumericLiteral:
+		action = strconv.FormatUint(f.Value, 10)
+	case tree.Variable:
+		action = f.Name
+	default:
+		return tree.Macro{}, fmt.Errorf("Invalid return action for %s: %s", binding.Name, tree.ExpressionString(binding.Body))
+	}
+	if !IsValidAction(action) {
+		return tree.Macro{}, fmt.Errorf("Invalid return action '%s' for %s", action, binding.Name)
+	}
+	return binding, nil
+}
@@ -23,6 +23,8 @@ func (s *BindingSuite) Test_parseBindingHead_parsesValidBindingHeads(c *C) {
 	parseBindingHeadCheck(c, "fcntl ( ) ", tree.Macro{Name: "fcntl"})
 	parseBindingHeadCheck(c, " fcntl ( kill ) ", tree.Macro{Name: "fcntl", ArgumentNames: []string{"kill"}})
 	parseBindingHeadCheck(c, " fcntl( kill, trace) ", tree.Macro{Name: "fcntl", ArgumentNames: []string{"kill", "trace"}})
+	parseBindingHeadCheck(c, "override _IOC(a, b)", tree.Macro{Name: "_IOC", ArgumentNames: []string{"a", "b"}, Override: true})
+	parseBindingHeadCheck(c, " override ", tree.Macro{Name: "override"})
 
 	_, ok := parseBindingHead("")
 	c.Assert(ok, Equals, false)
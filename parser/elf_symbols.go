@@ -0,0 +1,92 @@
+package parser
+
+import (
+	"debug/elf"
+	"fmt"
+	"sort"
+
+	"github.com/twtiger/gosecco/tree"
+)
+
+// ELFSymbolSource defines macros that check if the instruction pointer is inside the code of symbols in an ELF file.
+// This makes it possible to only allow some syscalls from specific parts of a program. The addresses of the symbols
+// are only known before the program is loaded if it is not position independent, so the ELF file has to be a non-PIE executable.
+type ELFSymbolSource struct {
+	// Filename is the name of the ELF file to read symbols from
+	Filename string
+	// Symbols maps the name of each macro to define to the name of the symbol it should cover
+	Symbols map[string]string
+}
+
+// Parse implements the Source interface by reading the symbols from the ELF file
+func (s *ELFSymbolSource) Parse() (tree.RawPolicy, error) {
+	f, err := elf.Open(s.Filename)
+	if err != nil {
+		return tree.RawPolicy{}, err
+	}
+	defer f.Close()
+
+	if f.Type != elf.ET_EXEC {
+		return tree.RawPolicy{}, fmt.Errorf("ELF file '%s' is not a position dependent executable - the addresses of its symbols are not known before it is loaded", s.Filename)
+	}
+
+	symbols, err := elfSymbols(f)
+	if err == elf.ErrNoSymbols {
+		return tree.RawPolicy{}, fmt.Errorf("ELF file '%s' has no symbols", s.Filename)
+	}
+	if err != nil {
+		return tree.RawPolicy{}, err
+	}
+
+	names := []string{}
+	for name := range s.Symbols {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := []interface{}{}
+	for _, name := range names {
+		sym, ok := symbols[s.Symbols[name]]
+		if !ok {
+			return tree.RawPolicy{}, fmt.Errorf("Symbol '%s' not found in ELF file '%s'", s.Symbols[name], s.Filename)
+		}
+		if sym.Size == 0 {
+			return tree.RawPolicy{}, fmt.Errorf("Symbol '%s' in ELF file '%s' has no size", s.Symbols[name], s.Filename)
+		}
+		result = append(result, tree.Macro{
+			Name: name,
+			Body: tree.Inclusion{
+				Positive: true,
+				Left:     tree.Argument{Type: tree.Full, Index: tree.InstructionPointer},
+				Ranges:   []tree.Range{tree.Range{Low: tree.NumericLiteral{sym.Value}, High: tree.NumericLiteral{sym.Value + sym.Size - 1}}},
+			},
+		})
+	}
+
+	return tree.RawPolicy{RuleOrMacros: result}, nil
+}
+
+// elfSymbols returns all symbols defined in the ELF file, both from the symbol table and the dynamic symbol table
+func elfSymbols(f *elf.File) (map[string]elf.Symbol, error) {
+	result := make(map[string]elf.Symbol)
+	found := false
+	for _, read := range []func() ([]elf.Symbol, error){f.Symbols, f.DynamicSymbols} {
+		syms, err := read()
+		if err == elf.ErrNoSymbols {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		found = true
+		for _, sym := range syms {
+			if _, ok := result[sym.Name]; !ok && sym.Section != elf.SHN_UNDEF {
+				result[sym.Name] = sym
+			}
+		}
+	}
+	if !found {
+		return nil, elf.ErrNoSymbols
+	}
+	return result, nil
+}
@@ -0,0 +1,52 @@
+package parser
+
+import (
+	"github.com/twtiger/gosecco/tree"
+
+	. "gopkg.in/check.v1"
+)
+
+type ELFSymbolsSuite struct{}
+
+var _ = Suite(&ELFSymbolsSuite{})
+
+func (s *ELFSymbolsSuite) Test_ParseSymbolRanges(c *C) {
+	rp, ee := (&ELFSymbolSource{
+		Filename: getActualTestFolder() + "/elf/program",
+		Symbols:  map[string]string{"inStart": "_start", "inAllowedExec": "allowed_exec"},
+	}).Parse()
+	c.Assert(ee, IsNil)
+	c.Assert(rp, DeepEquals, tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Macro{
+				Name: "inAllowedExec",
+				Body: tree.Inclusion{Positive: true,
+					Left:   tree.Argument{Type: tree.Full, Index: tree.InstructionPointer},
+					Ranges: []tree.Range{tree.Range{Low: tree.NumericLiteral{0x40100E}, High: tree.NumericLiteral{0x401015}}}}},
+			tree.Macro{
+				Name: "inStart",
+				Body: tree.Inclusion{Positive: true,
+					Left:   tree.Argument{Type: tree.Full, Index: tree.InstructionPointer},
+					Ranges: []tree.Range{tree.Range{Low: tree.NumericLiteral{0x401000}, High: tree.NumericLiteral{0x40100D}}}}},
+		}})
+}
+
+func (s *ELFSymbolsSuite) Test_ParseMissingSymbol(c *C) {
+	_, ee := (&ELFSymbolSource{Filename: getActualTestFolder() + "/elf/program", Symbols: map[string]string{"inFoo": "foo"}}).Parse()
+	c.Assert(ee, ErrorMatches, "Symbol 'foo' not found in ELF file '.*/elf/program'")
+}
+
+func (s *ELFSymbolsSuite) Test_ParseSymbolWithoutSize(c *C) {
+	_, ee := (&ELFSymbolSource{Filename: getActualTestFolder() + "/elf/program", Symbols: map[string]string{"inBss": "__bss_start"}}).Parse()
+	c.Assert(ee, ErrorMatches, "Symbol '__bss_start' in ELF file '.*/elf/program' has no size")
+}
+
+func (s *ELFSymbolsSuite) Test_ParsePositionIndependentExecutable(c *C) {
+	_, ee := (&ELFSymbolSource{Filename: getActualTestFolder() + "/elf/program_pie", Symbols: map[string]string{"inStart": "_start"}}).Parse()
+	c.Assert(ee, ErrorMatches, "ELF file '.*/elf/program_pie' is not a position dependent executable - the addresses of its symbols are not known before it is loaded")
+}
+
+func (s *ELFSymbolsSuite) Test_ParseNonELFFile(c *C) {
+	_, ee := (&ELFSymbolSource{Filename: getActualTestFolder() + "/simple_test_policy", Symbols: map[string]string{"inStart": "_start"}}).Parse()
+	c.Assert(ee, NotNil)
+}
@@ -7,38 +7,93 @@ import (
 )
 
 // ParseError represents error parsing a policy file. It will report the filename and the line number as well as the actual error.
+// If the error happened in an included file, the places it was included from will also be reported.
 type ParseError struct {
 	originalError error
 	file          string
 	line          int
+	includeStack  []includePosition
+}
+
+type includePosition struct {
+	file string
+	line int
 }
 
 func (e *ParseError) Error() string {
-	return fmt.Sprintf("%s:%d: %s", e.file, e.line, e.originalError)
+	result := fmt.Sprintf("%s:%d: %s", e.file, e.line, e.originalError)
+	for _, p := range e.includeStack {
+		result += fmt.Sprintf(", included from %s:%d", p.file, p.line)
+	}
+	return result
 }
 
-func parseLines(path string, lines []string) (tree.RawPolicy, error) {
+func includeError(err error, path string, line int) error {
+	if pe, ok := err.(*ParseError); ok {
+		pe.includeStack = append(pe.includeStack, includePosition{path, line})
+		return pe
+	}
+	return &ParseError{originalError: err, file: path, line: line}
+}
+
+func parseLines(path string, lines []string, ctx *includeContext) (tree.RawPolicy, error) {
 	result := []interface{}{}
 
 	for ix, l := range lines {
+		code := stripComment(l)
 		switch lineType(l) {
 		case commentLine: //ignore
 		case emptyLine: //ignore
+		case includeLine:
+			included, err := ctx.include(path, code)
+			if err != nil {
+				return tree.RawPolicy{}, includeError(err, path, ix)
+			}
+			result = append(result, included...)
+		case importLine:
+			imported, err := ctx.importNamespaced(path, code)
+			if err != nil {
+				return tree.RawPolicy{}, includeError(err, path, ix)
+			}
+			result = append(result, imported...)
 		case ruleLine:
-			parsedRule, err := parseRule(l)
+			parsedRules, err := parseRule(code)
 			if err != nil {
-				return tree.RawPolicy{}, &ParseError{err, path, ix}
+				return tree.RawPolicy{}, &ParseError{originalError: err, file: path, line: ix}
+			}
+			for _, r := range parsedRules {
+				r.Position = tree.Position{File: path, Line: ix}
+				result = append(result, r)
 			}
-			result = append(result, parsedRule)
-		case assignmentLine, defaultAssignmentLine:
-			parsedBinding, err := parseBinding(l)
+		case groupLine:
+			parsedGroup, err := parseGroup(code)
+			if err != nil {
+				return tree.RawPolicy{}, &ParseError{originalError: err, file: path, line: ix}
+			}
+			result = append(result, parsedGroup)
+		case metadataLine:
+			parsedMetadata, err := parseMetadata(code)
+			if err != nil {
+				return tree.RawPolicy{}, &ParseError{originalError: err, file: path, line: ix}
+			}
+			result = append(result, parsedMetadata)
+		case assignmentLine:
+			parsedBinding, err := parseBinding(code)
+			if err != nil {
+				return tree.RawPolicy{}, &ParseError{originalError: err, file: path, line: ix}
+			}
+			parsedBinding.Position = tree.Position{File: path, Line: ix}
+			result = append(result, parsedBinding)
+		case defaultAssignmentLine:
+			parsedBinding, err := parseDefaultAssignment(code)
 			if err != nil {
-				return tree.RawPolicy{}, &ParseError{err, path, ix}
+				return tree.RawPolicy{}, &ParseError{originalError: err, file: path, line: ix}
 			}
+			parsedBinding.Position = tree.Position{File: path, Line: ix}
 			result = append(result, parsedBinding)
 
 		case unknownLine:
-			return tree.RawPolicy{}, &ParseError{fmt.Errorf("Couldn't parse line: '%s' - it doesn't match any kind of valid syntax", l), path, ix}
+			return tree.RawPolicy{}, &ParseError{originalError: fmt.Errorf("Couldn't parse line: '%s' - it doesn't match any kind of valid syntax", l), file: path, line: ix}
 		}
 	}
 
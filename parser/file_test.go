@@ -29,20 +29,24 @@ func (s *FileSuite) Test_ParseFile(c *C) {
 			tree.Macro{
 				Name:          "DEFAULT_POSITIVE",
 				ArgumentNames: nil,
-				Body:          tree.Variable{Name: "kill"}},
+				Body:          tree.Variable{Name: "kill"},
+				Position:      tree.Position{File: getActualTestFolder() + "/simple_test_policy", Line: 3}},
 			tree.Macro{
 				Name:          "something",
 				ArgumentNames: []string{"a"},
-				Body:          tree.Arithmetic{Op: 0, Left: tree.NumericLiteral{Value: 0x1}, Right: tree.Variable{Name: "a"}}},
+				Body:          tree.Arithmetic{Op: 0, Left: tree.NumericLiteral{Value: 0x1}, Right: tree.Variable{Name: "a"}},
+				Position:      tree.Position{File: getActualTestFolder() + "/simple_test_policy", Line: 5}},
 			tree.Macro{
 				Name:          "VAL",
 				ArgumentNames: nil,
-				Body:          tree.NumericLiteral{Value: 0x2a}},
+				Body:          tree.NumericLiteral{Value: 0x2a},
+				Position:      tree.Position{File: getActualTestFolder() + "/simple_test_policy", Line: 6}},
 			tree.Rule{
 				Name:           "read",
 				PositiveAction: "",
 				NegativeAction: "",
-				Body:           tree.NumericLiteral{Value: 0x2a}},
+				Body:           tree.NumericLiteral{Value: 0x2a},
+				Position:       tree.Position{File: getActualTestFolder() + "/simple_test_policy", Line: 8}},
 		}})
 }
 
@@ -62,20 +66,24 @@ func (s *FileSuite) Test_ParseString(c *C) {
 			tree.Macro{
 				Name:          "DEFAULT_POSITIVE",
 				ArgumentNames: nil,
-				Body:          tree.Variable{Name: "kill"}},
+				Body:          tree.Variable{Name: "kill"},
+				Position:      tree.Position{File: "<string>", Line: 2}},
 			tree.Macro{
 				Name:          "something",
 				ArgumentNames: []string{"a"},
-				Body:          tree.Arithmetic{Op: 0, Left: tree.NumericLiteral{Value: 0x1}, Right: tree.Variable{Name: "a"}}},
+				Body:          tree.Arithmetic{Op: 0, Left: tree.NumericLiteral{Value: 0x1}, Right: tree.Variable{Name: "a"}},
+				Position:      tree.Position{File: "<string>", Line: 4}},
 			tree.Macro{
 				Name:          "VAL",
 				ArgumentNames: nil,
-				Body:          tree.NumericLiteral{Value: 0x2a}},
+				Body:          tree.NumericLiteral{Value: 0x2a},
+				Position:      tree.Position{File: "<string>", Line: 5}},
 			tree.Rule{
 				Name:           "read",
 				PositiveAction: "",
 				NegativeAction: "",
-				Body:           tree.NumericLiteral{Value: 0x2a}},
+				Body:           tree.NumericLiteral{Value: 0x2a},
+				Position:       tree.Position{File: "<string>", Line: 7}},
 		}})
 }
 
@@ -89,25 +97,30 @@ func (s *FileSuite) Test_Parse_fromCombinedSource(c *C) {
 			tree.Macro{
 				Name:          "DEFAULT_POSITIVE",
 				ArgumentNames: nil,
-				Body:          tree.Variable{Name: "kill"}},
+				Body:          tree.Variable{Name: "kill"},
+				Position:      tree.Position{File: getActualTestFolder() + "/simple_test_policy", Line: 3}},
 			tree.Macro{
 				Name:          "something",
 				ArgumentNames: []string{"a"},
-				Body:          tree.Arithmetic{Op: 0, Left: tree.NumericLiteral{Value: 0x1}, Right: tree.Variable{Name: "a"}}},
+				Body:          tree.Arithmetic{Op: 0, Left: tree.NumericLiteral{Value: 0x1}, Right: tree.Variable{Name: "a"}},
+				Position:      tree.Position{File: getActualTestFolder() + "/simple_test_policy", Line: 5}},
 			tree.Macro{
 				Name:          "VAL",
 				ArgumentNames: nil,
-				Body:          tree.NumericLiteral{Value: 0x2a}},
+				Body:          tree.NumericLiteral{Value: 0x2a},
+				Position:      tree.Position{File: getActualTestFolder() + "/simple_test_policy", Line: 6}},
 			tree.Rule{
 				Name:           "read",
 				PositiveAction: "",
 				NegativeAction: "",
-				Body:           tree.NumericLiteral{Value: 0x2a}},
+				Body:           tree.NumericLiteral{Value: 0x2a},
+				Position:       tree.Position{File: getActualTestFolder() + "/simple_test_policy", Line: 8}},
 			tree.Rule{
 				Name:           "write",
 				PositiveAction: "",
 				NegativeAction: "",
-				Body:           tree.NumericLiteral{Value: 0x2b}},
+				Body:           tree.NumericLiteral{Value: 0x2b},
+				Position:       tree.Position{File: "<tmp1>", Line: 0}},
 		}})
 }
 
@@ -116,3 +129,31 @@ func (s *FileSuite) Test_ParseFile_failing(c *C) {
 	c.Assert(rp.RuleOrMacros, IsNil)
 	c.Assert(ee, ErrorMatches, ".*parser/test_policies/failing_test_policy:1: unexpected end of line")
 }
+
+func (s *FileSuite) Test_ParseString_reportsLineOfUnknownErrno(c *C) {
+	_, ee := ParseString("read: return EPERM\nwrite: return ENOTHING\n")
+	c.Assert(ee, ErrorMatches, "<string>:1: Invalid errno 'ENOTHING'")
+}
+
+func (s *FileSuite) Test_ParseString_reportsLineOfInvalidDefaultAction(c *C) {
+	_, ee := ParseString("DEFAULT_NEGATIVE = EPERM\nDEFAULT_POSITIVE = EBLARG\n")
+	c.Assert(ee, ErrorMatches, "<string>:1: Invalid return action 'EBLARG' for DEFAULT_POSITIVE")
+
+	_, ee = ParseString("DEFAULT_POLICY = 70000\n")
+	c.Assert(ee, ErrorMatches, "<string>:0: Invalid return action '70000' for DEFAULT_POLICY")
+
+	_, ee = ParseString("DEFAULT_POLICY = arg0 + 1\n")
+	c.Assert(ee, ErrorMatches, "<string>:0: Invalid return action for DEFAULT_POLICY: \\(plus arg0 1\\)")
+}
+
+func (s *FileSuite) Test_ParseString_withTrailingComments(c *C) {
+	rp, ee := ParseString("DEFAULT_POSITIVE = trace # default: trace\nfoo = 42 # the answer\nread: arg0 == foo  # stdin only\n")
+	c.Assert(ee, IsNil)
+	c.Assert(rp.RuleOrMacros, HasLen, 3)
+	c.Assert(tree.ExpressionString(rp.RuleOrMacros[2].(tree.Rule).Body), Equals, "(eq arg0 foo)")
+}
+
+func (s *FileSuite) Test_ParseString_reportsPositionOnLineWithComment(c *C) {
+	_, ee := ParseString("read: 1\nwrite: arg0 == \"foo\" # comment\n")
+	c.Assert(ee, ErrorMatches, "<string>:1: unexpected token at <input>:-1:9: '\"'")
+}
@@ -0,0 +1,44 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/twtiger/gosecco/tree"
+)
+
+const syscallOrGroupName = `(?:@[[:word:]-]+|[[:word:]]+)`
+
+var (
+	groupDefinitionRE = regexp.MustCompile(`^[[:space:]]*@[[:word:]-]+[[:space:]]*=`)
+	groupNameRE       = regexp.MustCompile(`^@[[:word:]-]+$`)
+	groupMemberRE     = regexp.MustCompile(`^` + syscallOrGroupName + `$`)
+)
+
+func isGroupDefinition(s string) bool {
+	return groupDefinitionRE.MatchString(s)
+}
+
+func parseGroup(s string) (tree.SyscallGroup, error) {
+	parts := strings.SplitN(s, "=", 2) //This shouldn't fail since we will never hit this case unless linetype told us to
+	name := strings.TrimSpace(parts[0])
+	if !groupNameRE.MatchString(name) {
+		return tree.SyscallGroup{}, errors.New("Invalid syscall group name")
+	}
+
+	if len(strings.TrimSpace(parts[1])) == 0 {
+		return tree.SyscallGroup{}, fmt.Errorf("No syscalls specified for group: %s", name)
+	}
+
+	result := tree.SyscallGroup{Name: name}
+	for _, m := range strings.Split(parts[1], ",") {
+		m = strings.TrimSpace(m)
+		if !groupMemberRE.MatchString(m) {
+			return tree.SyscallGroup{}, fmt.Errorf("Invalid syscall name '%s' in group: %s", m, name)
+		}
+		result.Syscalls = append(result.Syscalls, m)
+	}
+	return result, nil
+}
@@ -0,0 +1,56 @@
+package parser
+
+import (
+	"github.com/twtiger/gosecco/tree"
+	. "gopkg.in/check.v1"
+)
+
+type GroupSuite struct{}
+
+var _ = Suite(&GroupSuite{})
+
+func (s *GroupSuite) Test_parseGroup_parsesAGroup(c *C) {
+	res, err := parseGroup(" @my-io = read, write ,@timer")
+	c.Assert(err, IsNil)
+	c.Assert(res, DeepEquals, tree.SyscallGroup{Name: "@my-io", Syscalls: []string{"read", "write", "@timer"}})
+}
+
+func (s *GroupSuite) Test_parseGroup_returnsErrorForInvalidGroups(c *C) {
+	_, err := parseGroup("@my-io = ")
+	c.Assert(err, ErrorMatches, "No syscalls specified for group: @my-io")
+
+	_, err = parseGroup("@my-io = read, 1+1")
+	c.Assert(err, ErrorMatches, "Invalid syscall name '1\\+1' in group: @my-io")
+
+	_, err = parseGroup("@my-io = read,")
+	c.Assert(err, ErrorMatches, "Invalid syscall name '' in group: @my-io")
+
+	_, err = parseGroup("@my io = read")
+	c.Assert(err, ErrorMatches, "Invalid syscall group name")
+}
+
+func (s *GroupSuite) Test_parseRule_acceptsGroupsInRuleHead(c *C) {
+	r, err := parseRule("@network-io, read[+trace]: arg0 == 1")
+	c.Assert(err, IsNil)
+	c.Assert(r, HasLen, 2)
+	c.Check(r[0].Name, Equals, "@network-io")
+	c.Check(r[0].PositiveAction, Equals, "trace")
+	c.Check(r[1].Name, Equals, "read")
+}
+
+func (s *GroupSuite) Test_parseExpression_acceptsGroupsInInclusion(c *C) {
+	result, _, _, err := parseExpression("in(arg0, 1, @network-io)")
+	c.Assert(err, IsNil)
+	c.Assert(result, DeepEquals,
+		tree.Inclusion{Positive: true,
+			Left:   tree.Argument{Index: 0},
+			Rights: []tree.Numeric{tree.NumericLiteral{Value: 1}, tree.Variable{Name: "@network-io"}}})
+}
+
+func (s *GroupSuite) Test_parseExpression_rejectsGroupsOutsideOfInclusion(c *C) {
+	_, _, _, err := parseExpression("arg0 == @network-io")
+	c.Assert(err, ErrorMatches, "syscall group @network-io can only be used in the list of an in or notIn expression")
+
+	_, _, _, err = parseExpression("in(@network-io, 1)")
+	c.Assert(err, ErrorMatches, "syscall group @network-io can only be used in the list of an in or notIn expression")
+}
@@ -0,0 +1,96 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/twtiger/gosecco/tree"
+)
+
+var importRE = regexp.MustCompile(`^[[:space:]]*import[[:space:]]+"([^"]*)"[[:space:]]+as[[:space:]]+([[:word:]]+)[[:space:]]*$`)
+
+func isImport(s string) bool {
+	return importRE.MatchString(s)
+}
+
+// importNamespaced parses the source referred to by an import directive, and returns the macros in it with the
+// namespace of the directive added to their names. References between the macros in the imported source are
+// changed to use the new names, so they will keep referring to each other no matter what the importing source defines.
+func (ctx *includeContext) importNamespaced(from, s string) ([]interface{}, error) {
+	match := importRE.FindStringSubmatch(s)
+	path, namespace := match[1], match[2]
+	if path == "" {
+		return nil, errors.New("No path specified for import")
+	}
+
+	src, err := ctx.resolver(from, path)
+	if err != nil {
+		return nil, err
+	}
+
+	key := sourceKey(src)
+	if key != "" && ctx.isActive(key) {
+		return nil, fmt.Errorf("Import cycle detected: %s", ctx.cycleFrom(key))
+	}
+
+	// The imported source keeps track of its own includes, since nothing it includes will be visible without the namespace
+	ictx := &includeContext{resolver: ctx.resolver, active: ctx.active, included: make(map[string]bool)}
+	rp, err := ictx.parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []interface{}{}
+	defined := make(map[string]bool)
+	for _, e := range rp.RuleOrMacros {
+		m, ok := e.(tree.Macro)
+		if !ok {
+			return nil, fmt.Errorf("Only macros can be imported, but '%s' contains other definitions", path)
+		}
+		m.Body = addNamespace(namespace, m.Body, defined, m.ArgumentNames)
+		defined[m.Name] = true
+		m.Name = namespace + "." + m.Name
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+// namespacer adds a namespace to all references to the given names
+type namespacer struct {
+	tree.EmptyTransformer
+	namespace string
+	names     map[string]bool
+}
+
+func addNamespace(namespace string, x tree.Expression, defined map[string]bool, argumentNames []string) tree.Expression {
+	names := make(map[string]bool)
+	for k := range defined {
+		names[k] = true
+	}
+	// The arguments of a macro shadow the names defined before it
+	for _, a := range argumentNames {
+		delete(names, a)
+	}
+	n := &namespacer{namespace: namespace, names: names}
+	n.RealSelf = n
+	return n.Transform(x)
+}
+
+func (n *namespacer) nameFor(name string) string {
+	if n.names[name] {
+		return n.namespace + "." + name
+	}
+	return name
+}
+
+// AcceptCall implements Visitor
+func (n *namespacer) AcceptCall(v tree.Call) {
+	n.EmptyTransformer.AcceptCall(v)
+	n.Result = tree.Call{Name: n.nameFor(v.Name), Args: n.Result.(tree.Call).Args}
+}
+
+// AcceptVariable implements Visitor
+func (n *namespacer) AcceptVariable(v tree.Variable) {
+	n.Result = tree.Variable{Name: n.nameFor(v.Name)}
+}
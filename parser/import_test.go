@@ -0,0 +1,71 @@
+package parser
+
+import (
+	"github.com/twtiger/gosecco/tree"
+
+	. "gopkg.in/check.v1"
+)
+
+type ImportSuite struct{}
+
+var _ = Suite(&ImportSuite{})
+
+func (s *ImportSuite) Test_Parse_importAddsNamespace(c *C) {
+	resolver := MapResolver(map[string]string{
+		"ioctl": "_IOC(a, b) = a << 8 | b\n_IOR(a, b) = _IOC(a, b) + SIZE\nSIZE = 4",
+	})
+
+	rp, ee := Parse(&StringSourceWithResolver{Name: "<tmp>", Content: "import \"ioctl\" as ioc\n_IOC(a, b) = 0\nioctl: arg1 == ioc._IOR(1, ioc.SIZE)", Resolver: resolver})
+	c.Assert(ee, IsNil)
+	c.Assert(rp, DeepEquals, tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Macro{Name: "ioc._IOC", ArgumentNames: []string{"a", "b"},
+				Body: tree.Arithmetic{Op: tree.BINOR,
+					Left:  tree.Arithmetic{Op: tree.LSH, Left: tree.Variable{Name: "a"}, Right: tree.NumericLiteral{Value: 8}},
+					Right: tree.Variable{Name: "b"}},
+				Position: tree.Position{File: "ioctl", Line: 0}},
+			tree.Macro{Name: "ioc._IOR", ArgumentNames: []string{"a", "b"},
+				Body: tree.Arithmetic{Op: tree.PLUS,
+					Left:  tree.Call{Name: "ioc._IOC", Args: []tree.Any{tree.Variable{Name: "a"}, tree.Variable{Name: "b"}}},
+					Right: tree.Variable{Name: "SIZE"}},
+				Position: tree.Position{File: "ioctl", Line: 1}},
+			tree.Macro{Name: "ioc.SIZE", Body: tree.NumericLiteral{Value: 4}, Position: tree.Position{File: "ioctl", Line: 2}},
+			tree.Macro{Name: "_IOC", ArgumentNames: []string{"a", "b"}, Body: tree.NumericLiteral{Value: 0}, Position: tree.Position{File: "<tmp>", Line: 1}},
+			tree.Rule{
+				Name: "ioctl",
+				Body: tree.Comparison{Op: tree.EQL, Left: tree.Argument{Index: 1},
+					Right: tree.Call{Name: "ioc._IOR", Args: []tree.Any{tree.NumericLiteral{Value: 1}, tree.Variable{Name: "ioc.SIZE"}}}},
+				Position: tree.Position{File: "<tmp>", Line: 2}},
+		}})
+}
+
+func (s *ImportSuite) Test_Parse_importDoesNotCountAsInclude(c *C) {
+	resolver := MapResolver(map[string]string{
+		"defs": "VAL = 42",
+	})
+
+	rp, ee := Parse(&StringSourceWithResolver{Name: "<tmp>", Content: "import \"defs\" as d\ninclude once \"defs\"", Resolver: resolver})
+	c.Assert(ee, IsNil)
+	c.Assert(rp.RuleOrMacros, DeepEquals, []interface{}{
+		tree.Macro{Name: "d.VAL", Body: tree.NumericLiteral{Value: 42}, Position: tree.Position{File: "defs", Line: 0}},
+		tree.Macro{Name: "VAL", Body: tree.NumericLiteral{Value: 42}, Position: tree.Position{File: "defs", Line: 0}},
+	})
+}
+
+func (s *ImportSuite) Test_Parse_importOfRulesFails(c *C) {
+	resolver := MapResolver(map[string]string{
+		"defs": "VAL = 42\nread: 1",
+	})
+
+	_, ee := Parse(&StringSourceWithResolver{Name: "<tmp>", Content: "import \"defs\" as d", Resolver: resolver})
+	c.Assert(ee, ErrorMatches, "<tmp>:0: Only macros can be imported, but 'defs' contains other definitions")
+}
+
+func (s *ImportSuite) Test_Parse_importCycle(c *C) {
+	resolver := MapResolver(map[string]string{
+		"defs": "import \"defs\" as d",
+	})
+
+	_, ee := Parse(&StringSourceWithResolver{Name: "<tmp>", Content: "import \"defs\" as d", Resolver: resolver})
+	c.Assert(ee, ErrorMatches, "defs:0: Import cycle detected: defs -> defs, included from <tmp>:0")
+}
@@ -0,0 +1,151 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/twtiger/gosecco/tree"
+)
+
+var includeRE = regexp.MustCompile(`^[[:space:]]*include(?:[[:space:]]+(once))?[[:space:]]+"([^"]*)"[[:space:]]*$`)
+
+// IncludeResolver finds the source an include directive refers to. It will be called with the name
+// of the source that contains the directive and the path given to the directive.
+type IncludeResolver func(from, path string) (Source, error)
+
+// FileResolver is the default IncludeResolver. It resolves relative paths against the directory
+// of the including file. For sources that are not files, that means relative to the current directory.
+func FileResolver(from, path string) (Source, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(from), path)
+	}
+	return &FileSource{path}, nil
+}
+
+// MapResolver returns an IncludeResolver that resolves include paths to string sources
+// with the content given in the map. It is useful when the definitions aren't stored in files.
+func MapResolver(m map[string]string) IncludeResolver {
+	return func(from, path string) (Source, error) {
+		content, ok := m[path]
+		if !ok {
+			return nil, fmt.Errorf("no definitions found for '%s'", path)
+		}
+		return &StringSource{Name: path, Content: content}, nil
+	}
+}
+
+// includingSource is implemented by the sources that can keep track of include directives
+// across more than one source
+type includingSource interface {
+	Source
+	parseIncluding(*includeContext) (tree.RawPolicy, error)
+}
+
+type includeContext struct {
+	resolver IncludeResolver
+	active   []string
+	included map[string]bool
+}
+
+func newIncludeContext(r IncludeResolver) *includeContext {
+	if r == nil {
+		r = FileResolver
+	}
+	return &includeContext{
+		resolver: r,
+		included: make(map[string]bool),
+	}
+}
+
+// withResolver returns a context sharing all state with this one, but using the given resolver if it is set
+func (ctx *includeContext) withResolver(r IncludeResolver) *includeContext {
+	if r == nil {
+		return ctx
+	}
+	nctx := *ctx
+	nctx.resolver = r
+	return &nctx
+}
+
+func sourceKey(s Source) string {
+	switch v := s.(type) {
+	case *FileSource:
+		if abs, err := filepath.Abs(v.Filename); err == nil {
+			return abs
+		}
+		return filepath.Clean(v.Filename)
+	case *StringSource:
+		return v.Name
+	case *StringSourceWithResolver:
+		return v.Name
+	}
+	return ""
+}
+
+func (ctx *includeContext) parse(s Source) (tree.RawPolicy, error) {
+	is, ok := s.(includingSource)
+	if !ok {
+		return s.Parse()
+	}
+
+	key := sourceKey(s)
+	if key != "" {
+		ctx.active = append(ctx.active, key)
+		ctx.included[key] = true
+		defer func() { ctx.active = ctx.active[:len(ctx.active)-1] }()
+	}
+
+	return is.parseIncluding(ctx)
+}
+
+func (ctx *includeContext) isActive(key string) bool {
+	for _, k := range ctx.active {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (ctx *includeContext) cycleFrom(key string) string {
+	res := []string{}
+	for ix, k := range ctx.active {
+		if k == key {
+			res = append(res, ctx.active[ix:]...)
+			break
+		}
+	}
+	return strings.Join(append(res, key), " -> ")
+}
+
+func (ctx *includeContext) include(from, s string) ([]interface{}, error) {
+	match := includeRE.FindStringSubmatch(s)
+	once, path := match[1] != "", match[2]
+	if path == "" {
+		return nil, errors.New("No path specified for include")
+	}
+
+	src, err := ctx.resolver(from, path)
+	if err != nil {
+		return nil, err
+	}
+
+	key := sourceKey(src)
+	if key != "" {
+		if ctx.isActive(key) {
+			return nil, fmt.Errorf("Include cycle detected: %s", ctx.cycleFrom(key))
+		}
+		if once && ctx.included[key] {
+			return nil, nil
+		}
+	}
+
+	rp, err := ctx.parse(src)
+	if err != nil {
+		return nil, err
+	}
+	return rp.RuleOrMacros, nil
+}
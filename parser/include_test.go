@@ -0,0 +1,102 @@
+package parser
+
+import (
+	"github.com/twtiger/gosecco/tree"
+
+	. "gopkg.in/check.v1"
+)
+
+type IncludeSuite struct{}
+
+var _ = Suite(&IncludeSuite{})
+
+func (s *IncludeSuite) Test_ParseFile_withIncludes(c *C) {
+	rp, ee := ParseFile(getActualTestFolder() + "/include_test_policy")
+	c.Assert(ee, IsNil)
+	c.Assert(rp, DeepEquals, tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Macro{
+				Name:     "OTHER_VAL",
+				Body:     tree.NumericLiteral{Value: 41},
+				Position: tree.Position{File: getActualTestFolder() + "/includes/more_definitions", Line: 0}},
+			tree.Macro{
+				Name:     "VAL",
+				Body:     tree.Arithmetic{Op: tree.PLUS, Left: tree.Variable{Name: "OTHER_VAL"}, Right: tree.NumericLiteral{Value: 1}},
+				Position: tree.Position{File: getActualTestFolder() + "/includes/shared_definitions", Line: 3}},
+			tree.Rule{
+				Name:     "read",
+				Body:     tree.Comparison{Op: tree.EQL, Left: tree.Argument{Index: 0}, Right: tree.Variable{Name: "VAL"}},
+				Position: tree.Position{File: getActualTestFolder() + "/include_test_policy", Line: 6}},
+		}})
+}
+
+func (s *IncludeSuite) Test_ParseFile_withIncludeCycle(c *C) {
+	_, ee := ParseFile(getActualTestFolder() + "/include_cycle_policy")
+	c.Assert(ee, ErrorMatches, ".*/includes/cycle_b:1: Include cycle detected: .*/includes/cycle_a -> .*/includes/cycle_b -> .*/includes/cycle_a, "+
+		"included from .*/includes/cycle_a:1, included from .*/include_cycle_policy:0")
+}
+
+func (s *IncludeSuite) Test_ParseFile_withFailingInclude(c *C) {
+	_, ee := ParseFile(getActualTestFolder() + "/include_failing_policy")
+	c.Assert(ee, ErrorMatches, ".*/failing_test_policy:1: unexpected end of line, included from .*/include_failing_policy:1")
+}
+
+func (s *IncludeSuite) Test_ParseFile_withMissingInclude(c *C) {
+	_, ee := Parse(&StringSource{Name: "<tmp>", Content: "\ninclude \"does_not_exist\""})
+	c.Assert(ee, ErrorMatches, "<tmp>:1: open does_not_exist: no such file or directory")
+}
+
+func (s *IncludeSuite) Test_Parse_stringSourceWithResolver(c *C) {
+	resolver := MapResolver(map[string]string{
+		"defs":  "include once \"other\"\nVAL = 42",
+		"other": "OTHER = 1",
+	})
+
+	rp, ee := Parse(&StringSourceWithResolver{Name: "<tmp>", Content: "include \"defs\"\ninclude once \"other\"\nread: arg0 == VAL", Resolver: resolver})
+	c.Assert(ee, IsNil)
+	c.Assert(rp, DeepEquals, tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Macro{Name: "OTHER", Body: tree.NumericLiteral{Value: 1}, Position: tree.Position{File: "other", Line: 0}},
+			tree.Macro{Name: "VAL", Body: tree.NumericLiteral{Value: 42}, Position: tree.Position{File: "defs", Line: 1}},
+			tree.Rule{
+				Name:     "read",
+				Body:     tree.Comparison{Op: tree.EQL, Left: tree.Argument{Index: 0}, Right: tree.Variable{Name: "VAL"}},
+				Position: tree.Position{File: "<tmp>", Line: 2}},
+		}})
+}
+
+func (s *IncludeSuite) Test_Parse_stringSourceWithResolverFindsCycles(c *C) {
+	resolver := MapResolver(map[string]string{
+		"defs": "include \"defs\"",
+	})
+
+	_, ee := Parse(&StringSourceWithResolver{Name: "<tmp>", Content: "include \"defs\"", Resolver: resolver})
+	c.Assert(ee, ErrorMatches, "defs:0: Include cycle detected: defs -> defs, included from <tmp>:0")
+}
+
+func (s *IncludeSuite) Test_Parse_stringSourceWithResolverReportsMissingDefinitions(c *C) {
+	resolver := MapResolver(map[string]string{})
+
+	_, ee := Parse(&StringSourceWithResolver{Name: "<tmp>", Content: "include \"defs\"", Resolver: resolver})
+	c.Assert(ee, ErrorMatches, "<tmp>:0: no definitions found for 'defs'")
+}
+
+func (s *IncludeSuite) Test_Parse_combinedSourceIncludesOnlyOnce(c *C) {
+	resolver := MapResolver(map[string]string{
+		"defs": "VAL = 42",
+	})
+
+	rp, ee := Parse(CombineSources(
+		&StringSourceWithResolver{Name: "<tmp1>", Content: "include once \"defs\"", Resolver: resolver},
+		&StringSourceWithResolver{Name: "<tmp2>", Content: "include once \"defs\"", Resolver: resolver},
+	))
+	c.Assert(ee, IsNil)
+	c.Assert(rp.RuleOrMacros, DeepEquals, []interface{}{
+		tree.Macro{Name: "VAL", Body: tree.NumericLiteral{Value: 42}, Position: tree.Position{File: "defs", Line: 0}},
+	})
+}
+
+func (s *IncludeSuite) Test_Parse_emptyIncludePath(c *C) {
+	_, ee := ParseString("include \"\"")
+	c.Assert(ee, ErrorMatches, "<string>:0: No path specified for include")
+}
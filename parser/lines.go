@@ -12,12 +12,37 @@ const (
 	assignmentLine
 	defaultAssignmentLine
 	emptyLine
+	includeLine
+	groupLine
+	metadataLine
+	importLine
 )
 
 func isComment(s string) bool {
 	return strings.HasPrefix(strings.TrimSpace(s), "#")
 }
 
+// stripComment removes a trailing comment from the line, if there is one. A # inside of a quoted string
+// does not start a comment.
+func stripComment(s string) string {
+	inString := false
+	for ix, c := range s {
+		switch c {
+		case '"':
+			inString = !inString
+		case '#':
+			if !inString {
+				return s[:ix]
+			}
+		}
+	}
+	return s
+}
+
+func isInclude(s string) bool {
+	return includeRE.MatchString(s)
+}
+
 func isRule(s string) bool {
 	return len(strings.SplitN(s, ":", 2)) == 2
 }
@@ -47,6 +72,24 @@ func lineType(s string) LineType {
 		return commentLine
 	}
 
+	s = stripComment(s)
+
+	if isInclude(s) {
+		return includeLine
+	}
+
+	if isImport(s) {
+		return importLine
+	}
+
+	if isMetadata(s) {
+		return metadataLine
+	}
+
+	if isGroupDefinition(s) {
+		return groupLine
+	}
+
 	if isRule(s) {
 		return ruleLine
 	}
@@ -33,5 +33,30 @@ func (s *LinesSuite) Test_lineType_recognizesLineTypes(c *C) {
 	c.Check(lineType("write[+kill] :return 42"), Equals, ruleLine)
 	c.Check(lineType("write[+hello, -foo]: return 42"), Equals, ruleLine)
 
+	c.Check(lineType("include \"shared.seccomp\""), Equals, includeLine)
+	c.Check(lineType(" include once \"foo:bar=1\" "), Equals, includeLine)
+	c.Check(lineType("include: 1"), Equals, ruleLine)
+
+	c.Check(lineType("@my-io = read, write"), Equals, groupLine)
+	c.Check(lineType("import \"ioctl.seccomp\" as ioc"), Equals, importLine)
+	c.Check(lineType("import = 1"), Equals, assignmentLine)
+	c.Check(lineType("@name = \"webserver\""), Equals, metadataLine)
+	c.Check(lineType("@description = \"read: only\" # comment"), Equals, metadataLine)
+	c.Check(lineType("@network-io: 1"), Equals, ruleLine)
+	c.Check(lineType("@network-io, read[+trace]: arg0 == 1"), Equals, ruleLine)
+
+	c.Check(lineType("read: arg0 == 1  # stdin only"), Equals, ruleLine)
+	c.Check(lineType("foo = 42 # the answer: always"), Equals, assignmentLine)
+	c.Check(lineType("DEFAULT_POSITIVE = trace # for now"), Equals, defaultAssignmentLine)
+	c.Check(lineType("include \"a#b\" # shared: stuff"), Equals, includeLine)
+	c.Check(lineType("foo # bar: baz"), Equals, unknownLine)
+	c.Check(lineType("  # read: 1"), Equals, commentLine)
+
 	c.Check(lineType("hmm"), Equals, unknownLine)
 }
+
+func (s *LinesSuite) Test_stripComment(c *C) {
+	c.Check(stripComment("read: 1 # hello"), Equals, "read: 1 ")
+	c.Check(stripComment("read: 1"), Equals, "read: 1")
+	c.Check(stripComment("include \"a#b\" #c"), Equals, "include \"a#b\" ")
+}
@@ -0,0 +1,27 @@
+package parser
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/twtiger/gosecco/tree"
+)
+
+var (
+	metadataDefinitionRE = regexp.MustCompile(`^[[:space:]]*@[[:word:]-]+[[:space:]]*=[[:space:]]*"`)
+	metadataRE           = regexp.MustCompile(`^[[:space:]]*@([[:word:]-]+)[[:space:]]*=[[:space:]]*"([^"]*)"[[:space:]]*$`)
+)
+
+// isMetadata returns true for lines that define metadata. These look like group definitions, but the value is a quoted string
+func isMetadata(s string) bool {
+	return metadataDefinitionRE.MatchString(s)
+}
+
+func parseMetadata(s string) (tree.Metadata, error) {
+	match := metadataRE.FindStringSubmatch(s)
+	if match == nil {
+		return tree.Metadata{}, errors.New("Invalid metadata definition - the value has to be one quoted string")
+	}
+	return tree.Metadata{Name: strings.ToLower(match[1]), Value: match[2]}, nil
+}
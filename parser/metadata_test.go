@@ -0,0 +1,35 @@
+package parser
+
+import (
+	"github.com/twtiger/gosecco/tree"
+	. "gopkg.in/check.v1"
+)
+
+type MetadataSuite struct{}
+
+var _ = Suite(&MetadataSuite{})
+
+func (s *MetadataSuite) Test_parseMetadata_parsesAField(c *C) {
+	res, err := parseMetadata(` @Name = "webserver" `)
+	c.Assert(err, IsNil)
+	c.Assert(res, DeepEquals, tree.Metadata{Name: "name", Value: "webserver"})
+
+	res, err = parseMetadata(`@description="Allows: reads, writes # and nothing else"`)
+	c.Assert(err, IsNil)
+	c.Assert(res, DeepEquals, tree.Metadata{Name: "description", Value: "Allows: reads, writes # and nothing else"})
+}
+
+func (s *MetadataSuite) Test_parseMetadata_returnsErrorForInvalidFields(c *C) {
+	_, err := parseMetadata(`@version = "1.0`)
+	c.Assert(err, ErrorMatches, "Invalid metadata definition - the value has to be one quoted string")
+
+	_, err = parseMetadata(`@version = "1.0" "2.0"`)
+	c.Assert(err, ErrorMatches, "Invalid metadata definition - the value has to be one quoted string")
+}
+
+func (s *MetadataSuite) Test_ParseString_keepsMetadataInOrder(c *C) {
+	rp, err := ParseString("@name = \"webserver\" # the name\n@owner = \"ops@example.com\"\nread: 1")
+	c.Assert(err, IsNil)
+	c.Assert(rp.RuleOrMacros[0], DeepEquals, tree.Metadata{Name: "name", Value: "webserver"})
+	c.Assert(rp.RuleOrMacros[1], DeepEquals, tree.Metadata{Name: "owner", Value: "ops@example.com"})
+}
@@ -27,3 +27,19 @@ var comparisonOperator = map[token]tree.ComparisonType{
 	GTE:    tree.GTE,
 	BITSET: tree.BITSET,
 }
+
+// signedComparisonOperator maps the relational operators to the signed versions used when one side is wrapped in signed()
+var signedComparisonOperator = map[token]tree.ComparisonType{
+	LT:  tree.SLT,
+	GT:  tree.SGT,
+	LTE: tree.SLTE,
+	GTE: tree.SGTE,
+}
+
+// bitPredicates are the built in predicates that test the flags of a value against a mask. They are called like macros.
+var bitPredicates = map[string]tree.ComparisonType{
+	"allbits":  tree.ALLBITS,
+	"anybits":  tree.ANYBITS,
+	"nobits":   tree.NOBITS,
+	"onlybits": tree.ONLYBITS,
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/twtiger/gosecco/constants"
 	"github.com/twtiger/gosecco/tree"
 )
 
@@ -41,6 +42,17 @@ func newParser(forBinding bool, td *traceData) *parser {
 	}
 }
 
+// parseErrno accepts either a number or the name of one of the known errors
+func parseErrno(s string) (uint16, error) {
+	if errno, err := strconv.ParseUint(s, 0, 16); err == nil {
+		return uint16(errno), nil
+	}
+	if errno, ok := constants.GetError(s); ok {
+		return uint16(errno), nil
+	}
+	return 0, fmt.Errorf("Invalid errno '%s'", s)
+}
+
 func (p *parser) parseSpecialCases(expr string) (tree.Expression, bool, uint16, bool, string, error) {
 	hasRet := false
 	ret := uint16(0)
@@ -51,19 +63,19 @@ func (p *parser) parseSpecialCases(expr string) (tree.Expression, bool, uint16,
 		}
 
 		if match := returnRE.FindStringSubmatch(expr); match != nil {
-			errno, err := strconv.ParseUint(match[1], 0, 16)
+			errno, err := parseErrno(match[1])
 			if err == nil {
-				return nil, true, uint16(errno), true, newExpr, nil
+				return nil, true, errno, true, newExpr, nil
 			}
 			return nil, false, 0, true, newExpr, err
 		}
 
 		if match := exprReturnRE.FindStringSubmatch(expr); match != nil {
 			newExpr = strings.TrimSuffix(expr, match[0])
-			errno, err := strconv.ParseUint(match[1], 0, 16)
+			errno, err := parseErrno(match[1])
 			if err == nil {
 				hasRet = true
-				ret = uint16(errno)
+				ret = errno
 			} else {
 				return nil, false, 0, true, newExpr, err
 			}
@@ -170,6 +182,9 @@ func (ctx *parseContext) equalityExpression() (tree.Expression, error) {
 		if e != nil {
 			return nil, e
 		}
+		// Equality doesn't depend on the signedness of the values, so signed() can just be removed
+		left, _ = signedOperand(left)
+		right, _ = signedOperand(right)
 		return tree.Comparison{Op: comparisonOperator[op], Left: left, Right: right}, nil
 	}
 	return left, nil
@@ -187,7 +202,22 @@ func (ctx *parseContext) relationalExpression() (tree.Expression, error) {
 		if e != nil {
 			return nil, e
 		}
+		left, ls := signedOperand(left)
+		right, rs := signedOperand(right)
+		if ls || rs {
+			return tree.Comparison{Op: signedComparisonOperator[op], Left: left, Right: right}, nil
+		}
 		return tree.Comparison{Op: comparisonOperator[op], Left: left, Right: right}, nil
+	case IN, NOTIN:
+		op, _ := ctx.consume()
+		if ctx.next() != LBRACK {
+			return nil, ctx.genErr("'['")
+		}
+		incl := tree.Inclusion{Positive: op == IN, Left: left}
+		if e := ctx.collectInclusionValues(&incl, RBRACK); e != nil {
+			return nil, e
+		}
+		return incl, nil
 	}
 	return left, nil
 }
@@ -308,10 +338,25 @@ func (ctx *parseContext) unaryExpression() (tree.Expression, error) {
 			return nil, e
 		}
 		return tree.Negation{left}, nil
+	case SUB:
+		ctx.consume()
+		left, e := ctx.unaryExpression()
+		if e != nil {
+			return nil, e
+		}
+		return tree.Arithmetic{Op: tree.MINUS, Left: tree.NumericLiteral{0}, Right: left}, nil
 	}
 	return ctx.primary()
 }
 
+// signedOperand removes a signed() wrapper from one side of a comparison, and returns true if it was there
+func signedOperand(x tree.Expression) (tree.Expression, bool) {
+	if c, ok := x.(tree.Call); ok && strings.ToLower(c.Name) == "signed" {
+		return c.Args[0], true
+	}
+	return x, false
+}
+
 func (ctx *parseContext) collectArgs() ([]tree.Any, error) {
 	args := []tree.Any{}
 	for ctx.next() != RPAREN {
@@ -332,24 +377,40 @@ func (ctx *parseContext) collectArgs() ([]tree.Any, error) {
 	return args, nil
 }
 
-func (ctx *parseContext) collectNumerics() ([]tree.Numeric, error) {
-	args := []tree.Numeric{}
-	for ctx.next() != RPAREN {
-		ctx.consume() //consume the opening paren, or last comma
-		res, e := ctx.logicalORExpression()
-		if e != nil {
-			return nil, e
+// collectInclusionValues parses the values of an inclusion expression until the closing token is found. A value can be
+// a numeric expression, a syscall group, or a range of values written as low..high
+func (ctx *parseContext) collectInclusionValues(incl *tree.Inclusion, closing token) error {
+	for ctx.next() != closing {
+		ctx.consume() //consume the opening bracket, or last comma
+		if ctx.next() == GROUP {
+			// A syscall group stands for the numbers of all syscalls in it, which will be filled in by the unifier
+			_, data := ctx.consume()
+			incl.Rights = append(incl.Rights, tree.Variable{Name: string(data)})
+		} else {
+			res, e := ctx.logicalORExpression()
+			if e != nil {
+				return e
+			}
+			if ctx.next() == DOTDOT {
+				ctx.consume()
+				high, e := ctx.logicalORExpression()
+				if e != nil {
+					return e
+				}
+				incl.Ranges = append(incl.Ranges, tree.Range{Low: res, High: high})
+			} else {
+				incl.Rights = append(incl.Rights, res)
+			}
 		}
-		args = append(args, res)
 		switch ctx.next() {
-		case RPAREN, COMMA:
+		case closing, COMMA:
 			// Do nothing here
 		default:
-			return nil, ctx.genErr("')' or ','")
+			return ctx.genErr(fmt.Sprintf("'%s' or ','", tokens[closing]))
 		}
 	}
 	ctx.consume()
-	return args, nil
+	return nil
 }
 
 func (ctx *parseContext) primary() (tree.Expression, error) {
@@ -368,7 +429,8 @@ func (ctx *parseContext) primary() (tree.Expression, error) {
 	case ARG:
 		_, data := ctx.consume()
 		tp := tree.Full
-		pref := strings.TrimPrefix(string(data), "arg")
+		isIP := strings.HasPrefix(string(data), "ip")
+		pref := strings.TrimPrefix(strings.TrimPrefix(string(data), "arg"), "ip")
 		if strings.HasPrefix(pref, "H") {
 			tp = tree.Hi
 			pref = strings.TrimPrefix(pref, "H")
@@ -377,6 +439,9 @@ func (ctx *parseContext) primary() (tree.Expression, error) {
 			tp = tree.Low
 			pref = strings.TrimPrefix(pref, "L")
 		}
+		if isIP {
+			return tree.Argument{Index: tree.InstructionPointer, Type: tp}, nil
+		}
 		val, _ := strconv.Atoi(pref)
 		// This should never error out
 		return tree.Argument{Index: val, Type: tp}, nil
@@ -387,17 +452,36 @@ func (ctx *parseContext) primary() (tree.Expression, error) {
 			if e != nil {
 				return nil, e
 			}
+			if op, ok := bitPredicates[strings.ToLower(string(data))]; ok {
+				if len(args) != 2 {
+					return nil, fmt.Errorf("%s takes a value and a mask, but was given %d arguments", data, len(args))
+				}
+				return tree.Comparison{Op: op, Left: args[0], Right: args[1]}, nil
+			}
+			if strings.ToLower(string(data)) == "signed" && len(args) != 1 {
+				return nil, fmt.Errorf("%s takes one value, but was given %d arguments", data, len(args))
+			}
 			return tree.Call{Name: string(data), Args: args}, nil
 		}
 		return tree.Variable{string(data)}, nil
 	case IN, NOTIN:
 		op, _ := ctx.consume()
 		if ctx.next() == LPAREN {
-			all, e := ctx.collectNumerics()
+			ctx.consume()
+			left, e := ctx.logicalORExpression()
 			if e != nil {
 				return nil, e
 			}
-			return tree.Inclusion{Positive: op == IN, Left: all[0], Rights: all[1:]}, nil
+			incl := tree.Inclusion{Positive: op == IN, Left: left}
+			switch ctx.next() {
+			case RPAREN, COMMA:
+				if e := ctx.collectInclusionValues(&incl, RPAREN); e != nil {
+					return nil, e
+				}
+			default:
+				return nil, ctx.genErr("')' or ','")
+			}
+			return incl, nil
 		}
 		return nil, ctx.genErr("'('")
 	case INT:
@@ -411,6 +495,9 @@ func (ctx *parseContext) primary() (tree.Expression, error) {
 	case FALSE:
 		ctx.consume()
 		return tree.BooleanLiteral{false}, nil
+	case GROUP:
+		_, data := ctx.consume()
+		return nil, fmt.Errorf("syscall group %s can only be used in the list of an in or notIn expression", data)
 	case EOF:
 		return nil, errors.New("unexpected end of line")
 	}
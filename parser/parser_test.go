@@ -272,6 +272,38 @@ func (s *ParserSuite) Test_parseAExpressionBinaryNegation(c *C) {
 	c.Assert(parseExpectSuccess(c, "arg0 == ~0"), Equals, "(eq arg0 (binNeg 0))")
 }
 
+func (s *ParserSuite) Test_parseAExpressionWithInstructionPointer(c *C) {
+	result, _, _, _ := parseExpression("ip in [0x401000..0x401FFF] && ipH == 0 && ipL > 1")
+	c.Assert(result, DeepEquals, tree.And{
+		Left: tree.Inclusion{Positive: true,
+			Left:   tree.Argument{Type: tree.Full, Index: tree.InstructionPointer},
+			Ranges: []tree.Range{tree.Range{Low: tree.NumericLiteral{0x401000}, High: tree.NumericLiteral{0x401FFF}}}},
+		Right: tree.And{
+			Left:  tree.Comparison{Op: tree.EQL, Left: tree.Argument{Type: tree.Hi, Index: tree.InstructionPointer}, Right: tree.NumericLiteral{0}},
+			Right: tree.Comparison{Op: tree.GT, Left: tree.Argument{Type: tree.Low, Index: tree.InstructionPointer}, Right: tree.NumericLiteral{1}},
+		},
+	})
+	c.Assert(tree.ExpressionString(result), Equals, "(and (in ip (range 4198400 4202495)) (and (eq ipH 0) (gt ipL 1)))")
+}
+
+func (s *ParserSuite) Test_parseAExpressionWithUnaryMinus(c *C) {
+	c.Assert(parseExpectSuccess(c, "arg0 == -1"), Equals, "(eq arg0 (minus 0 1))")
+	c.Assert(parseExpectSuccess(c, "arg0 == 2 * -AT_FDCWD"), Equals, "(eq arg0 (mul 2 (minus 0 AT_FDCWD)))")
+}
+
+func (s *ParserSuite) Test_parseAExpressionWithSignedComparison(c *C) {
+	c.Assert(parseExpectSuccess(c, "signed(arg0) <= 0"), Equals, "(slte arg0 0)")
+	c.Assert(parseExpectSuccess(c, "-100 < signed(argL1)"), Equals, "(slt (minus 0 100) argL1)")
+	c.Assert(parseExpectSuccess(c, "signed(arg0 & 0xFF) > Signed(arg1)"), Equals, "(sgt (binand arg0 255) arg1)")
+	c.Assert(parseExpectSuccess(c, "signed(arg0) >= 1"), Equals, "(sgte arg0 1)")
+	c.Assert(parseExpectSuccess(c, "signed(arg0) == -1"), Equals, "(eq arg0 (minus 0 1))")
+}
+
+func (s *ParserSuite) Test_invalidSigned(c *C) {
+	_, _, _, err := parseExpression("signed(arg0, arg1) > 0")
+	c.Assert(err, ErrorMatches, ".*signed takes one value, but was given 2 arguments")
+}
+
 func (s *ParserSuite) Test_parseAExpressionWithBinaryNegationTwice(c *C) {
 	c.Assert(parseExpectSuccess(c, "arg0 == ~~0"), Equals, "(eq arg0 (binNeg (binNeg 0)))")
 }
@@ -359,6 +391,25 @@ func (s *ParserSuite) Test_parseAExpressionWithInclusionWithWhitespace(c *C) {
 	c.Assert(tree.ExpressionString(result), Equals, "(in arg0 1 2 3 4)")
 }
 
+func (s *ParserSuite) Test_parseAExpressionWithRange(c *C) {
+	result, _, _, _ := parseExpression("arg1 in [0x1000..0x2000]")
+	c.Assert(tree.ExpressionString(result), Equals, "(in arg1 (range 4096 8192))")
+
+	result, _, _, _ = parseExpression("arg1 notIn [1, 3..5, @timer]")
+	c.Assert(tree.ExpressionString(result), Equals, "(notIn arg1 1 @timer (range 3 5))")
+
+	result, _, _, _ = parseExpression("in(arg1, 1, 3+1..5)")
+	c.Assert(tree.ExpressionString(result), Equals, "(in arg1 1 (range (plus 3 1) 5))")
+}
+
+func (s *ParserSuite) Test_parseAExpressionWithMaskedInclusion(c *C) {
+	result, _, _, _ := parseExpression("in(arg2 & O_ACCMODE, O_RDONLY, O_RDWR)")
+	c.Assert(tree.ExpressionString(result), Equals, "(in (binand arg2 O_ACCMODE) O_RDONLY O_RDWR)")
+
+	result, _, _, _ = parseExpression("arg2 & 0xFF in [1..2] && arg0 == 1")
+	c.Assert(tree.ExpressionString(result), Equals, "(and (in (binand arg2 255) (range 1 2)) (eq arg0 1))")
+}
+
 func (s *ParserSuite) Test_parseAExpressionWithTrue(c *C) {
 	result, _, _, _ := parseExpression("true")
 	c.Assert(tree.ExpressionString(result), Equals, "true")
@@ -436,6 +487,18 @@ func (s *ParserSuite) Test_parseComplexReturn(c *C) {
 	c.Assert(ret, Equals, uint16(42))
 }
 
+func (s *ParserSuite) Test_parseNamedReturn(c *C) {
+	x, hasReturn, ret, _ := parseExpression("return EPERM")
+	c.Assert(x, IsNil)
+	c.Assert(hasReturn, Equals, true)
+	c.Assert(ret, Equals, uint16(1))
+
+	x, hasReturn, ret, _ = parseExpression("42 == arg0; return ENOENT")
+	c.Assert(tree.ExpressionString(x), Equals, "(eq 42 arg0)")
+	c.Assert(hasReturn, Equals, true)
+	c.Assert(ret, Equals, uint16(2))
+}
+
 func (s *ParserSuite) Test_invalidLiteral(c *C) {
 	_, _, _, err := parseExpression("arg0 == \"foo\"")
 	c.Assert(err, ErrorMatches, "unexpected token at <input>:-1:8: '\"'")
@@ -457,6 +520,19 @@ func (s *ParserSuite) Test_invalidCall(c *C) {
 	c.Assert(err, ErrorMatches, "expression is invalid\\. unable to parse: expected primary expression, found '\\)'")
 }
 
+func (s *ParserSuite) Test_parseBitPredicates(c *C) {
+	result, _, _, _ := parseExpression("onlyBits(arg2, O_RDWR | O_CLOEXEC)")
+	c.Assert(tree.ExpressionString(result), Equals, "(onlyBits arg2 (binor O_RDWR O_CLOEXEC))")
+
+	result, _, _, _ = parseExpression("allbits(arg1, 3) && ANYBITS(argL0, 4) || noBits(arg3, 1)")
+	c.Assert(tree.ExpressionString(result), Equals, "(or (and (allBits arg1 3) (anyBits argL0 4)) (noBits arg3 1))")
+}
+
+func (s *ParserSuite) Test_invalidBitPredicate(c *C) {
+	_, _, _, err := parseExpression("onlyBits(arg2, 1, 2)")
+	c.Assert(err, ErrorMatches, ".*onlyBits takes a value and a mask, but was given 3 arguments")
+}
+
 func (s *ParserSuite) Test_invalidCall2(c *C) {
 	_, _, _, err := parseExpression("foo(1,2,3")
 	c.Assert(err, ErrorMatches, "expression is invalid\\. unable to parse: expected '\\)' or ',', found EOF")
@@ -472,6 +548,17 @@ func (s *ParserSuite) Test_invalidIn2(c *C) {
 	c.Assert(err, ErrorMatches, "expression is invalid\\. unable to parse: expected '\\)' or ',', found EOF")
 }
 
+func (s *ParserSuite) Test_invalidRange(c *C) {
+	_, _, _, err := parseExpression("arg0 in (1..2)")
+	c.Assert(err, ErrorMatches, "expression is invalid\\. unable to parse: expected '\\[', found '\\('")
+
+	_, _, _, err = parseExpression("arg0 in [1..2")
+	c.Assert(err, ErrorMatches, "expression is invalid\\. unable to parse: expected '\\]' or ',', found EOF")
+
+	_, _, _, err = parseExpression("arg0 in [1..2..3]")
+	c.Assert(err, ErrorMatches, "expression is invalid\\. unable to parse: expected '\\]' or ',', found '\\.\\.'")
+}
+
 func (s *ParserSuite) Test_invalidIn3(c *C) {
 	_, _, _, err := parseExpression("in 2")
 	c.Assert(err, ErrorMatches, "expression is invalid\\. unable to parse: expected '\\(', found 'INT' 2")
@@ -4,12 +4,21 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
+	"github.com/twtiger/gosecco/constants"
 	"github.com/twtiger/gosecco/tree"
 )
 
-var ruleHeadRE = regexp.MustCompile(`^[[:space:]]*([[:word:]]+)[[:space:]]*(?:\[(.*)\])?[[:space:]]*$`)
+// ruleHeadName matches the names that can be used in a rule head. Apart from syscall and group names, a syscall
+// can be given by number as syscall(435), for syscalls that don't have a known name yet
+const ruleHeadName = `(?:syscall[[:space:]]*\([[:space:]]*[[:alnum:]]+[[:space:]]*\)|` + syscallOrGroupName + `)`
+
+var (
+	ruleHeadRE   = regexp.MustCompile(`^[[:space:]]*(` + ruleHeadName + `(?:[[:space:]]*,[[:space:]]*` + ruleHeadName + `)*)[[:space:]]*(?:\[(.*)\])?[[:space:]]*$`)
+	rawSyscallRE = regexp.MustCompile(`^syscall[[:space:]]*\([[:space:]]*([[:alnum:]]+)[[:space:]]*\)$`)
+)
 
 func findPositiveAndNegative(ss []string) (string, string, bool) {
 	neg, pos := "", ""
@@ -34,33 +43,145 @@ func findPositiveAndNegative(ss []string) (string, string, bool) {
 	return pos, neg, true
 }
 
-func parseRuleHead(s string) (tree.Rule, bool) {
+// IsValidAction returns true if the action is one of the named actions, or something that can be used as an errno.
+// The empty action is valid, and means that the default action should be used
+func IsValidAction(s string) bool {
+	switch strings.ToLower(s) {
+	case "", "trap", "kill", "allow", "trace":
+		return true
+	}
+	_, err := parseErrno(s)
+	return err == nil
+}
+
+// ruleName returns the name to use for a syscall in a rule head. Syscalls given by number get the name used
+// for raw syscalls in the constants package
+func ruleName(name string) (string, bool) {
+	name = strings.TrimSpace(name)
+	if match := rawSyscallRE.FindStringSubmatch(name); match != nil {
+		nr, err := strconv.ParseUint(match[1], 0, 32)
+		if err != nil {
+			return "", false
+		}
+		return constants.RawSyscallName(uint32(nr)), true
+	}
+	return name, true
+}
+
+func parseRuleHead(s string) ([]tree.Rule, bool) {
 	match := ruleHeadRE.FindStringSubmatch(s)
 	if match != nil {
 		positive, negative, ok := findPositiveAndNegative(strings.Split(match[2], ","))
-		return tree.Rule{Name: match[1], PositiveAction: positive, NegativeAction: negative}, ok
+		result := []tree.Rule{}
+		for _, n := range strings.Split(match[1], ",") {
+			name, okName := ruleName(n)
+			if !okName {
+				return nil, false
+			}
+			result = append(result, tree.Rule{Name: name, PositiveAction: positive, NegativeAction: negative})
+		}
+		return result, ok
 	}
-	return tree.Rule{}, false
+	return nil, false
 }
 
-func parseRule(s string) (tree.Rule, error) {
+// parseRule parses a rule line. A rule can list more than one syscall in the head, in which case
+// one rule will be returned for each one of them, sharing the actions and the body
+func parseRule(s string) ([]tree.Rule, error) {
 	parts := strings.SplitN(s, ":", 2) //This shouldn't fail since we will never hit this case unless linetype told us to
-	rule, ok := parseRuleHead(parts[0])
+	rules, ok := parseRuleHead(parts[0])
 	if !ok {
-		return tree.Rule{}, errors.New("Invalid specification of syscall name")
+		return nil, errors.New("Invalid specification of syscall name")
+	}
+
+	for _, a := range []string{rules[0].PositiveAction, rules[0].NegativeAction} {
+		if !IsValidAction(a) {
+			return nil, fmt.Errorf("Invalid return action '%s'", a)
+		}
 	}
 
 	if len(parts) < 2 || len(strings.TrimSpace(parts[1])) == 0 {
-		return tree.Rule{}, fmt.Errorf("No expression specified for rule: %s", strings.TrimSpace(parts[0]))
+		return nil, fmt.Errorf("No expression specified for rule: %s", strings.TrimSpace(parts[0]))
+	}
+
+	if isOutcomes(parts[1]) {
+		return parseOutcomeRule(rules, parts[1])
 	}
 
 	x, hasReturn, ret, err := parseExpression(parts[1])
 	if err != nil {
-		return tree.Rule{}, err
+		return nil, err
+	}
+	if x == nil {
+		// A rule with only a return always returns the error
+		x = tree.BooleanLiteral{true}
+	}
+	for ix := range rules {
+		if hasReturn {
+			rules[ix].PositiveAction = fmt.Sprintf("%d", ret)
+		}
+		rules[ix].Body = x
+	}
+	return rules, nil
+}
+
+func isOutcomes(s string) bool {
+	return strings.Contains(s, "=>")
+}
+
+// parseOutcomeRule parses the body of a rule with several outcomes, such as "arg0 == 1 => EACCES; else => allow"
+func parseOutcomeRule(rules []tree.Rule, s string) ([]tree.Rule, error) {
+	if rules[0].PositiveAction != "" {
+		return nil, errors.New("A rule with several outcomes can not have a positive action")
+	}
+
+	outcomes := []tree.Outcome{}
+	clauses := strings.Split(s, ";")
+	for ix, c := range clauses {
+		o, err := parseOutcome(c)
+		if err != nil {
+			return nil, err
+		}
+		if o.Condition == nil {
+			if ix != len(clauses)-1 {
+				return nil, errors.New("The else outcome has to be the last one in a rule")
+			}
+			o.Condition = tree.BooleanLiteral{Value: true}
+		}
+		outcomes = append(outcomes, o)
+	}
+
+	for ix := range rules {
+		rules[ix].Outcomes = outcomes
+	}
+	return rules, nil
+}
+
+func parseOutcome(s string) (tree.Outcome, error) {
+	parts := strings.SplitN(s, "=>", 2)
+	if len(parts) < 2 {
+		return tree.Outcome{}, fmt.Errorf("No action specified for outcome: %s", strings.TrimSpace(s))
+	}
+
+	action := strings.TrimSpace(parts[1])
+	if action == "" || !IsValidAction(action) {
+		return tree.Outcome{}, fmt.Errorf("Invalid return action '%s'", action)
+	}
+
+	cond := strings.TrimSpace(parts[0])
+	if strings.ToLower(cond) == "else" {
+		return tree.Outcome{Action: action}, nil
+	}
+	if cond == "" {
+		return tree.Outcome{}, fmt.Errorf("No condition specified for outcome: %s", strings.TrimSpace(s))
+	}
+
+	x, hasReturn, _, err := parseExpression(cond)
+	if err != nil {
+		return tree.Outcome{}, err
 	}
 	if hasReturn {
-		rule.PositiveAction = fmt.Sprintf("%d", ret)
+		return tree.Outcome{}, errors.New("Return can not be used in a rule with several outcomes")
 	}
-	rule.Body = x
-	return rule, nil
+	return tree.Outcome{Condition: x, Action: action}, nil
 }
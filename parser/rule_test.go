@@ -12,7 +12,7 @@ var _ = Suite(&RuleSuite{})
 func parseRuleHeadCheck(c *C, s string, r tree.Rule) {
 	res, ok := parseRuleHead(s)
 	c.Assert(ok, Equals, true)
-	c.Check(res, Equals, r)
+	c.Check(res, DeepEquals, []tree.Rule{r})
 }
 
 func (s *RuleSuite) Test_parseRuleHead_parsesValidRuleHeads(c *C) {
@@ -38,9 +38,132 @@ func (s *RuleSuite) Test_parseRuleHead_parsesValidRuleHeads(c *C) {
 
 	_, ok = parseRuleHead("fcntl[hm]")
 	c.Assert(ok, Equals, false)
+
+	_, ok = parseRuleHead("read, [+kill]")
+	c.Assert(ok, Equals, false)
+
+	_, ok = parseRuleHead("read pread64")
+	c.Assert(ok, Equals, false)
+}
+
+func (s *RuleSuite) Test_parseRuleHead_parsesSeveralSyscalls(c *C) {
+	res, ok := parseRuleHead(" read, pread64 ,readv[+allow, -EBADF] ")
+	c.Assert(ok, Equals, true)
+	c.Assert(res, DeepEquals, []tree.Rule{
+		tree.Rule{Name: "read", PositiveAction: "allow", NegativeAction: "EBADF"},
+		tree.Rule{Name: "pread64", PositiveAction: "allow", NegativeAction: "EBADF"},
+		tree.Rule{Name: "readv", PositiveAction: "allow", NegativeAction: "EBADF"},
+	})
+}
+
+func (s *RuleSuite) Test_parseRuleHead_parsesSyscallNumbers(c *C) {
+	parseRuleHeadCheck(c, "syscall(435)", tree.Rule{Name: "syscall(435)"})
+	parseRuleHeadCheck(c, " syscall ( 0x1B3 ) [+trace] ", tree.Rule{Name: "syscall(435)", PositiveAction: "trace"})
+
+	res, ok := parseRuleHead("read, syscall(435)")
+	c.Assert(ok, Equals, true)
+	c.Assert(res, DeepEquals, []tree.Rule{
+		tree.Rule{Name: "read"},
+		tree.Rule{Name: "syscall(435)"},
+	})
+
+	_, ok = parseRuleHead("syscall(foo)")
+	c.Assert(ok, Equals, false)
+
+	_, ok = parseRuleHead("syscall(0x100000000)")
+	c.Assert(ok, Equals, false)
+
+	_, ok = parseRuleHead("syscall()")
+	c.Assert(ok, Equals, false)
 }
 
 func (s *RuleSuite) Test_parseRule_returnsErrorForInvalidLine(c *C) {
 	_, err := parseRule("  read:  ")
 	c.Assert(err, ErrorMatches, "No expression specified for rule: read")
 }
+
+func (s *RuleSuite) Test_parseRule_acceptsErrnoNamesInReturn(c *C) {
+	r, err := parseRule("read: return EPERM")
+	c.Assert(err, IsNil)
+	c.Assert(r[0].PositiveAction, Equals, "1")
+	c.Assert(r[0].Body, DeepEquals, tree.BooleanLiteral{true})
+
+	r, err = parseRule("read: arg0 == 1; return EACCES")
+	c.Assert(err, IsNil)
+	c.Assert(r[0].PositiveAction, Equals, "13")
+}
+
+func (s *RuleSuite) Test_parseRule_returnsErrorForUnknownErrnoName(c *C) {
+	_, err := parseRule("read: return EBLARG")
+	c.Assert(err, ErrorMatches, "Invalid errno 'EBLARG'")
+
+	_, err = parseRule("read: arg0 == 1; return EBLARG")
+	c.Assert(err, ErrorMatches, "Invalid errno 'EBLARG'")
+}
+
+func (s *RuleSuite) Test_parseRule_validatesActionsInRuleHead(c *C) {
+	r, err := parseRule("read[+EACCES, -kill]: arg0 == 1")
+	c.Assert(err, IsNil)
+	c.Assert(r[0].PositiveAction, Equals, "EACCES")
+
+	_, err = parseRule("read[-Blarg]: arg0 == 1")
+	c.Assert(err, ErrorMatches, "Invalid return action 'Blarg'")
+}
+
+func (s *RuleSuite) Test_parseRule_expandsSeveralSyscallsIntoRules(c *C) {
+	r, err := parseRule("read, pread64, readv[+allow, -EBADF]: arg0 < 100")
+	c.Assert(err, IsNil)
+	c.Assert(r, HasLen, 3)
+	for ix, name := range []string{"read", "pread64", "readv"} {
+		c.Check(r[ix].Name, Equals, name)
+		c.Check(r[ix].PositiveAction, Equals, "allow")
+		c.Check(r[ix].NegativeAction, Equals, "EBADF")
+		c.Check(tree.ExpressionString(r[ix].Body), Equals, "(lt arg0 100)")
+	}
+}
+
+func (s *RuleSuite) Test_parseRule_parsesRuleWithOutcomes(c *C) {
+	r, err := parseRule("openat: arg2 &? O_WRONLY => EACCES; arg2 &? O_RDWR => EPERM; else => allow")
+	c.Assert(err, IsNil)
+	c.Assert(r, HasLen, 1)
+	c.Assert(r[0].Body, IsNil)
+	c.Assert(r[0].Outcomes, HasLen, 3)
+	c.Check(tree.ExpressionString(r[0].Outcomes[0].Condition), Equals, "(bitset arg2 O_WRONLY)")
+	c.Check(r[0].Outcomes[0].Action, Equals, "EACCES")
+	c.Check(tree.ExpressionString(r[0].Outcomes[1].Condition), Equals, "(bitset arg2 O_RDWR)")
+	c.Check(r[0].Outcomes[1].Action, Equals, "EPERM")
+	c.Check(r[0].Outcomes[2].Condition, DeepEquals, tree.BooleanLiteral{true})
+	c.Check(r[0].Outcomes[2].Action, Equals, "allow")
+}
+
+func (s *RuleSuite) Test_parseRule_parsesRuleWithOutcomesAndNegativeAction(c *C) {
+	r, err := parseRule("read, write[-trace]: arg0 == 1 => 42")
+	c.Assert(err, IsNil)
+	c.Assert(r, HasLen, 2)
+	for _, rr := range r {
+		c.Check(rr.NegativeAction, Equals, "trace")
+		c.Check(rr.Outcomes, DeepEquals, []tree.Outcome{
+			tree.Outcome{Condition: tree.Comparison{Op: tree.EQL, Left: tree.Argument{Index: 0}, Right: tree.NumericLiteral{1}}, Action: "42"},
+		})
+	}
+}
+
+func (s *RuleSuite) Test_parseRule_returnsErrorForInvalidOutcomes(c *C) {
+	_, err := parseRule("read: arg0 == 1 => EACCES; arg0 == 2")
+	c.Assert(err, ErrorMatches, "No action specified for outcome: arg0 == 2")
+
+	_, err = parseRule("read: arg0 == 1 => EBLARG")
+	c.Assert(err, ErrorMatches, "Invalid return action 'EBLARG'")
+
+	_, err = parseRule("read: arg0 == 1 => ")
+	c.Assert(err, ErrorMatches, "Invalid return action ''")
+
+	_, err = parseRule("read:  => allow")
+	c.Assert(err, ErrorMatches, "No condition specified for outcome: => allow")
+
+	_, err = parseRule("read: else => allow; arg0 == 1 => kill")
+	c.Assert(err, ErrorMatches, "The else outcome has to be the last one in a rule")
+
+	_, err = parseRule("read[+allow]: arg0 == 1 => kill")
+	c.Assert(err, ErrorMatches, "A rule with several outcomes can not have a positive action")
+}
@@ -18,7 +18,8 @@ type FileSource struct {
 	Filename string
 }
 
-// StringSource contains the definitions as a string
+// StringSource contains the definitions as a string. Include paths in it are resolved as files relative
+// to the current directory, unless it was itself included from a source with a different resolver
 type StringSource struct {
 	// Name is the name to report for this string during parsing errors
 	Name string
@@ -26,6 +27,17 @@ type StringSource struct {
 	Content string
 }
 
+// StringSourceWithResolver contains the definitions as a string, and the resolver
+// used to find the sources referred to by include directives in the string
+type StringSourceWithResolver struct {
+	// Name is the name to report for this string during parsing errors
+	Name string
+	// Content is the actual string containing definitions
+	Content string
+	// Resolver is used to find the sources referred to by include and import directives
+	Resolver IncludeResolver
+}
+
 // CombinedSource allow you to combine more than one source and have them parsed as a unit
 type CombinedSource struct {
 	// Sources is a list of the sources to parse
@@ -39,23 +51,46 @@ func CombineSources(s ...Source) *CombinedSource {
 
 // Parse implements the Source interface by parsing the file
 func (s *FileSource) Parse() (tree.RawPolicy, error) {
+	return newIncludeContext(nil).parse(s)
+}
+
+func (s *FileSource) parseIncluding(ctx *includeContext) (tree.RawPolicy, error) {
 	file, err := ioutil.ReadFile(s.Filename)
 	if err != nil {
 		return tree.RawPolicy{}, err
 	}
-	return parseLines(s.Filename, strings.Split(string(file), "\n"))
+	return parseLines(s.Filename, strings.Split(string(file), "\n"), ctx.withResolver(FileResolver))
 }
 
 // Parse implements the Source interface by parsing the string
 func (s *StringSource) Parse() (tree.RawPolicy, error) {
-	return parseLines(s.Name, strings.Split(s.Content, "\n"))
+	return newIncludeContext(nil).parse(s)
+}
+
+func (s *StringSource) parseIncluding(ctx *includeContext) (tree.RawPolicy, error) {
+	return parseLines(s.Name, strings.Split(s.Content, "\n"), ctx)
+}
+
+// Parse implements the Source interface by parsing the string
+func (s *StringSourceWithResolver) Parse() (tree.RawPolicy, error) {
+	return newIncludeContext(nil).parse(s)
+}
+
+func (s *StringSourceWithResolver) parseIncluding(ctx *includeContext) (tree.RawPolicy, error) {
+	return parseLines(s.Name, strings.Split(s.Content, "\n"), ctx.withResolver(s.Resolver))
 }
 
 // Parse implements the Source interface by parsing each one of the sources
+// Include directives in the different sources will be tracked together, so a file
+// included once will only be included once for all the sources
 func (s *CombinedSource) Parse() (tree.RawPolicy, error) {
+	return newIncludeContext(nil).parse(s)
+}
+
+func (s *CombinedSource) parseIncluding(ctx *includeContext) (tree.RawPolicy, error) {
 	var result []interface{}
 	for _, s := range s.Sources {
-		rp, e := s.Parse()
+		rp, e := ctx.parse(s)
 		if e != nil {
 			return tree.RawPolicy{}, e
 		}
@@ -0,0 +1,17 @@
+	.text
+	.globl _start
+	.type _start, @function
+_start:
+	call allowed_exec
+	mov $60, %eax
+	xor %edi, %edi
+	syscall
+	.size _start, .-_start
+
+	.globl allowed_exec
+	.type allowed_exec, @function
+allowed_exec:
+	mov $59, %eax
+	syscall
+	ret
+	.size allowed_exec, .-allowed_exec
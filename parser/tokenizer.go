@@ -1,29 +1,29 @@
 package parser
 
-var _gosecco_tokenizer_actions []int8 = []int8{0, 1, 0, 1, 1, 1, 2, 1, 9, 1, 10, 1, 11, 1, 12, 1, 13, 1, 14, 1, 15, 1, 16, 1, 17, 1, 18, 1, 19, 1, 20, 1, 21, 1, 22, 1, 23, 1, 24, 1, 25, 1, 26, 1, 27, 1, 28, 1, 29, 1, 30, 1, 31, 1, 32, 1, 33, 1, 34, 1, 35, 1, 36, 1, 37, 1, 38, 1, 39, 1, 40, 1, 41, 1, 42, 1, 43, 1, 44, 2, 2, 3, 2, 2, 4, 2, 2, 5, 2, 2, 6, 2, 2, 7, 2, 2, 8, 0}
-var _gosecco_tokenizer_key_offsets []int16 = []int16{0, 2, 8, 45, 47, 48, 50, 56, 58, 60, 66, 68, 70, 71, 73, 80, 89, 98, 107, 116, 125, 134, 143, 152, 161, 170, 179, 188, 196, 204, 215, 224, 0}
-var _gosecco_tokenizer_trans_keys []byte = []byte{48, 49, 48, 57, 65, 70, 97, 102, 9, 32, 33, 37, 38, 40, 41, 42, 43, 44, 45, 47, 48, 60, 61, 62, 70, 73, 78, 84, 91, 93, 94, 95, 97, 102, 105, 110, 116, 124, 126, 49, 57, 65, 90, 98, 122, 9, 32, 61, 38, 63, 66, 88, 98, 120, 48, 55, 48, 55, 48, 49, 48, 57, 65, 70, 97, 102, 48, 57, 60, 61, 61, 61, 62, 95, 48, 57, 65, 90, 97, 122, 65, 95, 97, 48, 57, 66, 90, 98, 122, 76, 95, 108, 48, 57, 65, 90, 97, 122, 83, 95, 115, 48, 57, 65, 90, 97, 122, 69, 95, 101, 48, 57, 65, 90, 97, 122, 78, 95, 110, 48, 57, 65, 90, 97, 122, 79, 95, 111, 48, 57, 65, 90, 97, 122, 84, 95, 116, 48, 57, 65, 90, 97, 122, 73, 95, 105, 48, 57, 65, 90, 97, 122, 78, 95, 110, 48, 57, 65, 90, 97, 122, 82, 95, 114, 48, 57, 65, 90, 97, 122, 85, 95, 117, 48, 57, 65, 90, 97, 122, 69, 95, 101, 48, 57, 65, 90, 97, 122, 95, 114, 48, 57, 65, 90, 97, 122, 95, 103, 48, 57, 65, 90, 97, 122, 72, 76, 95, 48, 53, 54, 57, 65, 90, 97, 122, 95, 48, 53, 54, 57, 65, 90, 97, 122, 124, 0}
-var _gosecco_tokenizer_single_lengths []int8 = []int8{0, 0, 31, 2, 1, 2, 4, 0, 0, 0, 0, 2, 1, 2, 1, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 2, 2, 3, 1, 1, 0}
-var _gosecco_tokenizer_range_lengths []int8 = []int8{1, 3, 3, 0, 0, 0, 1, 1, 1, 3, 1, 0, 0, 0, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 4, 0, 0}
-var _gosecco_tokenizer_index_offsets []int16 = []int16{0, 2, 6, 41, 44, 46, 49, 55, 57, 59, 63, 65, 68, 70, 73, 78, 85, 92, 99, 106, 113, 120, 127, 134, 141, 148, 155, 162, 168, 174, 182, 188, 0}
-var _gosecco_tokenizer_trans_cond_spaces []int8 = []int8{-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0}
-var _gosecco_tokenizer_trans_offsets []int16 = []int16{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116, 117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132, 133, 134, 135, 136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146, 147, 148, 149, 150, 151, 152, 153, 154, 155, 156, 157, 158, 159, 160, 161, 162, 163, 164, 165, 166, 167, 168, 169, 170, 171, 172, 173, 174, 175, 176, 177, 178, 179, 180, 181, 182, 183, 184, 185, 186, 187, 188, 189, 190, 191, 192, 193, 194, 195, 196, 197, 198, 199, 200, 201, 202, 203, 204, 205, 206, 207, 208, 209, 210, 211, 212, 213, 214, 215, 216, 217, 218, 219, 220, 0}
-var _gosecco_tokenizer_trans_lengths []int8 = []int8{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0}
-var _gosecco_tokenizer_cond_keys []int8 = []int8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
-var _gosecco_tokenizer_cond_targs []int8 = []int8{8, 2, 9, 9, 9, 2, 3, 3, 4, 2, 5, 2, 2, 2, 2, 2, 2, 2, 6, 11, 12, 13, 15, 19, 20, 24, 2, 2, 2, 14, 27, 15, 19, 20, 24, 31, 2, 10, 14, 14, 2, 3, 3, 2, 2, 2, 2, 2, 2, 0, 1, 0, 1, 7, 2, 7, 2, 8, 2, 9, 9, 9, 2, 10, 2, 2, 2, 2, 2, 2, 2, 2, 2, 14, 14, 14, 14, 2, 16, 14, 16, 14, 14, 14, 2, 17, 14, 17, 14, 14, 14, 2, 18, 14, 18, 14, 14, 14, 2, 14, 14, 14, 14, 14, 14, 2, 14, 14, 14, 14, 14, 14, 2, 21, 14, 21, 14, 14, 14, 2, 22, 14, 22, 14, 14, 14, 2, 23, 14, 23, 14, 14, 14, 2, 14, 14, 14, 14, 14, 14, 2, 25, 14, 25, 14, 14, 14, 2, 26, 14, 26, 14, 14, 14, 2, 14, 14, 14, 14, 14, 14, 2, 14, 28, 14, 14, 14, 2, 14, 29, 14, 14, 14, 2, 30, 30, 14, 14, 14, 14, 14, 2, 14, 14, 14, 14, 14, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 0}
-var _gosecco_tokenizer_cond_actions []int8 = []int8{0, 75, 0, 0, 0, 75, 0, 0, 0, 15, 0, 39, 43, 11, 7, 47, 9, 13, 5, 0, 0, 0, 0, 0, 0, 0, 41, 45, 23, 94, 0, 0, 0, 0, 0, 0, 29, 0, 94, 94, 49, 0, 0, 71, 37, 69, 19, 17, 61, 0, 0, 0, 0, 0, 59, 0, 55, 0, 57, 0, 0, 0, 53, 0, 59, 25, 33, 65, 31, 73, 35, 27, 67, 94, 94, 94, 94, 77, 0, 94, 0, 94, 94, 94, 51, 0, 94, 0, 94, 94, 94, 51, 0, 94, 0, 94, 94, 94, 51, 91, 94, 91, 94, 94, 94, 51, 82, 94, 82, 94, 94, 94, 51, 0, 94, 0, 94, 94, 94, 51, 0, 94, 0, 94, 94, 94, 51, 0, 94, 0, 94, 94, 94, 51, 85, 94, 85, 94, 94, 94, 51, 0, 94, 0, 94, 94, 94, 51, 0, 94, 0, 94, 94, 94, 51, 88, 94, 88, 94, 94, 94, 51, 94, 0, 94, 94, 94, 51, 94, 0, 94, 94, 94, 51, 0, 0, 94, 79, 94, 94, 94, 51, 94, 79, 94, 94, 94, 51, 21, 63, 75, 75, 71, 69, 61, 59, 55, 57, 53, 59, 65, 73, 67, 77, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 63, 0}
-var _gosecco_tokenizer_to_state_actions []int8 = []int8{0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
-var _gosecco_tokenizer_from_state_actions []int8 = []int8{0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
-var _gosecco_tokenizer_eof_trans_indexed []int8 = []int8{3, 3, 0, 17, 18, 20, 23, 24, 25, 26, 23, 27, 30, 32, 35, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 37, 0}
-var _gosecco_tokenizer_eof_trans_direct []int16 = []int16{191, 192, 0, 193, 194, 195, 196, 197, 198, 199, 200, 201, 202, 203, 204, 205, 206, 207, 208, 209, 210, 211, 212, 213, 214, 215, 216, 217, 218, 219, 220, 221, 0}
+var _gosecco_tokenizer_actions []int8 = []int8{0, 1, 0, 1, 1, 1, 2, 1, 9, 1, 10, 1, 11, 1, 12, 1, 13, 1, 14, 1, 15, 1, 16, 1, 17, 1, 18, 1, 19, 1, 20, 1, 21, 1, 22, 1, 23, 1, 24, 1, 25, 1, 26, 1, 27, 1, 28, 1, 29, 1, 30, 1, 31, 1, 32, 1, 33, 1, 34, 1, 35, 1, 36, 1, 37, 1, 38, 1, 39, 1, 40, 1, 41, 1, 42, 1, 43, 1, 44, 1, 45, 1, 46, 2, 2, 3, 2, 2, 4, 2, 2, 5, 2, 2, 6, 2, 2, 7, 2, 2, 8, 0}
+var _gosecco_tokenizer_key_offsets []int16 = []int16{0, 2, 8, 13, 68, 70, 71, 73, 74, 80, 82, 84, 85, 87, 92, 100, 110, 124, 138, 152, 163, 179, 180, 182, 190, 204, 212, 226, 240, 251, 265, 267, 273, 287, 301, 315, 331, 345, 359, 0}
+var _gosecco_tokenizer_trans_keys []byte = []byte{48, 49, 48, 57, 65, 70, 97, 102, 95, 65, 90, 97, 122, 9, 32, 33, 37, 38, 40, 41, 42, 43, 44, 45, 46, 47, 48, 60, 61, 62, 64, 70, 73, 78, 84, 91, 93, 94, 95, 97, 102, 105, 110, 116, 124, 126, 49, 57, 65, 69, 71, 72, 74, 77, 79, 83, 85, 90, 98, 101, 103, 104, 106, 109, 111, 115, 117, 122, 9, 32, 61, 38, 63, 46, 66, 88, 98, 120, 48, 55, 48, 57, 60, 61, 61, 61, 62, 95, 65, 90, 97, 122, 46, 95, 48, 57, 65, 90, 97, 122, 46, 65, 95, 97, 48, 57, 66, 90, 98, 122, 46, 78, 95, 110, 48, 57, 65, 77, 79, 90, 97, 109, 111, 122, 46, 79, 95, 111, 48, 57, 65, 78, 80, 90, 97, 110, 112, 122, 46, 82, 95, 114, 48, 57, 65, 81, 83, 90, 97, 113, 115, 122, 46, 95, 114, 48, 57, 65, 90, 97, 113, 115, 122, 46, 78, 95, 110, 111, 112, 48, 57, 65, 77, 79, 90, 97, 109, 113, 122, 124, 48, 55, 45, 95, 48, 57, 65, 90, 97, 122, 46, 76, 95, 108, 48, 57, 65, 75, 77, 90, 97, 107, 109, 122, 46, 95, 48, 57, 65, 90, 97, 122, 46, 84, 95, 116, 48, 57, 65, 83, 85, 90, 97, 115, 117, 122, 46, 85, 95, 117, 48, 57, 65, 84, 86, 90, 97, 116, 118, 122, 46, 95, 103, 48, 57, 65, 90, 97, 102, 104, 122, 46, 72, 76, 95, 48, 57, 65, 71, 73, 75, 77, 90, 97, 122, 48, 49, 48, 57, 65, 70, 97, 102, 46, 83, 95, 115, 48, 57, 65, 82, 84, 90, 97, 114, 116, 122, 46, 73, 95, 105, 48, 57, 65, 72, 74, 90, 97, 104, 106, 122, 46, 69, 95, 101, 48, 57, 65, 68, 70, 90, 97, 100, 102, 122, 46, 72, 76, 95, 48, 53, 54, 57, 65, 71, 73, 75, 77, 90, 97, 122, 46, 69, 95, 101, 48, 57, 65, 68, 70, 90, 97, 100, 102, 122, 46, 78, 95, 110, 48, 57, 65, 77, 79, 90, 97, 109, 111, 122, 46, 95, 48, 53, 54, 57, 65, 90, 97, 122, 0}
+var _gosecco_tokenizer_single_lengths []int8 = []int8{0, 0, 1, 33, 2, 1, 2, 1, 4, 0, 2, 1, 2, 1, 2, 4, 4, 4, 4, 3, 6, 1, 0, 2, 4, 2, 4, 4, 3, 4, 0, 0, 4, 4, 4, 4, 4, 4, 2, 0}
+var _gosecco_tokenizer_range_lengths []int8 = []int8{1, 3, 2, 11, 0, 0, 0, 0, 1, 1, 0, 0, 0, 2, 3, 3, 5, 5, 5, 4, 5, 0, 1, 3, 5, 3, 5, 5, 4, 5, 1, 3, 5, 5, 5, 6, 5, 5, 4, 0}
+var _gosecco_tokenizer_index_offsets []int16 = []int16{0, 2, 6, 10, 55, 58, 60, 63, 65, 71, 73, 76, 78, 81, 85, 91, 99, 109, 119, 129, 137, 149, 151, 153, 159, 169, 175, 185, 195, 203, 213, 215, 219, 229, 239, 249, 260, 270, 280, 0}
+var _gosecco_tokenizer_trans_cond_spaces []int8 = []int8{-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0}
+var _gosecco_tokenizer_trans_offsets []int16 = []int16{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116, 117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132, 133, 134, 135, 136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146, 147, 148, 149, 150, 151, 152, 153, 154, 155, 156, 157, 158, 159, 160, 161, 162, 163, 164, 165, 166, 167, 168, 169, 170, 171, 172, 173, 174, 175, 176, 177, 178, 179, 180, 181, 182, 183, 184, 185, 186, 187, 188, 189, 190, 191, 192, 193, 194, 195, 196, 197, 198, 199, 200, 201, 202, 203, 204, 205, 206, 207, 208, 209, 210, 211, 212, 213, 214, 215, 216, 217, 218, 219, 220, 221, 222, 223, 224, 225, 226, 227, 228, 229, 230, 231, 232, 233, 234, 235, 236, 237, 238, 239, 240, 241, 242, 243, 244, 245, 246, 247, 248, 249, 250, 251, 252, 253, 254, 255, 256, 257, 258, 259, 260, 261, 262, 263, 264, 265, 266, 267, 268, 269, 270, 271, 272, 273, 274, 275, 276, 277, 278, 279, 280, 281, 282, 283, 284, 285, 286, 287, 288, 289, 290, 291, 292, 293, 294, 295, 296, 297, 298, 299, 300, 301, 302, 303, 304, 305, 306, 307, 308, 309, 310, 311, 312, 313, 314, 315, 316, 317, 318, 319, 320, 321, 322, 323, 324, 0}
+var _gosecco_tokenizer_trans_lengths []int8 = []int8{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0}
+var _gosecco_tokenizer_cond_keys []int8 = []int8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+var _gosecco_tokenizer_cond_targs []int8 = []int8{30, 3, 31, 31, 31, 3, 14, 14, 14, 3, 4, 4, 5, 3, 6, 3, 3, 3, 3, 3, 3, 7, 3, 8, 10, 11, 12, 13, 15, 16, 17, 18, 3, 3, 3, 14, 19, 15, 20, 17, 18, 21, 3, 9, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 3, 4, 4, 3, 3, 3, 3, 3, 3, 3, 3, 0, 1, 0, 1, 22, 3, 9, 3, 3, 3, 3, 3, 3, 3, 3, 3, 23, 23, 23, 3, 2, 14, 14, 14, 14, 3, 2, 24, 14, 24, 14, 14, 14, 3, 2, 25, 14, 25, 14, 14, 14, 14, 14, 3, 2, 26, 14, 26, 14, 14, 14, 14, 14, 3, 2, 27, 14, 27, 14, 14, 14, 14, 14, 3, 2, 14, 28, 14, 14, 14, 14, 3, 2, 25, 14, 25, 14, 29, 14, 14, 14, 14, 14, 3, 3, 3, 22, 3, 23, 23, 23, 23, 23, 3, 2, 32, 14, 32, 14, 14, 14, 14, 14, 3, 2, 14, 14, 14, 14, 3, 2, 33, 14, 33, 14, 14, 14, 14, 14, 3, 2, 34, 14, 34, 14, 14, 14, 14, 14, 3, 2, 14, 35, 14, 14, 14, 14, 3, 2, 25, 25, 14, 14, 14, 14, 14, 14, 3, 30, 3, 31, 31, 31, 3, 2, 36, 14, 36, 14, 14, 14, 14, 14, 3, 2, 37, 14, 37, 14, 14, 14, 14, 14, 3, 2, 25, 14, 25, 14, 14, 14, 14, 14, 3, 2, 38, 38, 14, 25, 14, 14, 14, 14, 14, 3, 2, 25, 14, 25, 14, 14, 14, 14, 14, 3, 2, 25, 14, 25, 14, 14, 14, 14, 14, 3, 2, 14, 25, 14, 14, 14, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 0}
+var _gosecco_tokenizer_cond_actions []int8 = []int8{5, 79, 5, 5, 5, 79, 98, 98, 98, 81, 5, 5, 5, 15, 5, 39, 43, 11, 7, 47, 9, 5, 13, 5, 5, 5, 5, 5, 98, 98, 98, 98, 41, 45, 23, 98, 98, 98, 98, 98, 98, 5, 29, 5, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 51, 5, 5, 75, 37, 73, 19, 17, 65, 49, 77, 0, 0, 0, 0, 5, 63, 5, 63, 25, 33, 69, 31, 77, 35, 27, 71, 5, 5, 5, 77, 0, 98, 98, 98, 98, 53, 0, 98, 98, 98, 98, 98, 98, 53, 0, 86, 98, 86, 98, 98, 98, 98, 98, 53, 0, 98, 98, 98, 98, 98, 98, 98, 98, 53, 0, 98, 98, 98, 98, 98, 98, 98, 98, 53, 0, 98, 98, 98, 98, 98, 98, 53, 0, 86, 98, 86, 98, 83, 98, 98, 98, 98, 98, 53, 21, 67, 5, 59, 5, 5, 5, 5, 5, 55, 0, 98, 98, 98, 98, 98, 98, 98, 98, 53, 0, 98, 98, 98, 98, 81, 0, 98, 98, 98, 98, 98, 98, 98, 98, 53, 0, 98, 98, 98, 98, 98, 98, 98, 98, 53, 0, 98, 98, 98, 98, 98, 98, 53, 0, 83, 83, 98, 98, 98, 98, 98, 98, 81, 5, 61, 5, 5, 5, 57, 0, 98, 98, 98, 98, 98, 98, 98, 98, 53, 0, 98, 98, 98, 98, 98, 98, 98, 98, 53, 0, 92, 98, 92, 98, 98, 98, 98, 98, 53, 0, 98, 98, 98, 83, 98, 98, 98, 98, 98, 53, 0, 95, 98, 95, 98, 98, 98, 98, 98, 53, 0, 89, 98, 89, 98, 98, 98, 98, 98, 53, 0, 98, 83, 98, 98, 98, 53, 79, 79, 81, 75, 73, 65, 77, 63, 63, 69, 77, 71, 77, 53, 53, 53, 53, 53, 53, 53, 67, 59, 55, 53, 81, 53, 53, 53, 81, 61, 57, 53, 53, 53, 53, 53, 53, 53, 0}
+var _gosecco_tokenizer_to_state_actions []int8 = []int8{0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+var _gosecco_tokenizer_from_state_actions []int8 = []int8{0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+var _gosecco_tokenizer_eof_trans_indexed []int8 = []int8{1, 1, 4, 0, 35, 37, 40, 42, 46, 46, 49, 42, 53, 42, 56, 56, 56, 56, 56, 56, 56, 64, 65, 66, 56, 4, 56, 56, 56, 4, 72, 73, 56, 56, 56, 56, 56, 56, 56, 0}
+var _gosecco_tokenizer_eof_trans_direct []int16 = []int16{288, 289, 290, 0, 291, 292, 293, 294, 295, 296, 297, 298, 299, 300, 301, 302, 303, 304, 305, 306, 307, 308, 309, 310, 311, 312, 313, 314, 315, 316, 317, 318, 319, 320, 321, 322, 323, 324, 325, 0}
 var _gosecco_tokenizer_nfa_targs []int8 = []int8{0, 0}
-var _gosecco_tokenizer_nfa_offsets []int8 = []int8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+var _gosecco_tokenizer_nfa_offsets []int8 = []int8{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
 var _gosecco_tokenizer_nfa_push_actions []int8 = []int8{0, 0}
 var _gosecco_tokenizer_nfa_pop_trans []int8 = []int8{0, 0}
-var gosecco_tokenizer_start int = 2
-var gosecco_tokenizer_first_final int = 2
+var gosecco_tokenizer_start int = 3
+var gosecco_tokenizer_first_final int = 3
 var gosecco_tokenizer_error int = -1
-var gosecco_tokenizer_en_main int = 2
+var gosecco_tokenizer_en_main int = 3
 
 func tokenizeRaw(data []byte, f func(token, []byte), tokenError func(int, int, []byte) error) error {
 	var cs, act int
@@ -492,7 +492,7 @@ func tokenizeRaw(data []byte, f func(token, []byte), tokenError func(int, int, [
 						{
 							te = p + 1
 							{
-								return tokenError(ts, te, data)
+								f(DOTDOT, nil)
 							}
 						}
 					}
@@ -501,10 +501,9 @@ func tokenizeRaw(data []byte, f func(token, []byte), tokenError func(int, int, [
 				case 31:
 					{
 						{
-							te = p
-							p = p - 1
+							te = p + 1
 							{
-								f(IDENT, data[ts:te])
+								return tokenError(ts, te, data)
 							}
 						}
 					}
@@ -516,7 +515,7 @@ func tokenizeRaw(data []byte, f func(token, []byte), tokenError func(int, int, [
 							te = p
 							p = p - 1
 							{
-								f(INT, data[ts:te])
+								f(IDENT, data[ts:te])
 							}
 						}
 					}
@@ -528,7 +527,7 @@ func tokenizeRaw(data []byte, f func(token, []byte), tokenError func(int, int, [
 							te = p
 							p = p - 1
 							{
-								f(INT, data[ts:te])
+								f(GROUP, data[ts:te])
 							}
 						}
 					}
@@ -564,7 +563,7 @@ func tokenizeRaw(data []byte, f func(token, []byte), tokenError func(int, int, [
 							te = p
 							p = p - 1
 							{
-								f(AND, nil)
+								f(INT, data[ts:te])
 							}
 						}
 					}
@@ -576,7 +575,7 @@ func tokenizeRaw(data []byte, f func(token, []byte), tokenError func(int, int, [
 							te = p
 							p = p - 1
 							{
-								f(OR, nil)
+								f(INT, data[ts:te])
 							}
 						}
 					}
@@ -588,7 +587,7 @@ func tokenizeRaw(data []byte, f func(token, []byte), tokenError func(int, int, [
 							te = p
 							p = p - 1
 							{
-								f(LT, nil)
+								f(AND, nil)
 							}
 						}
 					}
@@ -600,7 +599,7 @@ func tokenizeRaw(data []byte, f func(token, []byte), tokenError func(int, int, [
 							te = p
 							p = p - 1
 							{
-								f(GT, nil)
+								f(OR, nil)
 							}
 						}
 					}
@@ -612,7 +611,7 @@ func tokenizeRaw(data []byte, f func(token, []byte), tokenError func(int, int, [
 							te = p
 							p = p - 1
 							{
-								f(NOT, nil)
+								f(LT, nil)
 							}
 						}
 					}
@@ -623,6 +622,9 @@ func tokenizeRaw(data []byte, f func(token, []byte), tokenError func(int, int, [
 						{
 							te = p
 							p = p - 1
+							{
+								f(GT, nil)
+							}
 						}
 					}
 
@@ -633,13 +635,34 @@ func tokenizeRaw(data []byte, f func(token, []byte), tokenError func(int, int, [
 							te = p
 							p = p - 1
 							{
-								return tokenError(ts, te, data)
+								f(NOT, nil)
 							}
 						}
 					}
 
 					break
 				case 43:
+					{
+						{
+							te = p
+							p = p - 1
+						}
+					}
+
+					break
+				case 44:
+					{
+						{
+							te = p
+							p = p - 1
+							{
+								return tokenError(ts, te, data)
+							}
+						}
+					}
+
+					break
+				case 45:
 					{
 						{
 							p = (te) - 1
@@ -650,7 +673,7 @@ func tokenizeRaw(data []byte, f func(token, []byte), tokenError func(int, int, [
 					}
 
 					break
-				case 44:
+				case 46:
 					{
 						{
 							switch act {
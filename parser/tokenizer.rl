@@ -17,9 +17,10 @@ import "fmt"
 
     IDENT_CHAR = "_" | alnum ;
 
-    ARG = "arg" [HL]? [0-5] ;
+    ARG = "arg" [HL]? [0-5] | "ip" [HL]? ;
 
-    IDENT = [_a-zA-Z] IDENT_CHAR* ;
+    IDENT = [_a-zA-Z] IDENT_CHAR* ( "." [_a-zA-Z] IDENT_CHAR* )* ;
+    GROUP = "@" [_a-zA-Z] ( IDENT_CHAR | "-" )* ;
 
     main := |*
       ARG     => {f(ARG,   data[ts:te])};
@@ -31,6 +32,7 @@ import "fmt"
       "false"i => {f(FALSE, nil)};
 
       IDENT   => {f(IDENT, data[ts:te])};
+      GROUP   => {f(GROUP, data[ts:te])};
 
       INTHEX  => {f(INT,   data[ts:te])};
       INTOCT  => {f(INT,   data[ts:te])};
@@ -69,6 +71,7 @@ import "fmt"
       "]" => {f(RBRACK, nil)};
 
       "," => {f(COMMA, nil)};
+      ".." => {f(DOTDOT, nil)};
 
       SPACES+;
 
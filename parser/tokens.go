@@ -9,6 +9,7 @@ const (
 	IDENT // main
 	ARG   // arg[0-5]
 	INT   // 12345, 0b01010, 0xFFF, 0777
+	GROUP // @network-io
 
 	ADD // +
 	SUB // -
@@ -39,6 +40,7 @@ const (
 	LPAREN // (
 	LBRACK // [
 	COMMA  // ,
+	DOTDOT // ..
 
 	RPAREN // )
 	RBRACK // ]
@@ -58,6 +60,7 @@ var tokens = [...]string{
 	IDENT: "IDENT",
 	ARG:   "ARG",
 	INT:   "INT",
+	GROUP: "GROUP",
 
 	ADD: "+",
 	SUB: "-",
@@ -87,6 +90,7 @@ var tokens = [...]string{
 	LPAREN: "(",
 	LBRACK: "[",
 	COMMA:  ",",
+	DOTDOT: "..",
 
 	RPAREN: ")",
 	RBRACK: "]",
@@ -53,6 +53,14 @@ func (v *precompilationChecker) check() []error {
 }
 
 func (v *precompilationChecker) checkRule(r *tree.Rule) error {
+	if len(r.Outcomes) > 0 {
+		for _, o := range r.Outcomes {
+			if err := checkPrecompilationRules(o.Condition); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	return checkPrecompilationRules(r.Body)
 }
 
@@ -165,6 +165,17 @@ func (s *PrecompilationCheckerSuite) Test_noLteComparisonsAllowed(c *C) {
 	c.Assert(val[0], ErrorMatches, "\\[read\\] no less than or equals comparisons allowed - this is probably a programmer error: \\(lte 1 42\\)")
 }
 
+func (s *PrecompilationCheckerSuite) Test_noSignedComparisonsAllowed(c *C) {
+	toCheck := tree.Policy{Rules: []*tree.Rule{
+		&tree.Rule{Name: "read", Body: tree.Comparison{Op: tree.SGT, Left: tree.NumericLiteral{1}, Right: tree.NumericLiteral{42}}},
+	}}
+
+	val := EnsureValid(toCheck)
+
+	c.Assert(len(val), Equals, 1)
+	c.Assert(val[0], ErrorMatches, "\\[read\\] no signed comparisons allowed - this is probably a programmer error: \\(sgt 1 42\\)")
+}
+
 func (s *PrecompilationCheckerSuite) Test_otherComparisonsAllowed(c *C) {
 	toCheck := tree.Policy{Rules: []*tree.Rule{
 		&tree.Rule{Name: "read", Body: tree.Comparison{Op: tree.EQL, Left: tree.NumericLiteral{1}, Right: tree.NumericLiteral{42}}},
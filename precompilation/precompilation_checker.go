@@ -63,6 +63,8 @@ func (t *precompilationTypeChecker) AcceptComparison(v tree.Comparison) {
 		t.result = fmt.Errorf("no less than comparisons allowed - this is probably a programmer error: %s", tree.ExpressionString(v))
 	} else if v.Op == tree.LTE {
 		t.result = fmt.Errorf("no less than or equals comparisons allowed - this is probably a programmer error: %s", tree.ExpressionString(v))
+	} else if v.Op == tree.SGT || v.Op == tree.SGTE || v.Op == tree.SLT || v.Op == tree.SLTE {
+		t.result = fmt.Errorf("no signed comparisons allowed - this is probably a programmer error: %s", tree.ExpressionString(v))
 	} else {
 		res := either(
 			checkPrecompilationRules(v.Left),
@@ -2,13 +2,16 @@ package gosecco
 
 import (
 	"fmt"
+	"log"
 	"runtime"
 	"strings"
 	"syscall"
 
 	"github.com/twtiger/gosecco/checker"
 	"github.com/twtiger/gosecco/compiler"
+	"github.com/twtiger/gosecco/constants"
 	"github.com/twtiger/gosecco/data"
+	"github.com/twtiger/gosecco/lint"
 	"github.com/twtiger/gosecco/native"
 	"github.com/twtiger/gosecco/parser"
 	"github.com/twtiger/gosecco/precompilation"
@@ -64,18 +67,71 @@ type SeccompSettings struct {
 	// for. If not specified, it will default to "kill". The actions are specified using the same syntax as described for
 	// DefaultPositiveAction.
 	ActionOnAuditFailure string
+	// Arch is the name of the architecture to compile the policy for, such as "x86_64" or "i386". Syscall names, groups and
+	// constants are resolved for it, and the filter only accepts syscalls made with its audit architecture. If not specified,
+	// it will default to the native architecture.
+	Arch string
+	// RejectRuntimeDivision makes a division or modulo by a value that is only known at runtime, such as argL0 / argL1, an error.
+	// BPF returns 0 from the whole filter if such a value is zero, which kills the thread. If this is not set, the compiler will
+	// instead make the rule take its negative action in that case.
+	RejectRuntimeDivision bool
 }
 
 // InlineMarker is the marker a string should start with in order to
 // specify it should be parsed as an inline string, not a path.
 const InlineMarker = "{inline}"
 
+// PreparedPolicy contains the bytecode for a policy together with the metadata defined in it and the warnings found while compiling it
+type PreparedPolicy struct {
+	// Filters is the compiled bytecode of the policy
+	Filters []unix.SockFilter
+	// Metadata contains the metadata fields of the policy, such as @name, @version, @description and @owner.
+	// The names are given without the leading @
+	Metadata map[string]string
+	// Warnings contains the problems found in the policy that didn't stop it from being compiled, such as macros that are never used
+	Warnings []tree.Warning
+}
+
+// Describe returns a short description of the policy based on its metadata, such as "webserver 1.2 (ops@example.com)".
+// If the policy has no name, an empty string is returned
+func (p *PreparedPolicy) Describe() string {
+	name, ok := p.Metadata["name"]
+	if !ok {
+		return ""
+	}
+	if v, ok := p.Metadata["version"]; ok {
+		name = fmt.Sprintf("%s %s", name, v)
+	}
+	if o, ok := p.Metadata["owner"]; ok {
+		name = fmt.Sprintf("%s (%s)", name, o)
+	}
+	return name
+}
+
 // PrepareSource will take the given source and settings, parse and compile the given
 // data, combined with the settings - and returns the bytecode
 func PrepareSource(source parser.Source, s SeccompSettings) ([]unix.SockFilter, error) {
+	p, e := PreparePolicy(source, s)
+	if e != nil {
+		return nil, e
+	}
+	return p.Filters, nil
+}
+
+// PreparePolicy works like PrepareSource, but also returns the metadata of the policy and the warnings for it together with the bytecode
+func PreparePolicy(source parser.Source, s SeccompSettings) (*PreparedPolicy, error) {
 	var e error
 	var rp tree.RawPolicy
 
+	arch, ok := constants.GetArch(s.Arch)
+	if !ok {
+		return nil, fmt.Errorf("Architecture '%s' is not known - it can be one of %s", s.Arch, strings.Join(constants.ArchNames(), ", "))
+	}
+
+	if e = checkActions(s); e != nil {
+		return nil, e
+	}
+
 	// Parsing of extra files with definitions
 	extras := make([]map[string]tree.Macro, len(s.ExtraDefinitions))
 	for ix, ed := range s.ExtraDefinitions {
@@ -87,7 +143,7 @@ func PrepareSource(source parser.Source, s SeccompSettings) ([]unix.SockFilter,
 		if e != nil {
 			return nil, e
 		}
-		p, e2 := unifier.Unify(rp, nil, "", "", "")
+		p, e2 := unifier.UnifyForArch(arch, rp, nil, "", "", "")
 		if e2 != nil {
 			return nil, e2
 		}
@@ -101,20 +157,29 @@ func PrepareSource(source parser.Source, s SeccompSettings) ([]unix.SockFilter,
 	}
 
 	// Unifying
-	pol, err := unifier.Unify(rp, extras, s.DefaultPositiveAction, s.DefaultNegativeAction, s.DefaultPolicyAction)
+	pol, err := unifier.UnifyForArch(arch, rp, extras, s.DefaultPositiveAction, s.DefaultNegativeAction, s.DefaultPolicyAction)
 	if err != nil {
 		return nil, err
 	}
 
 	// Type checking
+	pol.RejectRuntimeDivision = s.RejectRuntimeDivision
 	errors := checker.EnsureValid(pol)
 	if len(errors) > 0 {
 		return nil, errors[0]
 	}
+	pol.Warnings = append(pol.Warnings, checker.Warnings(pol)...)
 
 	// Simplification
+	original := make([]tree.Rule, len(pol.Rules))
+	for ix, r := range pol.Rules {
+		original[ix] = *r
+	}
 	simplifier.SimplifyPolicy(&pol)
 
+	// Linting
+	pol.Warnings = append(pol.Warnings, lint.Check(pol, original)...)
+
 	// Pre-compilation
 	errors = precompilation.EnsureValid(pol)
 	if len(errors) > 0 {
@@ -122,7 +187,26 @@ func PrepareSource(source parser.Source, s SeccompSettings) ([]unix.SockFilter,
 	}
 
 	// Compilation
-	return compiler.Compile(pol)
+	filters, err := compiler.Compile(pol)
+	if err != nil {
+		return nil, err
+	}
+	return &PreparedPolicy{Filters: filters, Metadata: pol.Metadata, Warnings: pol.Warnings}, nil
+}
+
+// checkActions returns an error for the first default action in the settings that isn't valid
+func checkActions(s SeccompSettings) error {
+	actions := []struct{ setting, action string }{
+		{"DefaultPositiveAction", s.DefaultPositiveAction},
+		{"DefaultNegativeAction", s.DefaultNegativeAction},
+		{"DefaultPolicyAction", s.DefaultPolicyAction},
+	}
+	for _, a := range actions {
+		if !parser.IsValidAction(a.action) {
+			return fmt.Errorf("Invalid return action '%s' for %s", a.action, a.setting)
+		}
+	}
+	return nil
 }
 
 // Prepare will take the given path and settings, parse and compile the given
@@ -138,7 +222,11 @@ func Prepare(path string, s SeccompSettings) ([]unix.SockFilter, error) {
 // Compile from the go-seccomp package and should provide the same behavior.
 // However, the modern interface is through the Prepare function
 func Compile(path string, enforce bool) ([]unix.SockFilter, error) {
+	return Prepare(path, CompileSettings(enforce))
+}
 
+// CompileSettings returns the settings used by Compile
+func CompileSettings(enforce bool) SeccompSettings {
 	settings := SeccompSettings{}
 	settings.DefaultPositiveAction = "allow"
 	settings.ActionOnAuditFailure = "kill"
@@ -149,14 +237,18 @@ func Compile(path string, enforce bool) ([]unix.SockFilter, error) {
 		settings.DefaultNegativeAction = "trace"
 		settings.DefaultPolicyAction = "trace"
 	}
-
-	return Prepare(path, settings)
+	return settings
 }
 
 // CompileBlacklist provides the compatibility interface for gosecco, for blacklist mode
 // It has the same signature as CompileBlacklist from Subgraphs go-seccomp and should provide the same behavior.
 // However, the modern interface is through the Prepare function
 func CompileBlacklist(path string, enforce bool) ([]unix.SockFilter, error) {
+	return Prepare(path, CompileBlacklistSettings(enforce))
+}
+
+// CompileBlacklistSettings returns the settings used by CompileBlacklist
+func CompileBlacklistSettings(enforce bool) SeccompSettings {
 	settings := SeccompSettings{}
 	settings.DefaultNegativeAction = "allow"
 	settings.DefaultPolicyAction = "allow"
@@ -167,8 +259,7 @@ func CompileBlacklist(path string, enforce bool) ([]unix.SockFilter, error) {
 	} else {
 		settings.DefaultPositiveAction = "trace"
 	}
-
-	return Prepare(path, settings)
+	return settings
 }
 
 // Load makes the seccomp system call to install the bpf filter for
@@ -207,6 +298,15 @@ func Install(bpf []unix.SockFilter) error {
 	return Load(bpf)
 }
 
+// InstallPolicy installs the bytecode of the prepared policy in the same way as Install. If the policy
+// has a name, it will be logged before the policy is installed
+func InstallPolicy(p *PreparedPolicy) error {
+	if d := p.Describe(); d != "" {
+		log.Printf("Installing seccomp policy %s", d)
+	}
+	return Install(p.Filters)
+}
+
 // InstallBlacklist makes the necessary system calls to install the Seccomp-BPF
 // filter for the current process (all threads). Install can be called
 // multiple times to install additional filters.
@@ -7,6 +7,9 @@ import (
 	"testing"
 
 	"github.com/twtiger/gosecco/asm"
+	"github.com/twtiger/gosecco/data"
+	"github.com/twtiger/gosecco/emulator"
+	"github.com/twtiger/gosecco/parser"
 	"golang.org/x/sys/unix"
 
 	. "gopkg.in/check.v1"
@@ -46,6 +49,13 @@ func (s *SeccompSuite) Test_parseUnificationErrorReturnsError(c *C) {
 	c.Assert(ee, ErrorMatches, "Variable 'b' is not defined")
 }
 
+func (s *SeccompSuite) Test_duplicateRuleFromCombinedHeadReturnsError(c *C) {
+	set := SeccompSettings{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill"}
+	src := &parser.StringSource{Name: "<tmp>", Content: "read, pread64: arg0 == 1\nread: arg0 == 2\n"}
+	_, ee := PrepareSource(src, set)
+	c.Assert(ee, ErrorMatches, "\\[read\\] duplicate definition of syscall rule at <tmp>:1, previously defined at <tmp>:0")
+}
+
 func (s *SeccompSuite) Test_parseValidPolicyFile(c *C) {
 	set := SeccompSettings{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill"}
 	f := getActualTestFolder() + "/valid_test_policy"
@@ -62,6 +72,221 @@ func (s *SeccompSuite) Test_parseValidPolicyFile(c *C) {
 		"ret_k\t0\n")
 }
 
+func (s *SeccompSuite) Test_preparePolicyForAnotherArchitecture(c *C) {
+	set := SeccompSettings{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill", Arch: "i386"}
+	src := &parser.StringSource{Name: "<tmp>", Content: "ioctl: argL1 == TUNATTACHFILTER\nsocketcall: 1\n"}
+	res, ee := PrepareSource(src, set)
+	c.Assert(ee, IsNil)
+	c.Assert(asm.Dump(res), Equals, ""+
+		"ld_abs\t4\n"+
+		"jeq_k\t00\t06\t40000003\n"+
+		"ld_abs\t0\n"+
+		"jeq_k\t00\t02\t36\n"+
+		"ld_abs\t18\n"+
+		"jeq_k\t01\t02\t400854D5\n"+
+		"jeq_k\t00\t01\t66\n"+
+		"ret_k\t7FFF0000\n"+
+		"ret_k\t0\n")
+}
+
+func (s *SeccompSuite) Test_preparePolicyForAnUnknownArchitectureReturnsError(c *C) {
+	set := SeccompSettings{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill", Arch: "vax"}
+	src := &parser.StringSource{Name: "<tmp>", Content: "read: 1\n"}
+	_, ee := PrepareSource(src, set)
+	c.Assert(ee, ErrorMatches, "Architecture 'vax' is not known - it can be one of i386, x86_64")
+}
+
+func (s *SeccompSuite) Test_preparePolicyReturnsMetadata(c *C) {
+	set := SeccompSettings{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill"}
+	src := &parser.StringSource{Name: "<tmp>", Content: "@name = \"webserver\"\n@version = \"1.2\"\n@owner = \"ops@example.com\"\nread: 1\n"}
+	res, ee := PreparePolicy(src, set)
+	c.Assert(ee, IsNil)
+	c.Assert(res.Metadata, DeepEquals, map[string]string{"name": "webserver", "version": "1.2", "owner": "ops@example.com"})
+	c.Assert(res.Describe(), Equals, "webserver 1.2 (ops@example.com)")
+
+	filters, _ := PrepareSource(src, set)
+	c.Assert(res.Filters, DeepEquals, filters)
+}
+
+func (s *SeccompSuite) Test_preparePolicyReturnsWarnings(c *C) {
+	set := SeccompSettings{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill"}
+	src := &parser.StringSource{Name: "<tmp>", Content: "VAL = 1\nOTHER = 2\nread: arg0 == OTHER\n"}
+	res, ee := PreparePolicy(src, set)
+	c.Assert(ee, IsNil)
+	c.Assert(len(res.Warnings), Equals, 1)
+	c.Assert(res.Warnings[0].String(), Equals, "<tmp>:0: Macro 'VAL' is never used")
+}
+
+func (s *SeccompSuite) Test_preparePolicyReturnsLintWarnings(c *C) {
+	set := SeccompSettings{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill"}
+	src := &parser.StringSource{Name: "<tmp>", Content: "read: argL0 > 0xFFFFFFFF\nwrite: 1\n"}
+	res, ee := PreparePolicy(src, set)
+	c.Assert(ee, IsNil)
+	c.Assert(len(res.Warnings), Equals, 1)
+	c.Assert(res.Warnings[0].String(), Equals, "<tmp>:0: Comparison can never be true: (gt argL0 4294967295) in rule for 'read'")
+}
+
+func (s *SeccompSuite) Test_preparePolicyWarnsAboutConstantsFromTheWrongFamily(c *C) {
+	set := SeccompSettings{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill"}
+	src := &parser.StringSource{Name: "<tmp>", Content: "isFile(x) = x == O_RDONLY\nsocket: isFile(arg0) || type == SOCK_STREAM\n"}
+	res, ee := PreparePolicy(src, set)
+	c.Assert(ee, IsNil)
+	c.Assert(len(res.Warnings), Equals, 1)
+	c.Assert(res.Warnings[0].String(), Equals, "<tmp>:1: O_RDONLY belongs to O_*, but domain takes AF_*: (eq arg0 0) in rule for 'socket'")
+}
+
+func (s *SeccompSuite) Test_preparePolicyCalculatesWith32BitsAtRuntime(c *C) {
+	set := SeccompSettings{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill"}
+	src := &parser.StringSource{Name: "<tmp>", Content: "pipe2: flags & ~O_CLOEXEC == 0\nclose: ~fd == 0\nread: fd + (0xFFFFFFFF + 1) == 3\n"}
+	res, ee := PreparePolicy(src, set)
+	c.Assert(ee, IsNil)
+	c.Assert(asm.Dump(res.Filters), Matches, "(?s).*ld_abs\t18\nand_k\tFFF7FFFF\n.*ld_abs\t10\nxor_k\tFFFFFFFF\n.*")
+	c.Assert(len(res.Warnings), Equals, 1)
+	c.Assert(res.Warnings[0].String(), Equals, "<tmp>:2: Calculation wraps around to 0x0 with the 32 bits BPF uses, instead of 0x100000000: (plus 4294967295 1) in rule for 'read'")
+}
+
+func (s *SeccompSuite) Test_reportsInvalidDefaultActions(c *C) {
+	set := SeccompSettings{DefaultPositiveAction: "allow", DefaultNegativeAction: "EBLARG", DefaultPolicyAction: "kill"}
+	src := &parser.StringSource{Name: "<tmp>", Content: "read: 1\n"}
+	_, ee := PrepareSource(src, set)
+	c.Assert(ee, ErrorMatches, "Invalid return action 'EBLARG' for DefaultNegativeAction")
+
+	set.DefaultNegativeAction = "EPERM"
+	src = &parser.StringSource{Name: "<tmp>", Content: "read: 1\nDEFAULT_POSITIVE = EBLARG\n"}
+	_, ee = PrepareSource(src, set)
+	c.Assert(ee, ErrorMatches, "<tmp>:1: Invalid return action 'EBLARG' for DEFAULT_POSITIVE")
+}
+
+func (s *SeccompSuite) Test_preparePolicyHandlesDivisionByZero(c *C) {
+	set := SeccompSettings{DefaultPositiveAction: "allow", DefaultNegativeAction: "EPERM", DefaultPolicyAction: "kill"}
+	src := &parser.StringSource{Name: "<tmp>", Content: "read: argL0 / argL1 == 2\n"}
+	res, ee := PreparePolicy(src, set)
+	c.Assert(ee, IsNil)
+	call := func(l0, l1 uint64) uint32 {
+		return emulator.Emulate(data.SeccompWorkingMemory{NR: 0, Arch: 0xC000003E, Args: [6]uint64{l0, l1}}, res.Filters)
+	}
+	c.Assert(call(10, 5), Equals, uint32(0x7FFF0000))
+	c.Assert(call(10, 3), Equals, uint32(0x50001))
+	c.Assert(call(10, 0), Equals, uint32(0x50001))
+
+	set.RejectRuntimeDivision = true
+	_, ee = PreparePolicy(src, set)
+	c.Assert(ee, ErrorMatches, "\\[read\\] division by a value that is only known at runtime is not allowed: \\(div argL0 argL1\\)")
+
+	src = &parser.StringSource{Name: "<tmp>", Content: "read: argL0 % 0x10 == 1\nwrite: arg0 == 10 / (3 - 3)\n"}
+	_, ee = PreparePolicy(src, set)
+	c.Assert(ee, ErrorMatches, "\\[write\\] division by zero: \\(div 10 \\(minus 3 3\\)\\)")
+}
+
+func (s *SeccompSuite) Test_rulesWithOnlyAReturnAlwaysReturnTheError(c *C) {
+	set := SeccompSettings{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill"}
+	src := &parser.StringSource{Name: "<tmp>", Content: "read: return EPERM\nwrite: return 5\n"}
+	res, ee := PrepareSource(src, set)
+	c.Assert(ee, IsNil)
+	call := func(nr int32) uint32 {
+		return emulator.Emulate(data.SeccompWorkingMemory{NR: nr, Arch: 0xC000003E}, res)
+	}
+	c.Assert(call(0), Equals, uint32(0x50001))
+	c.Assert(call(1), Equals, uint32(0x50005))
+	c.Assert(call(2), Equals, uint32(0))
+}
+
+func (s *SeccompSuite) Test_lessThanComparisonsKeepTheirStrictness(c *C) {
+	set := SeccompSettings{DefaultPositiveAction: "allow", DefaultNegativeAction: "EPERM", DefaultPolicyAction: "kill"}
+	src := &parser.StringSource{Name: "<tmp>", Content: "read: arg0 < 4\nwrite: arg0 <= 4\nclose: arg0 < 0x100000004\nstat: arg0 <= 0x100000004\n"}
+	res, ee := PrepareSource(src, set)
+	c.Assert(ee, IsNil)
+	call := func(nr int32, l0 uint64) uint32 {
+		return emulator.Emulate(data.SeccompWorkingMemory{NR: nr, Arch: 0xC000003E, Args: [6]uint64{l0}}, res)
+	}
+	c.Assert(call(0, 3), Equals, uint32(0x7FFF0000))
+	c.Assert(call(0, 4), Equals, uint32(0x50001))
+	c.Assert(call(1, 4), Equals, uint32(0x7FFF0000))
+	c.Assert(call(1, 5), Equals, uint32(0x50001))
+	c.Assert(call(3, 0x100000003), Equals, uint32(0x7FFF0000))
+	c.Assert(call(3, 0x100000004), Equals, uint32(0x50001))
+	c.Assert(call(4, 0x100000004), Equals, uint32(0x7FFF0000))
+	c.Assert(call(4, 0x100000005), Equals, uint32(0x50001))
+}
+
+func (s *SeccompSuite) Test_negativeValuesCanBeComparedWithNamedArguments(c *C) {
+	set := SeccompSettings{DefaultPositiveAction: "allow", DefaultNegativeAction: "EPERM", DefaultPolicyAction: "kill"}
+	src := &parser.StringSource{Name: "<tmp>", Content: "mmap: fd == -1\nopenat: dirfd == -100\n"}
+	res, ee := PrepareSource(src, set)
+	c.Assert(ee, IsNil)
+	call := func(nr int32, args [6]uint64) uint32 {
+		return emulator.Emulate(data.SeccompWorkingMemory{NR: nr, Arch: 0xC000003E, Args: args}, res)
+	}
+	c.Assert(call(9, [6]uint64{0, 0, 0, 0, 0xFFFFFFFF}), Equals, uint32(0x7FFF0000))
+	c.Assert(call(9, [6]uint64{0, 0, 0, 0, 3}), Equals, uint32(0x50001))
+	c.Assert(call(257, [6]uint64{0xFFFFFF9C}), Equals, uint32(0x7FFF0000))
+	c.Assert(call(257, [6]uint64{100}), Equals, uint32(0x50001))
+
+	src = &parser.StringSource{Name: "<tmp>", Content: "openat: dirfd == AT_FDCWD\n"}
+	constant, ee := PrepareSource(src, set)
+	c.Assert(ee, IsNil)
+	src = &parser.StringSource{Name: "<tmp>", Content: "openat: dirfd == -100\n"}
+	negative, ee := PrepareSource(src, set)
+	c.Assert(ee, IsNil)
+	c.Assert(negative, DeepEquals, constant)
+}
+
+func (s *SeccompSuite) Test_bitsetOnHalfAnArgumentRequiresAllBitsOfTheMask(c *C) {
+	set := SeccompSettings{DefaultPositiveAction: "allow", DefaultNegativeAction: "EPERM", DefaultPolicyAction: "kill"}
+	src := &parser.StringSource{Name: "<tmp>", Content: "read: argL0 &? 3\n"}
+	res, ee := PreparePolicy(src, set)
+	c.Assert(ee, IsNil)
+	call := func(l0 uint64) uint32 {
+		return emulator.Emulate(data.SeccompWorkingMemory{NR: 0, Arch: 0xC000003E, Args: [6]uint64{l0}}, res.Filters)
+	}
+	c.Assert(call(3), Equals, uint32(0x7FFF0000))
+	c.Assert(call(7), Equals, uint32(0x7FFF0000))
+	c.Assert(call(1), Equals, uint32(0x50001))
+	c.Assert(call(2), Equals, uint32(0x50001))
+}
+
+func (s *SeccompSuite) Test_rulesCanUseNamedArguments(c *C) {
+	set := SeccompSettings{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill"}
+	src := &parser.StringSource{Name: "<tmp>", Content: "openat: flags &? O_CREAT && dirfd == 3\nmmap: prot &? PROT_EXEC\n"}
+	res, ee := PrepareSource(src, set)
+	c.Assert(ee, IsNil)
+	c.Assert(asm.Dump(res), Matches, "(?s).*ld_abs\t20\nand_k\t40\n.*ld_abs\t10\njeq_k\t..\t..\t3\n.*ld_abs\t20\nand_k\t4\n.*")
+
+	src = &parser.StringSource{Name: "<tmp>", Content: "close: flags == 0\n"}
+	_, ee = PrepareSource(src, set)
+	c.Assert(ee, ErrorMatches, "Variable 'flags' is not defined")
+}
+
+func (s *SeccompSuite) Test_describeWithoutName(c *C) {
+	c.Assert((&PreparedPolicy{Metadata: map[string]string{"version": "1.2"}}).Describe(), Equals, "")
+	c.Assert((&PreparedPolicy{}).Describe(), Equals, "")
+}
+
+func (s *SeccompSuite) Test_importedMacrosKeepTheirOwnDefinitions(c *C) {
+	set := SeccompSettings{DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill"}
+	resolver := parser.MapResolver(map[string]string{
+		"ioctl": "_IOC(nr) = 0x5400 | nr\n_IO(nr) = _IOC(nr)",
+	})
+	src := &parser.StringSourceWithResolver{Name: "<tmp>", Content: "import \"ioctl\" as ioc\n_IOC(nr) = nr\nioctl: arg1 == ioc._IO(1)", Resolver: resolver}
+	res, ee := PrepareSource(src, set)
+	c.Assert(ee, IsNil)
+	c.Assert(asm.Dump(res), Matches, "(?s).*jeq_k\t00\t..\t5401\n.*")
+}
+
+func (s *SeccompSuite) Test_extraDefinitionsCantOverrideEachOther(c *C) {
+	set := SeccompSettings{
+		DefaultPositiveAction: "allow", DefaultNegativeAction: "kill", DefaultPolicyAction: "kill",
+		ExtraDefinitions: []string{InlineMarker + "VAL = 1", InlineMarker + "VAL = 2"},
+	}
+	src := &parser.StringSource{Name: "<tmp>", Content: "read: arg0 == VAL"}
+	_, ee := PrepareSource(src, set)
+	c.Assert(ee, ErrorMatches, "Macro 'VAL' at <string>:0 is already defined in another source at <string>:0 - use override to replace it")
+
+	set.ExtraDefinitions[1] = InlineMarker + "override VAL = 2"
+	_, ee = PrepareSource(src, set)
+	c.Assert(ee, IsNil)
+}
+
 func (s *SeccompSuite) Test_parseInvalidTypeReturnsError(c *C) {
 	set := SeccompSettings{}
 	f := getActualTestFolder() + "/type_checker_error_policy"
@@ -78,14 +303,13 @@ func (s *SeccompSuite) Test_parseSimplifiesValidExpression(c *C) {
 
 	c.Assert(asm.Dump(res), Equals, ""+
 		"ld_abs\t4\n"+
-		"jeq_k\t00\t08\tC000003E\n"+
+		"jeq_k\t00\t07\tC000003E\n"+
 		"ld_abs\t0\n"+
-		"jeq_k\t00\t04\t1\n"+
+		"jeq_k\t00\t05\t1\n"+
 		"ld_abs\t10\n"+
-		"jeq_k\t00\t04\t3\n"+
+		"jeq_k\t00\t03\t3\n"+
 		"ld_abs\t14\n"+
-		"jeq_k\t01\t02\t0\n"+
-		"jmp\t1\n"+
+		"jeq_k\t00\t01\t0\n"+
 		"ret_k\t7FFF0000\n"+
 		"ret_k\t0\n")
 }
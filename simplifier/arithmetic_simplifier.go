@@ -1,17 +1,65 @@
 package simplifier
 
-import "github.com/twtiger/gosecco/tree"
+import (
+	"fmt"
+
+	"github.com/twtiger/gosecco/tree"
+)
+
+const max32 = uint64(0xFFFFFFFF)
 
 // AcceptBinaryNegation implements Visitor
 func (s *arithmeticSimplifier) AcceptBinaryNegation(v tree.BinaryNegation) {
 	val := s.Transform(v.Operand)
 	if val2, ok := potentialExtractValue(val); ok {
+		if s.runtime {
+			// The complement of a 32 bit value is what is meant here, so it only wraps around if the value doesn't fit
+			if val2 > max32 {
+				s.report(tree.BinaryNegation{val}, ^val2&max32, ^val2)
+			}
+			s.Result = tree.NumericLiteral{^val2 & max32}
+			return
+		}
 		s.Result = tree.NumericLiteral{^val2}
+	} else {
+		s.Result = tree.BinaryNegation{val}
 	}
 }
 
+// calculate returns the result of the operation, unless it is a division by zero, which doesn't have one
+func calculate(op tree.ArithmeticType, l, r uint64) (uint64, bool) {
+	if (op == tree.DIV || op == tree.MOD) && r == 0 {
+		return 0, false
+	}
+	switch op {
+	case tree.PLUS:
+		return l + r, true
+	case tree.MINUS:
+		return l - r, true
+	case tree.MULT:
+		return l * r, true
+	case tree.DIV:
+		return l / r, true
+	case tree.MOD:
+		return l % r, true
+	case tree.BINAND:
+		return l & r, true
+	case tree.BINOR:
+		return l | r, true
+	case tree.BINXOR:
+		return l ^ r, true
+	case tree.LSH:
+		return l << r, true
+	case tree.RSH:
+		return l >> r, true
+	}
+	return 0, false
+}
+
 // AcceptArithmetic implements Visitor
 func (s *arithmeticSimplifier) AcceptArithmetic(a tree.Arithmetic) {
+	defer s.enterRuntime(a)()
+
 	l := s.Transform(a.Left)
 	r := s.Transform(a.Right)
 
@@ -19,49 +67,96 @@ func (s *arithmeticSimplifier) AcceptArithmetic(a tree.Arithmetic) {
 	pr, ok2 := potentialExtractValue(r)
 
 	if ok1 && ok2 {
-		switch a.Op {
-		case tree.PLUS:
-			s.Result = tree.NumericLiteral{pl + pr}
-			return
-		case tree.MINUS:
-			s.Result = tree.NumericLiteral{pl - pr}
-			return
-		case tree.MULT:
-			s.Result = tree.NumericLiteral{pl * pr}
-			return
-		case tree.DIV:
-			s.Result = tree.NumericLiteral{pl / pr}
-			return
-		case tree.MOD:
-			s.Result = tree.NumericLiteral{pl % pr}
-			return
-		case tree.BINAND:
-			s.Result = tree.NumericLiteral{pl & pr}
-			return
-		case tree.BINOR:
-			s.Result = tree.NumericLiteral{pl | pr}
-			return
-		case tree.BINXOR:
-			s.Result = tree.NumericLiteral{pl ^ pr}
-			return
-		case tree.LSH:
-			s.Result = tree.NumericLiteral{pl << pr}
-			return
-		case tree.RSH:
-			s.Result = tree.NumericLiteral{pl >> pr}
+		if res, ok := calculate(a.Op, pl, pr); ok {
+			if s.runtime && res > max32 {
+				s.report(tree.Arithmetic{Op: a.Op, Left: l, Right: r}, res&max32, res)
+				res &= max32
+			}
+			s.Result = tree.NumericLiteral{res}
 			return
 		}
 	}
 	s.Result = tree.Arithmetic{Op: a.Op, Left: l, Right: r}
 }
 
-// arithmeticSimplifier simplifies arithmetic expressions by calculating them as much as possible
+// AcceptComparison implements Visitor
+func (s *arithmeticSimplifier) AcceptComparison(a tree.Comparison) {
+	l := s.Transform(a.Left)
+	r := s.Transform(a.Right)
+	s.Result = tree.Comparison{Op: a.Op, Left: truncateNegative(l, r), Right: truncateNegative(r, l)}
+}
+
+// isNegative32 returns true if the value is a negative 32 bit value extended to 64 bits, like -1 or -100
+func isNegative32(v uint64) bool {
+	return v >= 0xFFFFFFFF80000000
+}
+
+// truncateNegative cuts a negative value down to 32 bits, if it is compared with a value BPF calculates using 32 bits at
+// runtime. That makes fd == -1 mean the same as fd == 0xFFFFFFFF, just like negative constants such as AT_FDCWD.
+func truncateNegative(x, other tree.Numeric) tree.Numeric {
+	if v, ok := potentialExtractValue(x); ok && isNegative32(v) {
+		if half, full := runtimeValuesIn(other); half && !full {
+			return tree.NumericLiteral{v & max32}
+		}
+	}
+	return x
+}
+
+// arithmeticSimplifier simplifies arithmetic expressions by calculating them as much as possible.
+// The static parts of a calculation that BPF does at runtime - because it uses argL or argH - wrap around
+// at 32 bits, just like they would if BPF calculated them.
 type arithmeticSimplifier struct {
 	tree.EmptyTransformer
+	// runtime is set while simplifying a part of an expression that BPF calculates using 32 bit values
+	runtime bool
+	// differences receives a description of the calculations that wrap around, if it is set
+	differences *[]string
+}
+
+// enterRuntime sets the runtime flag if the expression uses 32 bit values at runtime, and returns a function
+// that restores it
+func (s *arithmeticSimplifier) enterRuntime(x tree.Expression) func() {
+	outer := s.runtime
+	half, full := runtimeValuesIn(x)
+	s.runtime = outer || (half && !full)
+	return func() { s.runtime = outer }
+}
+
+func (s *arithmeticSimplifier) report(x tree.Expression, res32, res64 uint64) {
+	if s.differences == nil {
+		return
+	}
+	msg := fmt.Sprintf("Calculation wraps around to 0x%X with the 32 bits BPF uses, instead of 0x%X: %s", res32, res64, tree.ExpressionString(x))
+	for _, d := range *s.differences {
+		if d == msg {
+			return
+		}
+	}
+	*s.differences = append(*s.differences, msg)
+}
+
+// runtimeValuesIn returns whether the expression uses a 32 bit value that BPF only has at runtime, and whether it
+// uses a full argument
+func runtimeValuesIn(x tree.Expression) (half bool, full bool) {
+	switch v := x.(type) {
+	case tree.Argument:
+		return v.Type != tree.Full, v.Type == tree.Full
+	case tree.Arithmetic:
+		lh, lf := runtimeValuesIn(v.Left)
+		rh, rf := runtimeValuesIn(v.Right)
+		return lh || rh, lf || rf
+	case tree.BinaryNegation:
+		return runtimeValuesIn(v.Operand)
+	}
+	return false, false
 }
 
 func createArithmeticSimplifier() tree.Transformer {
-	s := &arithmeticSimplifier{}
+	return createArithmeticSimplifierReporting(nil)
+}
+
+func createArithmeticSimplifierReporting(differences *[]string) tree.Transformer {
+	s := &arithmeticSimplifier{differences: differences}
 	s.RealSelf = s
 	return s
 }
@@ -5,6 +5,10 @@ import "github.com/twtiger/gosecco/tree"
 // AcceptBinaryNegation implements Visitor
 func (s *binaryNegationSimplifier) AcceptBinaryNegation(v tree.BinaryNegation) {
 	val := s.Transform(v.Operand)
+	if half, full := runtimeValuesIn(val); half && !full {
+		s.Result = tree.Arithmetic{Op: tree.BINXOR, Left: val, Right: tree.NumericLiteral{max32}}
+		return
+	}
 	s.Result = tree.Arithmetic{Op: tree.BINXOR, Left: val, Right: tree.NumericLiteral{uint64(0xFFFFFFFFFFFFFFFF)}}
 }
 
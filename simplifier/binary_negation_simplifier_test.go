@@ -14,3 +14,9 @@ func (s *BinaryNegationSimplifierSuite) Test_simplifiesBinaryNegation(c *C) {
 
 	c.Assert(tree.ExpressionString(sx), Equals, "(binxor 42 18446744073709551615)") // This big ugly value is 0xFFFFFFFFFFFFFFFF, the largest uint64 value
 }
+
+func (s *BinaryNegationSimplifierSuite) Test_simplifiesBinaryNegationOfRuntimeValueWith32Bits(c *C) {
+	sx := createBinaryNegationSimplifier().Transform(tree.BinaryNegation{tree.Argument{Type: tree.Low, Index: 1}})
+
+	c.Assert(tree.ExpressionString(sx), Equals, "(binxor argL1 4294967295)")
+}
@@ -0,0 +1,43 @@
+package simplifier
+
+import "github.com/twtiger/gosecco/tree"
+
+// valueMask limits the mask to 32 bits when the value isn't a full argument, since everything except
+// full arguments will be evaluated as 32bit values. For those values, the high bits of the mask can never match.
+func valueMask(value, mask tree.Numeric) tree.Numeric {
+	if _, ok := potentialExtractFullArgument(value); ok {
+		return mask
+	}
+	return tree.Arithmetic{Op: tree.BINAND, Left: mask, Right: tree.NumericLiteral{0xFFFFFFFF}}
+}
+
+// AcceptComparison implements Visitor
+func (s *bitPredicateSimplifier) AcceptComparison(a tree.Comparison) {
+	l := s.Transform(a.Left)
+	r := s.Transform(a.Right)
+
+	switch a.Op {
+	case tree.ALLBITS:
+		s.Result = tree.Comparison{Op: tree.EQL, Left: tree.Arithmetic{Op: tree.BINAND, Left: l, Right: r}, Right: r}
+	case tree.ANYBITS:
+		s.Result = tree.Comparison{Op: tree.NEQL, Left: tree.Arithmetic{Op: tree.BINAND, Left: l, Right: valueMask(l, r)}, Right: tree.NumericLiteral{0}}
+	case tree.NOBITS:
+		s.Result = tree.Comparison{Op: tree.EQL, Left: tree.Arithmetic{Op: tree.BINAND, Left: l, Right: valueMask(l, r)}, Right: tree.NumericLiteral{0}}
+	case tree.ONLYBITS:
+		s.Result = tree.Comparison{Op: tree.EQL, Left: tree.Arithmetic{Op: tree.BINAND, Left: l, Right: valueMask(l, tree.BinaryNegation{r})}, Right: tree.NumericLiteral{0}}
+	default:
+		s.Result = tree.Comparison{Op: a.Op, Left: l, Right: r}
+	}
+}
+
+// bitPredicateSimplifier rewrites the flag set predicates into comparisons against masked values.
+// When used on full arguments, these will later be split by the full argument splitter
+type bitPredicateSimplifier struct {
+	tree.EmptyTransformer
+}
+
+func createBitPredicateSimplifier() tree.Transformer {
+	s := &bitPredicateSimplifier{}
+	s.RealSelf = s
+	return s
+}
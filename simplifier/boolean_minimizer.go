@@ -0,0 +1,406 @@
+package simplifier
+
+import (
+	"sort"
+
+	"github.com/twtiger/gosecco/tree"
+)
+
+// The boolean minimizer factors the boolean structure of an expression. It builds a reduced ordered binary decision
+// diagram over the comparisons in the expression, and turns the diagram back into an expression. For example
+//   (argL0 == 1 && argL1 == 2) || (argL0 == 1 && argL1 == 3)  ==>  argL0 == 1 && (argL1 == 2 || argL1 == 3)
+// The result depends on the order of the comparisons in the diagram, so a few orders are tried, and the smallest
+// result is used - but only if it contains fewer comparisons than the original expression, since every comparison
+// has to be loaded and tested at runtime. The diagram also knows that a value can't be equal to two different
+// literals, so
+//   (argL1 == 1 && argL2 == 2) || (argL1 == 1 && argL2 == 7) || (argL1 == 0 && argL2 == 19)
+//     ==>  (argL1 == 1 && (argL2 == 2 || argL2 == 7)) || (argL1 == 0 && argL2 == 19)
+
+// maxMinimizedNodes limits the size of the diagrams, since some expressions have diagrams that are exponentially large
+const maxMinimizedNodes = 10000
+
+// minimizeBooleans can be turned off to compare the result with what it would have been without minimization
+var minimizeBooleans = true
+
+// bddNode is a decision on one comparison. The nodes with index 0 and 1 are the false and true results
+type bddNode struct {
+	variable  int
+	low, high int
+}
+
+type bdd struct {
+	atoms  []tree.Expression
+	order  map[string]int
+	nodes  []bddNode
+	unique map[bddNode]int
+	cache  map[[3]int]int
+	// subjects numbers the values compared for equality with a literal by each comparison, or is -1 for other comparisons
+	subjects []int
+	excluded map[[2]uint64]int
+	// tooLarge is set when the diagram grows beyond maxMinimizedNodes, in which case its result is meaningless
+	tooLarge bool
+}
+
+const (
+	bddFalse = 0
+	bddTrue  = 1
+)
+
+func newBDD(atoms []tree.Expression, keys []string) *bdd {
+	b := &bdd{
+		atoms:    atoms,
+		order:    make(map[string]int),
+		unique:   make(map[bddNode]int),
+		cache:    make(map[[3]int]int),
+		excluded: make(map[[2]uint64]int),
+	}
+	for ix, k := range keys {
+		b.order[k] = ix
+	}
+	numbers := make(map[string]int)
+	for _, a := range atoms {
+		subject, ok := equalityOf(a)
+		if _, seen := numbers[subject]; ok && !seen && len(numbers) < 64 {
+			numbers[subject] = len(numbers)
+		}
+		if n, known := numbers[subject]; ok && known {
+			b.subjects = append(b.subjects, n)
+		} else {
+			b.subjects = append(b.subjects, -1)
+		}
+	}
+	b.nodes = []bddNode{{variable: len(atoms)}, {variable: len(atoms)}}
+	return b
+}
+
+func (b *bdd) mk(variable, low, high int) int {
+	if low == high {
+		return low
+	}
+	n := bddNode{variable, low, high}
+	if ix, ok := b.unique[n]; ok {
+		return ix
+	}
+	if len(b.nodes) >= maxMinimizedNodes {
+		b.tooLarge = true
+		return bddFalse
+	}
+	b.nodes = append(b.nodes, n)
+	b.unique[n] = len(b.nodes) - 1
+	return len(b.nodes) - 1
+}
+
+func (b *bdd) restrict(f, variable int, value bool) int {
+	if b.nodes[f].variable != variable {
+		return f
+	}
+	if value {
+		return b.nodes[f].high
+	}
+	return b.nodes[f].low
+}
+
+// ite returns the diagram for if f then g else h
+func (b *bdd) ite(f, g, h int) int {
+	switch {
+	case f == bddTrue:
+		return g
+	case f == bddFalse:
+		return h
+	case g == h:
+		return g
+	case g == bddTrue && h == bddFalse:
+		return f
+	}
+	key := [3]int{f, g, h}
+	if r, ok := b.cache[key]; ok {
+		return r
+	}
+	v := b.nodes[f].variable
+	if b.nodes[g].variable < v {
+		v = b.nodes[g].variable
+	}
+	if b.nodes[h].variable < v {
+		v = b.nodes[h].variable
+	}
+	high := b.ite(b.restrict(f, v, true), b.restrict(g, v, true), b.restrict(h, v, true))
+	low := b.ite(b.restrict(f, v, false), b.restrict(g, v, false), b.restrict(h, v, false))
+	r := b.mk(v, low, high)
+	b.cache[key] = r
+	return r
+}
+
+func (b *bdd) build(x tree.Expression) int {
+	switch v := x.(type) {
+	case tree.BooleanLiteral:
+		if v.Value {
+			return bddTrue
+		}
+		return bddFalse
+	case tree.And:
+		return b.ite(b.build(v.Left), b.build(v.Right), bddFalse)
+	case tree.Or:
+		return b.ite(b.build(v.Left), bddTrue, b.build(v.Right))
+	case tree.Negation:
+		return b.ite(b.build(v.Operand), bddFalse, bddTrue)
+	}
+	key, _, negated := atomOf(x)
+	variable := b.order[key]
+	if negated {
+		return b.mk(variable, bddTrue, bddFalse)
+	}
+	return b.mk(variable, bddFalse, bddTrue)
+}
+
+// equalityOf returns the value a comparison of a value with a literal checks for equality
+func equalityOf(x tree.Expression) (string, bool) {
+	c, ok := x.(tree.Comparison)
+	if !ok || c.Op != tree.EQL {
+		return "", false
+	}
+	_, lok := potentialExtractValue(c.Left)
+	_, rok := potentialExtractValue(c.Right)
+	switch {
+	case rok && !lok:
+		return tree.ExpressionString(c.Left), true
+	case lok && !rok:
+		return tree.ExpressionString(c.Right), true
+	}
+	return "", false
+}
+
+// exclude returns the diagram without the decisions on equalities whose value is already known to be equal to another
+// literal. known contains the numbers of the subjects known to be equal to a literal.
+func (b *bdd) exclude(f int, known uint64) int {
+	if f == bddTrue || f == bddFalse {
+		return f
+	}
+	key := [2]uint64{uint64(f), known}
+	if r, ok := b.excluded[key]; ok {
+		return r
+	}
+	if len(b.excluded) >= maxMinimizedNodes {
+		b.tooLarge = true
+		return bddFalse
+	}
+	n := b.nodes[f]
+	s := b.subjects[n.variable]
+	var r int
+	switch {
+	case s >= 0 && known&(1<<uint(s)) != 0:
+		r = b.exclude(n.low, known)
+	case s >= 0:
+		r = b.mk(n.variable, b.exclude(n.low, known), b.exclude(n.high, known|1<<uint(s)))
+	default:
+		r = b.mk(n.variable, b.exclude(n.low, known), b.exclude(n.high, known))
+	}
+	b.excluded[key] = r
+	return r
+}
+
+// lowExcludesAtom returns true if the low branch of the node can only be true when the comparison of the node is false,
+// in which case the comparison doesn't have to be negated on that branch
+func (b *bdd) lowExcludesAtom(n bddNode) bool {
+	s := b.subjects[n.variable]
+	return s >= 0 && n.low != bddTrue && b.exclude(n.low, 1<<uint(s)) == bddFalse
+}
+
+// expression turns the diagram back into an expression
+func (b *bdd) expression(f int) tree.Expression {
+	switch f {
+	case bddTrue:
+		return tree.BooleanLiteral{true}
+	case bddFalse:
+		return tree.BooleanLiteral{false}
+	}
+	n := b.nodes[f]
+	atom := b.atoms[n.variable]
+	switch {
+	case n.low == bddFalse && n.high == bddTrue:
+		return atom
+	case n.low == bddTrue && n.high == bddFalse:
+		return negateAtom(atom)
+	case n.low == bddFalse:
+		return tree.And{Left: atom, Right: b.expression(n.high)}
+	case n.high == bddFalse && b.lowExcludesAtom(n):
+		return b.expression(n.low)
+	case n.high == bddFalse:
+		return tree.And{Left: negateAtom(atom), Right: b.expression(n.low)}
+	case n.high == bddTrue:
+		return tree.Or{Left: atom, Right: b.expression(n.low)}
+	case n.low == bddTrue:
+		return tree.Or{Left: negateAtom(atom), Right: b.expression(n.high)}
+	case b.lowExcludesAtom(n):
+		return tree.Or{Left: tree.And{Left: atom, Right: b.expression(n.high)}, Right: b.expression(n.low)}
+	}
+	return tree.Or{
+		Left:  tree.And{Left: atom, Right: b.expression(n.high)},
+		Right: tree.And{Left: negateAtom(atom), Right: b.expression(n.low)},
+	}
+}
+
+// emittedSize returns the number of comparisons in the expression the diagram turns into, without building it. Nodes
+// are shared in the diagram but not in the expression, so the size can be exponential in the number of nodes - it is
+// only counted up to the limit.
+func (b *bdd) emittedSize(f int, limit int, sizes map[int]int) int {
+	if f == bddTrue || f == bddFalse {
+		return 0
+	}
+	if size, ok := sizes[f]; ok {
+		return size
+	}
+	n := b.nodes[f]
+	if n.high == bddFalse && b.lowExcludesAtom(n) {
+		return b.emittedSize(n.low, limit, sizes)
+	}
+	size, decisions := 1, 0
+	for _, next := range []int{n.low, n.high} {
+		if next != bddTrue && next != bddFalse {
+			size += b.emittedSize(next, limit, sizes)
+			decisions++
+		}
+	}
+	if decisions == 2 && !b.lowExcludesAtom(n) {
+		// The comparison is used on both sides of an or
+		size++
+	}
+	if size > limit {
+		size = limit + 1
+	}
+	sizes[f] = size
+	return size
+}
+
+// atomOf returns the key of the comparison an expression tests, the comparison itself, and whether the expression
+// is the negation of it. Comparisons that are negations of each other, such as X != Y and X == Y, or Y >= X and
+// X > Y, share the same key. Expressions that aren't comparisons are atoms of their own.
+func atomOf(x tree.Expression) (string, tree.Expression, bool) {
+	c, ok := x.(tree.Comparison)
+	if !ok {
+		return tree.ExpressionString(x), x, false
+	}
+	switch c.Op {
+	case tree.EQL, tree.NEQL:
+		l, r := tree.ExpressionString(c.Left), tree.ExpressionString(c.Right)
+		if r < l {
+			l, r = r, l
+		}
+		return "(eq " + l + " " + r + ")", tree.Comparison{Op: tree.EQL, Left: c.Left, Right: c.Right}, c.Op == tree.NEQL
+	case tree.GT:
+		return tree.ExpressionString(c), c, false
+	case tree.GTE:
+		gt := tree.Comparison{Op: tree.GT, Left: c.Right, Right: c.Left}
+		return tree.ExpressionString(gt), gt, true
+	}
+	return tree.ExpressionString(x), x, false
+}
+
+func negateAtom(x tree.Expression) tree.Expression {
+	if c, ok := x.(tree.Comparison); ok {
+		switch c.Op {
+		case tree.EQL:
+			return tree.Comparison{Op: tree.NEQL, Left: c.Left, Right: c.Right}
+		case tree.GT:
+			return tree.Comparison{Op: tree.GTE, Left: c.Right, Right: c.Left}
+		}
+	}
+	return tree.Negation{Operand: x}
+}
+
+// collectAtoms returns the comparisons of a boolean expression in the order they are first used, together with
+// how many times each of them is used
+func collectAtoms(x tree.Expression, keys *[]string, atoms map[string]tree.Expression, uses map[string]int) {
+	switch v := x.(type) {
+	case tree.BooleanLiteral:
+	case tree.And:
+		collectAtoms(v.Left, keys, atoms, uses)
+		collectAtoms(v.Right, keys, atoms, uses)
+	case tree.Or:
+		collectAtoms(v.Left, keys, atoms, uses)
+		collectAtoms(v.Right, keys, atoms, uses)
+	case tree.Negation:
+		collectAtoms(v.Operand, keys, atoms, uses)
+	default:
+		key, atom, _ := atomOf(x)
+		if _, ok := atoms[key]; !ok {
+			*keys = append(*keys, key)
+			atoms[key] = atom
+		}
+		uses[key]++
+	}
+}
+
+// countAtoms returns the number of comparisons in a boolean expression
+func countAtoms(x tree.Expression) int {
+	switch v := x.(type) {
+	case tree.BooleanLiteral:
+		return 0
+	case tree.And:
+		return countAtoms(v.Left) + countAtoms(v.Right)
+	case tree.Or:
+		return countAtoms(v.Left) + countAtoms(v.Right)
+	case tree.Negation:
+		return countAtoms(v.Operand)
+	}
+	return 1
+}
+
+// minimizeWithOrder returns the expression the diagram with the given order turns into, if it has fewer than
+// limit comparisons
+func minimizeWithOrder(x tree.Expression, keys []string, atoms map[string]tree.Expression, limit int) (tree.Expression, bool) {
+	ordered := make([]tree.Expression, len(keys))
+	for ix, k := range keys {
+		ordered[ix] = atoms[k]
+	}
+	b := newBDD(ordered, keys)
+	f := b.exclude(b.build(x), 0)
+	if b.tooLarge || b.emittedSize(f, limit, make(map[int]int)) >= limit {
+		return nil, false
+	}
+	return b.expression(f), true
+}
+
+func minimizeBoolean(x tree.Expression) tree.Expression {
+	switch x.(type) {
+	case tree.And, tree.Or, tree.Negation:
+	default:
+		return x
+	}
+	if !minimizeBooleans {
+		return x
+	}
+
+	var keys []string
+	atoms := make(map[string]tree.Expression)
+	uses := make(map[string]int)
+	collectAtoms(x, &keys, atoms, uses)
+
+	// Comparisons used in many places are often common to all the alternatives, and can be factored out if they
+	// are decided first
+	byUses := append([]string{}, keys...)
+	sort.SliceStable(byUses, func(i, j int) bool { return uses[byUses[i]] > uses[byUses[j]] })
+
+	best, size := x, countAtoms(x)
+	for _, order := range [][]string{keys, byUses} {
+		if result, ok := minimizeWithOrder(x, order, atoms, size); ok {
+			best, size = result, countAtoms(result)
+		}
+	}
+	return best
+}
+
+// booleanMinimizer replaces boolean expressions with smaller equivalent expressions
+type booleanMinimizer struct {
+	tree.EmptyTransformer
+}
+
+// Transform implements Transformer
+func (s *booleanMinimizer) Transform(x tree.Expression) tree.Expression {
+	return minimizeBoolean(x)
+}
+
+func createBooleanMinimizer() tree.Transformer {
+	s := &booleanMinimizer{}
+	s.RealSelf = s
+	return s
+}
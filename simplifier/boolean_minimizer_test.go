@@ -0,0 +1,199 @@
+package simplifier
+
+import (
+	"math/rand"
+
+	"github.com/twtiger/gosecco/tree"
+	. "gopkg.in/check.v1"
+)
+
+type BooleanMinimizerSuite struct{}
+
+var _ = Suite(&BooleanMinimizerSuite{})
+
+func argEq(index int, value uint64) tree.Comparison {
+	return tree.Comparison{Op: tree.EQL, Left: tree.Argument{Type: tree.Low, Index: index}, Right: tree.NumericLiteral{value}}
+}
+
+func argCmp(op tree.ComparisonType, index int, value uint64) tree.Comparison {
+	return tree.Comparison{Op: op, Left: tree.Argument{Type: tree.Low, Index: index}, Right: tree.NumericLiteral{value}}
+}
+
+func (s *BooleanMinimizerSuite) Test_factorsOutCommonComparison(c *C) {
+	sx := createBooleanMinimizer().Transform(
+		tree.Or{
+			Left:  tree.And{Left: argEq(0, 1), Right: argEq(1, 2)},
+			Right: tree.And{Left: argEq(0, 1), Right: argEq(1, 3)},
+		},
+	)
+
+	c.Assert(tree.ExpressionString(sx), Equals, "(and (eq argL0 1) (or (eq argL1 2) (eq argL1 3)))")
+}
+
+func (s *BooleanMinimizerSuite) Test_factorsOutCommonComparisonUsedLast(c *C) {
+	sx := createBooleanMinimizer().Transform(
+		tree.Or{
+			Left:  tree.And{Left: argEq(1, 2), Right: argEq(0, 1)},
+			Right: tree.And{Left: argEq(1, 3), Right: argEq(0, 1)},
+		},
+	)
+
+	c.Assert(tree.ExpressionString(sx), Equals, "(and (eq argL0 1) (or (eq argL1 2) (eq argL1 3)))")
+}
+
+func (s *BooleanMinimizerSuite) Test_removesComparisonDecidedByNegation(c *C) {
+	sx := createBooleanMinimizer().Transform(
+		tree.Or{
+			Left:  argEq(0, 1),
+			Right: tree.And{Left: argCmp(tree.NEQL, 0, 1), Right: argEq(1, 3)},
+		},
+	)
+
+	c.Assert(tree.ExpressionString(sx), Equals, "(or (eq argL0 1) (eq argL1 3))")
+}
+
+func (s *BooleanMinimizerSuite) Test_treatsGreaterOrEqualAsNegatedGreaterThan(c *C) {
+	sx := createBooleanMinimizer().Transform(
+		tree.And{
+			Left: argCmp(tree.GT, 0, 5),
+			Right: tree.Or{
+				Left:  tree.Comparison{Op: tree.GTE, Left: tree.NumericLiteral{5}, Right: tree.Argument{Type: tree.Low, Index: 0}},
+				Right: argEq(1, 3),
+			},
+		},
+	)
+
+	c.Assert(tree.ExpressionString(sx), Equals, "(and (gt argL0 5) (eq argL1 3))")
+}
+
+func (s *BooleanMinimizerSuite) Test_recognizesEqualityInEitherOrder(c *C) {
+	sx := createBooleanMinimizer().Transform(
+		tree.And{
+			Left:  argEq(0, 1),
+			Right: tree.Comparison{Op: tree.EQL, Left: tree.NumericLiteral{1}, Right: tree.Argument{Type: tree.Low, Index: 0}},
+		},
+	)
+
+	c.Assert(tree.ExpressionString(sx), Equals, "(eq argL0 1)")
+}
+
+func (s *BooleanMinimizerSuite) Test_keepsExpressionsThatCantBeMadeSmaller(c *C) {
+	x := tree.Or{
+		Left:  tree.And{Left: argEq(0, 1), Right: argEq(1, 2)},
+		Right: tree.And{Left: argCmp(tree.NEQL, 0, 1), Right: argEq(2, 3)},
+	}
+
+	c.Assert(createBooleanMinimizer().Transform(x), DeepEquals, x)
+	c.Assert(createBooleanMinimizer().Transform(argEq(0, 1)), DeepEquals, argEq(0, 1))
+}
+
+func (s *BooleanMinimizerSuite) Test_decidesTautologies(c *C) {
+	sx := createBooleanMinimizer().Transform(
+		tree.Or{
+			Left:  tree.And{Left: argEq(0, 1), Right: argCmp(tree.BITSET, 1, 4)},
+			Right: tree.Or{Left: argCmp(tree.NEQL, 0, 1), Right: tree.Negation{Operand: argCmp(tree.BITSET, 1, 4)}},
+		},
+	)
+
+	c.Assert(tree.ExpressionString(sx), Equals, "true")
+}
+
+func (s *BooleanMinimizerSuite) Test_knowsAValueCantEqualTwoLiterals(c *C) {
+	sx := createBooleanMinimizer().Transform(
+		tree.Or{
+			Left: tree.Or{
+				Left:  tree.And{Left: argEq(1, 1), Right: argEq(2, 2)},
+				Right: tree.And{Left: argEq(1, 1), Right: argEq(2, 7)},
+			},
+			Right: tree.And{Left: argEq(1, 0), Right: argEq(2, 19)},
+		},
+	)
+
+	c.Assert(tree.ExpressionString(sx), Equals, "(or (and (eq argL1 1) (or (eq argL2 2) (eq argL2 7))) (and (eq argL1 0) (eq argL2 19)))")
+}
+
+func deepConjunction(k uint64, atom func(int, uint64) tree.Comparison) tree.Expression {
+	var x tree.Expression = tree.Or{Left: atom(0, 1), Right: atom(1, 1)}
+	for i := uint64(2); i <= k; i++ {
+		x = tree.And{Left: x, Right: tree.Or{Left: atom(0, i), Right: atom(1, i)}}
+	}
+	return x
+}
+
+func (s *BooleanMinimizerSuite) Test_givesUpOnDiagramsThatTurnIntoLargeExpressions(c *C) {
+	x := deepConjunction(40, func(ix int, k uint64) tree.Comparison { return argCmp(tree.GT, ix, k) })
+	c.Assert(createBooleanMinimizer().Transform(x), DeepEquals, x)
+
+	// argL0 and argL1 can only be equal to one of the values each
+	x = deepConjunction(40, argEq)
+	c.Assert(createBooleanMinimizer().Transform(x), DeepEquals, tree.BooleanLiteral{false})
+}
+
+// evaluateWith evaluates a boolean expression where the comparisons that are true are given by their keys
+func evaluateWith(x tree.Expression, truths map[string]bool) bool {
+	switch v := x.(type) {
+	case tree.BooleanLiteral:
+		return v.Value
+	case tree.And:
+		return evaluateWith(v.Left, truths) && evaluateWith(v.Right, truths)
+	case tree.Or:
+		return evaluateWith(v.Left, truths) || evaluateWith(v.Right, truths)
+	case tree.Negation:
+		return !evaluateWith(v.Operand, truths)
+	}
+	key, _, negated := atomOf(x)
+	return truths[key] != negated
+}
+
+func randomBoolean(r *rand.Rand, depth int) tree.Expression {
+	if depth == 0 || r.Intn(4) == 0 {
+		ops := []tree.ComparisonType{tree.EQL, tree.NEQL, tree.GT, tree.GTE, tree.BITSET}
+		return argCmp(ops[r.Intn(len(ops))], r.Intn(2), uint64(r.Intn(2)))
+	}
+	switch r.Intn(3) {
+	case 0:
+		return tree.And{Left: randomBoolean(r, depth-1), Right: randomBoolean(r, depth-1)}
+	case 1:
+		return tree.Or{Left: randomBoolean(r, depth-1), Right: randomBoolean(r, depth-1)}
+	}
+	return tree.Negation{Operand: randomBoolean(r, depth-1)}
+}
+
+// possibleAssignment returns false if the truths make a value equal to two different literals
+func possibleAssignment(truths map[string]bool, atoms map[string]tree.Expression) bool {
+	equalTo := make(map[string]bool)
+	for k, t := range truths {
+		if subject, ok := equalityOf(atoms[k]); ok && t {
+			if equalTo[subject] {
+				return false
+			}
+			equalTo[subject] = true
+		}
+	}
+	return true
+}
+
+func (s *BooleanMinimizerSuite) Test_minimizedExpressionsAreEquivalent(c *C) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 500; i++ {
+		x := randomBoolean(r, 5)
+		sx := minimizeBoolean(x)
+		c.Assert(countAtoms(sx) <= countAtoms(x), Equals, true)
+
+		var keys []string
+		atoms := make(map[string]tree.Expression)
+		collectAtoms(x, &keys, atoms, make(map[string]int))
+		for assignment := 0; assignment < 1<<uint(len(keys)); assignment++ {
+			truths := make(map[string]bool)
+			for ix, k := range keys {
+				truths[k] = assignment&(1<<uint(ix)) != 0
+			}
+			if !possibleAssignment(truths, atoms) {
+				continue
+			}
+			if evaluateWith(sx, truths) != evaluateWith(x, truths) {
+				c.Fatalf("%s became %s", tree.ExpressionString(x), tree.ExpressionString(sx))
+			}
+		}
+	}
+}
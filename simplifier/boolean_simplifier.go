@@ -19,6 +19,13 @@ func (s *booleanSimplifier) AcceptOr(a tree.Or) {
 				s.Result = r
 			}
 		}
+	} else if ok2 {
+		// Second branch is possible to calculate at compile time
+		if pr {
+			s.Result = tree.BooleanLiteral{true}
+		} else {
+			s.Result = l
+		}
 	} else {
 		s.Result = tree.Or{l, r}
 	}
@@ -2,98 +2,75 @@ package simplifier
 
 import "github.com/twtiger/gosecco/tree"
 
+// potentialSplit returns the low and high halves of an expression, if it is one of the expressions that can be split:
+// a full argument, a full argument masked with a number, or a number. The last return value is true if the expression
+// refers to a full argument
+func potentialSplit(x tree.Expression) (tree.Numeric, tree.Numeric, bool, bool) {
+	if ix, ok := potentialExtractFullArgument(x); ok {
+		return tree.Argument{Type: tree.Low, Index: ix}, tree.Argument{Type: tree.Hi, Index: ix}, true, true
+	}
+
+	if low, high, ok := potentialExtractValueParts(x); ok {
+		return tree.NumericLiteral{low}, tree.NumericLiteral{high}, false, true
+	}
+
+	if a, ok := x.(tree.Arithmetic); ok && a.Op == tree.BINAND {
+		arg, mask := a.Left, a.Right
+		if _, ok := potentialExtractFullArgument(mask); ok {
+			arg, mask = mask, arg
+		}
+		ix, okArg := potentialExtractFullArgument(arg)
+		maskLow, maskHigh, okMask := potentialExtractValueParts(mask)
+		if okArg && okMask {
+			return maskedHalf(tree.Argument{Type: tree.Low, Index: ix}, maskLow), maskedHalf(tree.Argument{Type: tree.Hi, Index: ix}, maskHigh), true, true
+		}
+	}
+
+	return nil, nil, false, false
+}
+
+// maskedHalf returns one half of a masked full argument. A half that the mask clears is always zero, so it
+// doesn't have to be loaded at all
+func maskedHalf(half tree.Argument, mask uint64) tree.Numeric {
+	if mask == 0 {
+		return tree.NumericLiteral{0}
+	}
+	return tree.Arithmetic{Op: tree.BINAND, Left: half, Right: tree.NumericLiteral{mask}}
+}
+
 // AcceptComparison implements Visitor
 func (s *fullArgumentSplitterSimplifier) AcceptComparison(a tree.Comparison) {
 	l := s.Transform(a.Left)
 	r := s.Transform(a.Right)
 
 	pral, okal := potentialExtractFullArgument(l)
-	prnlLow, prnlHi, oknl := potentialExtractValueParts(l)
 
-	prar, okar := potentialExtractFullArgument(r)
-	prnrLow, prnrHi, oknr := potentialExtractValueParts(r)
+	lLow, lHigh, lArg, okl := potentialSplit(l)
+	rLow, rHigh, rArg, okr := potentialSplit(r)
 
-	if okal && oknr {
+	if okl && okr && (lArg || rArg) {
 		switch a.Op {
 		case tree.EQL:
 			s.Result = tree.And{
-				Left:  tree.Comparison{Op: a.Op, Left: tree.Argument{Type: tree.Low, Index: pral}, Right: tree.NumericLiteral{prnrLow}},
-				Right: tree.Comparison{Op: a.Op, Left: tree.Argument{Type: tree.Hi, Index: pral}, Right: tree.NumericLiteral{prnrHi}},
+				Left:  tree.Comparison{Op: a.Op, Left: lLow, Right: rLow},
+				Right: tree.Comparison{Op: a.Op, Left: lHigh, Right: rHigh},
 			}
 		case tree.BITSET:
 			s.Result = tree.And{
-				Left: tree.Comparison{Op: tree.EQL, Left: tree.Arithmetic{Op: tree.BINAND, Left: tree.Argument{Type: tree.Low, Index: pral}, Right: tree.NumericLiteral{prnrLow}}, Right: tree.NumericLiteral{prnrLow}},
-
-				Right: tree.Comparison{Op: tree.EQL, Left: tree.Arithmetic{Op: tree.BINAND, Left: tree.Argument{Type: tree.Hi, Index: pral}, Right: tree.NumericLiteral{prnrHi}}, Right: tree.NumericLiteral{prnrHi}},
-			}
-		case tree.NEQL:
-			s.Result = tree.Or{
-				Left:  tree.Comparison{Op: a.Op, Left: tree.Argument{Type: tree.Low, Index: pral}, Right: tree.NumericLiteral{prnrLow}},
-				Right: tree.Comparison{Op: a.Op, Left: tree.Argument{Type: tree.Hi, Index: pral}, Right: tree.NumericLiteral{prnrHi}},
+				Left:  tree.Comparison{Op: tree.EQL, Left: tree.Arithmetic{Op: tree.BINAND, Left: lLow, Right: rLow}, Right: rLow},
+				Right: tree.Comparison{Op: tree.EQL, Left: tree.Arithmetic{Op: tree.BINAND, Left: lHigh, Right: rHigh}, Right: rHigh},
 			}
-		case tree.GT, tree.GTE:
-			s.Result = tree.Or{
-				Left: tree.Comparison{Op: tree.GT, Left: tree.Argument{Type: tree.Hi, Index: pral}, Right: tree.NumericLiteral{prnrHi}},
-				Right: tree.And{
-					Left:  tree.Comparison{Op: tree.EQL, Left: tree.Argument{Type: tree.Hi, Index: pral}, Right: tree.NumericLiteral{prnrHi}},
-					Right: tree.Comparison{Op: a.Op, Left: tree.Argument{Type: tree.Low, Index: pral}, Right: tree.NumericLiteral{prnrLow}},
-				},
-			}
-		default:
-			panic("shouldn't happen")
-		}
-	} else if okar && oknl {
-		switch a.Op {
-		case tree.EQL:
-			s.Result = tree.And{
-				Left:  tree.Comparison{Op: a.Op, Left: tree.NumericLiteral{prnlLow}, Right: tree.Argument{Type: tree.Low, Index: prar}},
-				Right: tree.Comparison{Op: a.Op, Left: tree.NumericLiteral{prnlHi}, Right: tree.Argument{Type: tree.Hi, Index: prar}},
-			}
-		case tree.BITSET:
-			s.Result = tree.And{
-				Left:  tree.Comparison{Op: tree.EQL, Left: tree.Arithmetic{Op: tree.BINAND, Left: tree.NumericLiteral{prnlLow}, Right: tree.Argument{Type: tree.Low, Index: prar}}, Right: tree.Argument{Type: tree.Low, Index: prar}},
-				Right: tree.Comparison{Op: tree.EQL, Left: tree.Arithmetic{Op: tree.BINAND, Left: tree.NumericLiteral{prnlHi}, Right: tree.Argument{Type: tree.Hi, Index: prar}}, Right: tree.Argument{Type: tree.Hi, Index: prar}},
-			}
-		case tree.NEQL:
-			s.Result = tree.Or{
-				Left:  tree.Comparison{Op: a.Op, Left: tree.NumericLiteral{prnlLow}, Right: tree.Argument{Type: tree.Low, Index: prar}},
-				Right: tree.Comparison{Op: a.Op, Left: tree.NumericLiteral{prnlHi}, Right: tree.Argument{Type: tree.Hi, Index: prar}},
-			}
-		case tree.GT, tree.GTE:
-			s.Result = tree.Or{
-				Left: tree.Comparison{Op: tree.GT, Left: tree.NumericLiteral{prnlHi}, Right: tree.Argument{Type: tree.Hi, Index: prar}},
-				Right: tree.And{
-					Left:  tree.Comparison{Op: tree.EQL, Left: tree.NumericLiteral{prnlHi}, Right: tree.Argument{Type: tree.Hi, Index: prar}},
-					Right: tree.Comparison{Op: a.Op, Left: tree.NumericLiteral{prnlLow}, Right: tree.Argument{Type: tree.Low, Index: prar}},
-				},
-			}
-		default:
-			panic("shouldn't happen")
-		}
-	} else if okal && okar {
-		switch a.Op {
-		case tree.EQL:
-			s.Result = tree.And{
-				Left:  tree.Comparison{Op: a.Op, Left: tree.Argument{Type: tree.Low, Index: pral}, Right: tree.Argument{Type: tree.Low, Index: prar}},
-				Right: tree.Comparison{Op: a.Op, Left: tree.Argument{Type: tree.Hi, Index: pral}, Right: tree.Argument{Type: tree.Hi, Index: prar}},
-			}
-		case tree.BITSET:
-			s.Result = tree.And{
-				Left:  tree.Comparison{Op: tree.EQL, Left: tree.Arithmetic{Op: tree.BINAND, Left: tree.Argument{Type: tree.Low, Index: pral}, Right: tree.Argument{Type: tree.Low, Index: prar}}, Right: tree.Argument{Type: tree.Low, Index: prar}},
-				Right: tree.Comparison{Op: tree.EQL, Left: tree.Arithmetic{Op: tree.BINAND, Left: tree.Argument{Type: tree.Hi, Index: pral}, Right: tree.Argument{Type: tree.Hi, Index: prar}}, Right: tree.Argument{Type: tree.Hi, Index: prar}},
-			}
-
 		case tree.NEQL:
 			s.Result = tree.Or{
-				Left:  tree.Comparison{Op: a.Op, Left: tree.Argument{Type: tree.Low, Index: pral}, Right: tree.Argument{Type: tree.Low, Index: prar}},
-				Right: tree.Comparison{Op: a.Op, Left: tree.Argument{Type: tree.Hi, Index: pral}, Right: tree.Argument{Type: tree.Hi, Index: prar}},
+				Left:  tree.Comparison{Op: a.Op, Left: lLow, Right: rLow},
+				Right: tree.Comparison{Op: a.Op, Left: lHigh, Right: rHigh},
 			}
 		case tree.GT, tree.GTE:
 			s.Result = tree.Or{
-				Left: tree.Comparison{Op: tree.GT, Left: tree.Argument{Type: tree.Hi, Index: pral}, Right: tree.Argument{Type: tree.Hi, Index: prar}},
+				Left: tree.Comparison{Op: tree.GT, Left: lHigh, Right: rHigh},
 				Right: tree.And{
-					Left:  tree.Comparison{Op: tree.EQL, Left: tree.Argument{Type: tree.Hi, Index: pral}, Right: tree.Argument{Type: tree.Hi, Index: prar}},
-					Right: tree.Comparison{Op: a.Op, Left: tree.Argument{Type: tree.Low, Index: pral}, Right: tree.Argument{Type: tree.Low, Index: prar}},
+					Left:  tree.Comparison{Op: tree.EQL, Left: lHigh, Right: rHigh},
+					Right: tree.Comparison{Op: a.Op, Left: lLow, Right: rLow},
 				},
 			}
 		default:
@@ -104,6 +81,8 @@ func (s *fullArgumentSplitterSimplifier) AcceptComparison(a tree.Comparison) {
 			Left:  tree.Comparison{Op: tree.EQL, Left: tree.Argument{Type: tree.Hi, Index: pral}, Right: tree.NumericLiteral{0}},
 			Right: tree.Comparison{Op: tree.NEQL, Left: tree.Arithmetic{Op: tree.BINAND, Left: tree.Argument{Type: tree.Low, Index: pral}, Right: a.Right}, Right: tree.NumericLiteral{0}},
 		}
+	} else if a.Op == tree.BITSET {
+		s.Result = tree.Comparison{Op: tree.EQL, Left: tree.Arithmetic{Op: tree.BINAND, Left: l, Right: r}, Right: r}
 	} else {
 		s.Result = tree.Comparison{Op: a.Op, Left: l, Right: r}
 	}
@@ -114,11 +93,14 @@ func (s *fullArgumentSplitterSimplifier) AcceptComparison(a tree.Comparison) {
 // this simplifier is expected to run after the inclusion simplifiers and the LT and LTE simplifiers
 // since it will not deal well with those situations
 // It can compare full arguments against each other
-// It can also deal well with arguments on one side and numbers on the other side
+// It can also deal well with arguments on one side and numbers on the other side, and with full arguments
+// masked with a number
 // If the result on one side is the result of a calculation, this simplifier
 // will default to assume the wanted behavior is that the upper half of the other side is
 // all zeroes. Everything else is obvious.
 // It deals specifically with the cases for EQL, NEQL, GT, GTE and BITSET
+// A BITSET that doesn't involve full arguments is turned into a check that the masked value equals the mask,
+// since the compiler can't generate code for it directly
 type fullArgumentSplitterSimplifier struct {
 	tree.EmptyTransformer
 }
@@ -89,6 +89,18 @@ func (s *FullArgumentSplitterSimplifierSuite) Test_simplifiesBitsetWithArgAgains
 	c.Assert(tree.ExpressionString(sx), Equals, "(and (eq argH2 0) (neq (binand argL2 (binor 1 2)) 0))")
 }
 
+func (s *FullArgumentSplitterSimplifierSuite) Test_simplifiesBitsetWithoutFullArguments(c *C) {
+	sx := createFullArgumentSplitterSimplifier().Transform(
+		tree.Comparison{
+			Op:    tree.BITSET,
+			Left:  tree.Argument{Type: tree.Low, Index: 2},
+			Right: tree.NumericLiteral{0x40},
+		},
+	)
+
+	c.Assert(tree.ExpressionString(sx), Equals, "(eq (binand argL2 64) 64)")
+}
+
 func (s *FullArgumentSplitterSimplifierSuite) Test_simplifiesNonequalityWithArgAgainstNumber(c *C) {
 	sx := createFullArgumentSplitterSimplifier().Transform(
 		tree.Comparison{
@@ -18,6 +18,21 @@ func combineAsAnds(parts []tree.Expression) tree.Expression {
 	return tree.And{Left: parts[0], Right: combineAsAnds(parts[1:])}
 }
 
+// rangeComparison checks whether the value is inside of the range, or outside of it for a negative inclusion
+// Only GT and GTE are used, since those are the comparisons the compiler handles directly
+func rangeComparison(positive bool, value, low, high tree.Numeric) tree.Expression {
+	if positive {
+		return tree.And{
+			Left:  tree.Comparison{Op: tree.GTE, Left: value, Right: low},
+			Right: tree.Comparison{Op: tree.GTE, Left: high, Right: value},
+		}
+	}
+	return tree.Or{
+		Left:  tree.Comparison{Op: tree.GT, Left: low, Right: value},
+		Right: tree.Comparison{Op: tree.GT, Left: value, Right: high},
+	}
+}
+
 // AcceptInclusion implements Visitor
 func (s *inclusionRemoverSimplifier) AcceptInclusion(a tree.Inclusion) {
 	l := s.Transform(a.Left)
@@ -29,9 +44,17 @@ func (s *inclusionRemoverSimplifier) AcceptInclusion(a tree.Inclusion) {
 		combiner = combineAsAnds
 	}
 
-	result := make([]tree.Expression, len(a.Rights))
-	for ix, v := range a.Rights {
-		result[ix] = tree.Comparison{Op: op, Left: l, Right: s.Transform(v)}
+	result := []tree.Expression{}
+	for _, v := range a.Rights {
+		result = append(result, tree.Comparison{Op: op, Left: l, Right: s.Transform(v)})
+	}
+	for _, r := range a.Ranges {
+		result = append(result, rangeComparison(a.Positive, l, s.Transform(r.Low), s.Transform(r.High)))
+	}
+
+	if len(result) == 0 {
+		s.Result = tree.BooleanLiteral{!a.Positive}
+		return
 	}
 
 	s.Result = combiner(result)
@@ -26,6 +26,29 @@ func (s *InclusionRemoverSimplifierSuite) Test_removesInclusionStatementCorrectl
 
 }
 
+func (s *InclusionRemoverSimplifierSuite) Test_removesRangesCorrectly(c *C) {
+	sx := createInclusionRemoverSimplifier().Transform(
+		tree.Inclusion{
+			Positive: true,
+			Left:     tree.Argument{Type: tree.Full, Index: 1},
+			Rights:   []tree.Numeric{tree.NumericLiteral{1}},
+			Ranges:   []tree.Range{tree.Range{Low: tree.NumericLiteral{3}, High: tree.NumericLiteral{5}}},
+		},
+	)
+
+	c.Assert(tree.ExpressionString(sx), Equals, "(or (eq arg1 1) (and (gte arg1 3) (gte 5 arg1)))")
+
+	sx = createInclusionRemoverSimplifier().Transform(
+		tree.Inclusion{
+			Positive: false,
+			Left:     tree.Argument{Type: tree.Full, Index: 1},
+			Ranges:   []tree.Range{tree.Range{Low: tree.NumericLiteral{3}, High: tree.NumericLiteral{5}}},
+		},
+	)
+
+	c.Assert(tree.ExpressionString(sx), Equals, "(or (gt 3 arg1) (gt arg1 5))")
+}
+
 func (s *InclusionRemoverSimplifierSuite) Test_removesNotInclusionStatementCorrectly(c *C) {
 	sx := createInclusionRemoverSimplifier().Transform(
 		tree.Inclusion{
@@ -15,7 +15,26 @@ func (s *inclusionSimplifier) AcceptInclusion(a tree.Inclusion) {
 		resultVals[ix], resultOks[ix] = potentialExtractValue(result[ix])
 	}
 
+	var ranges []tree.Range
+	for _, r := range a.Ranges {
+		ranges = append(ranges, tree.Range{Low: s.Transform(r.Low), High: s.Transform(r.High)})
+	}
+
 	if pok {
+		newRanges := []tree.Range{}
+		for _, r := range ranges {
+			low, lok := potentialExtractValue(r.Low)
+			high, hok := potentialExtractValue(r.High)
+			if lok && hok {
+				if low <= pl && pl <= high {
+					s.Result = tree.BooleanLiteral{a.Positive}
+					return
+				}
+			} else {
+				newRanges = append(newRanges, r)
+			}
+		}
+
 		newResults := []tree.Numeric{}
 		for ix, v := range result {
 			if resultOks[ix] {
@@ -33,15 +52,15 @@ func (s *inclusionSimplifier) AcceptInclusion(a tree.Inclusion) {
 				}
 			}
 		}
-		if len(newResults) == 0 {
+		if len(newResults) == 0 && len(newRanges) == 0 {
 			s.Result = tree.BooleanLiteral{!a.Positive}
-		} else if a.Positive == true && len(newResults) == 1 {
+		} else if a.Positive == true && len(newResults) == 1 && len(newRanges) == 0 {
 			s.Result = tree.Comparison{Op: tree.EQL, Left: l, Right: newResults[0]}
 		} else {
-			s.Result = tree.Inclusion{Positive: a.Positive, Left: l, Rights: newResults}
+			s.Result = tree.Inclusion{Positive: a.Positive, Left: l, Rights: newResults, Ranges: newRanges}
 		}
 	} else {
-		s.Result = tree.Inclusion{Positive: a.Positive, Left: l, Rights: result}
+		s.Result = tree.Inclusion{Positive: a.Positive, Left: l, Rights: result, Ranges: ranges}
 	}
 }
 
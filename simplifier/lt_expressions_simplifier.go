@@ -11,10 +11,10 @@ func (s *ltExpressionsSimplifier) AcceptComparison(a tree.Comparison) {
 
 	switch a.Op {
 	case tree.LT:
-		newOp = tree.GTE
+		newOp = tree.GT
 		l, r = r, l
 	case tree.LTE:
-		newOp = tree.GT
+		newOp = tree.GTE
 		l, r = r, l
 	}
 
@@ -23,6 +23,7 @@ func (s *ltExpressionsSimplifier) AcceptComparison(a tree.Comparison) {
 }
 
 // ltExpressionsSimplifier simplifies LT and LTE expressions by rewriting them to GT and GTE expressions
+// with the operands swapped, so a < b becomes b > a and a <= b becomes b >= a
 type ltExpressionsSimplifier struct {
 	tree.EmptyTransformer
 }
@@ -0,0 +1,87 @@
+package simplifier
+
+import (
+	"testing"
+
+	"github.com/twtiger/gosecco/compiler"
+	"github.com/twtiger/gosecco/parser"
+	"github.com/twtiger/gosecco/unifier"
+
+	. "gopkg.in/check.v1"
+)
+
+var exampleProfiles = []string{
+	"../profiles/tor.seccomp",
+	"../profiles/openssh.seccomp",
+}
+
+// compileProfile returns the number of instructions the given profile compiles to
+func compileProfile(path string, minimize bool) (int, error) {
+	defer func(old bool) { minimizeBooleans = old }(minimizeBooleans)
+	minimizeBooleans = minimize
+
+	rp, err := parser.ParseFile(path)
+	if err != nil {
+		return 0, err
+	}
+	pol, err := unifier.Unify(rp, nil, "", "", "")
+	if err != nil {
+		return 0, err
+	}
+	SimplifyPolicy(&pol)
+	filters, err := compiler.Compile(pol)
+	if err != nil {
+		return 0, err
+	}
+	return len(filters), nil
+}
+
+type ProfilesSuite struct{}
+
+var _ = Suite(&ProfilesSuite{})
+
+func (s *ProfilesSuite) Test_minimizationNeverGrowsExampleProfiles(c *C) {
+	for _, p := range exampleProfiles {
+		before, err := compileProfile(p, false)
+		c.Assert(err, IsNil)
+		after, err := compileProfile(p, true)
+		c.Assert(err, IsNil)
+		c.Assert(after <= before, Equals, true, Commentf("%s compiled to %d instructions, and %d without minimization", p, after, before))
+	}
+}
+
+func (s *ProfilesSuite) Test_minimizationShrinksTheTorProfile(c *C) {
+	before, err := compileProfile(exampleProfiles[0], false)
+	c.Assert(err, IsNil)
+	after, err := compileProfile(exampleProfiles[0], true)
+	c.Assert(err, IsNil)
+	c.Assert(after < before, Equals, true, Commentf("compiled to %d instructions, and %d without minimization", after, before))
+}
+
+func benchmarkProfile(b *testing.B, path string, minimize bool) {
+	var n int
+	var err error
+	for i := 0; i < b.N; i++ {
+		n, err = compileProfile(path, minimize)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(n), "instructions")
+}
+
+func BenchmarkTorProfile(b *testing.B) {
+	benchmarkProfile(b, exampleProfiles[0], true)
+}
+
+func BenchmarkTorProfileWithoutMinimization(b *testing.B) {
+	benchmarkProfile(b, exampleProfiles[0], false)
+}
+
+func BenchmarkOpenSSHProfile(b *testing.B) {
+	benchmarkProfile(b, exampleProfiles[1], true)
+}
+
+func BenchmarkOpenSSHProfileWithoutMinimization(b *testing.B) {
+	benchmarkProfile(b, exampleProfiles[1], false)
+}
@@ -0,0 +1,75 @@
+package simplifier
+
+import "github.com/twtiger/gosecco/tree"
+
+// flipSign flips the sign bit of a 32bit value. This makes an unsigned comparison of the flipped values give the same
+// answer as a signed comparison of the original values. Literals are cut down to 32 bits.
+func flipSign(x tree.Numeric) tree.Numeric {
+	if v, ok := potentialExtractValue(x); ok {
+		return tree.NumericLiteral{(v ^ 0x80000000) & 0xFFFFFFFF}
+	}
+	return tree.Arithmetic{Op: tree.BINXOR, Left: x, Right: tree.NumericLiteral{0x80000000}}
+}
+
+// AcceptComparison implements Visitor
+func (s *signedComparisonSimplifier) AcceptComparison(a tree.Comparison) {
+	l := s.Transform(a.Left)
+	r := s.Transform(a.Right)
+
+	var op tree.ComparisonType
+	switch a.Op {
+	case tree.SGT:
+		op = tree.GT
+	case tree.SGTE:
+		op = tree.GTE
+	case tree.SLT:
+		op = tree.GT
+		l, r = r, l
+	case tree.SLTE:
+		op = tree.GTE
+		l, r = r, l
+	default:
+		s.Result = tree.Comparison{Op: a.Op, Left: l, Right: r}
+		return
+	}
+
+	pl, okl := potentialExtractValue(l)
+	pr, okr := potentialExtractValue(r)
+	if okl && okr {
+		if op == tree.GT {
+			s.Result = tree.BooleanLiteral{int64(pl) > int64(pr)}
+		} else {
+			s.Result = tree.BooleanLiteral{int64(pl) >= int64(pr)}
+		}
+		return
+	}
+
+	lLow, lHigh, lArg, okl := potentialSplit(l)
+	rLow, rHigh, rArg, okr := potentialSplit(r)
+
+	if okl && okr && (lArg || rArg) {
+		s.Result = tree.Or{
+			Left: tree.Comparison{Op: tree.GT, Left: flipSign(lHigh), Right: flipSign(rHigh)},
+			Right: tree.And{
+				Left:  tree.Comparison{Op: tree.EQL, Left: lHigh, Right: rHigh},
+				Right: tree.Comparison{Op: op, Left: lLow, Right: rLow},
+			},
+		}
+		return
+	}
+
+	s.Result = tree.Comparison{Op: op, Left: flipSign(l), Right: flipSign(r)}
+}
+
+// signedComparisonSimplifier rewrites signed comparisons into unsigned GT and GTE comparisons, since BPF only
+// has unsigned jumps. Comparisons against full arguments will be split into the upper and lower halves, where
+// only the upper half carries the sign. All other values are treated as 32bit signed values.
+type signedComparisonSimplifier struct {
+	tree.EmptyTransformer
+}
+
+func createSignedComparisonSimplifier() tree.Transformer {
+	s := &signedComparisonSimplifier{}
+	s.RealSelf = s
+	return s
+}
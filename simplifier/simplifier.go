@@ -15,16 +15,51 @@ func reduceTransformers(inp tree.Expression, ss ...tree.Transformer) tree.Expres
 // SimplifyPolicy will take a policy and simplify all expressions in it
 func SimplifyPolicy(pol *tree.Policy) {
 	for _, r := range pol.Rules {
+		if len(r.Outcomes) > 0 {
+			outcomes := []tree.Outcome{}
+			for _, o := range r.Outcomes {
+				outcomes = append(outcomes, tree.Outcome{Condition: Simplify(o.Condition), Action: o.Action})
+			}
+			r.Outcomes = outcomes
+			continue
+		}
 		r.Body = Simplify(r.Body)
 	}
 }
 
 // Simplify will take an expression and reduce it as much as possible using state operations
 func Simplify(inp tree.Expression) tree.Expression {
+	return simplify(inp, nil)
+}
+
+// WidthDifferences returns a description of every calculation in the expression that the simplifier wraps around
+// at 32 bits, because it is part of a calculation BPF does at runtime, where the result doesn't fit in 32 bits
+func WidthDifferences(inp tree.Expression) []string {
+	differences := []string{}
+	simplify(inp, &differences)
+	return differences
+}
+
+// SimplifyRuntime works like Simplify, for an expression that is part of a calculation BPF does at runtime
+func SimplifyRuntime(inp tree.Expression) tree.Expression {
+	s := &arithmeticSimplifier{runtime: true}
+	s.RealSelf = s
+	return Simplify(s.Transform(inp))
+}
+
+func simplify(inp tree.Expression, differences *[]string) tree.Expression {
 	return reduceTransformers(inp,
+		// allBits(X, M)   ==>  X & M == M
+		// anyBits(X, M)   ==>  X & M != 0
+		// noBits(X, M)    ==>  X & M == 0
+		// onlyBits(X, M)  ==>  X & ~M == 0
+		createBitPredicateSimplifier(),
+
 		// X in [P]  ==>  P == Q
 		// X in [P, Q, R]  where X and R can be determined to not be equal  ==>  X in [P, Q]
 		// X in [P, Q, R]  where X and one of the values can be determined to be equal  ==>  true
+		// X in [P..Q]  where X, P and Q can be determined and X is outside the range  ==>  X in []
+		// X in [P..Q]  where X, P and Q can be determined and X is inside the range  ==>  true
 		// X notIn [P]  ==>  X != P
 		// X notIn [P, Q, R]  where X and R can be determined to not be equal  ==>  X notIn [P, Q]
 		// X notIn [P, Q, R]  where X and one of the values can be determined to be equal  ==>  false
@@ -32,10 +67,12 @@ func Simplify(inp tree.Expression) tree.Expression {
 
 		// X in [P, Q, R]     ==>  X == P || X == Q || X == R
 		// X notIn [P, Q, R]  ==>  X != P && X != Q && X != R
+		// X in [P..Q]        ==>  X >= P && Q >= X
+		// X notIn [P..Q]     ==>  P > X || X > Q
 		createInclusionRemoverSimplifier(),
 
-		// X < Y    ==>  Y >= X
-		// X <= Y   ==>  Y > X
+		// X < Y    ==>  Y > X
+		// X <= Y   ==>  Y >= X
 		createLtExpressionsSimplifier(),
 
 		// Where X and Y can be determined statically:
@@ -50,9 +87,24 @@ func Simplify(inp tree.Expression) tree.Expression {
 		// X << Y  ==>  [X<<Y]
 		// X >> Y  ==>  [X<<Y]
 		// ~X      ==>  [~X]
-		// Note that these calculations will all be done on 64bit unsigned values
-		// - this could lead to different result than if they were evaluated by the BPF engine.
-		createArithmeticSimplifier(),
+		// Divisions and modulo by zero are left alone, for the checker to report
+		// These calculations are done on 64bit unsigned values, except inside of a calculation that BPF does
+		// at runtime because it uses argL or argH. There the results wrap around at 32 bits, like they would
+		// if the BPF engine calculated them, and the calculations that wrap around are reported as differences.
+		// X == -N  where X is calculated at runtime with argL or argH  ==>  X == [-N & 0xFFFFFFFF]
+		// and the same for the other comparisons, and in the opposite order
+		createArithmeticSimplifierReporting(differences),
+
+		// Where X and Y can be determined statically:
+		// X s> Y  ==>  [X>Y] and the same for the other signed comparisons, with X and Y as signed 64bit values
+		// Where X or Y is a full argument (the opposite order is also valid):
+		// arg0 s> X   ==>  argH0^0x80000000 > X.high^0x80000000 || (argH0 == X.high && argL0 > X.low)
+		// arg0 s>= X  ==>  argH0^0x80000000 > X.high^0x80000000 || (argH0 == X.high && argL0 >= X.low)
+		// Otherwise:
+		// X s> Y      ==>  X^0x80000000 > Y^0x80000000
+		// X s>= Y     ==>  X^0x80000000 >= Y^0x80000000
+		// X s< Y and X s<= Y are first rewritten to Y s> X and Y s>= X
+		createSignedComparisonSimplifier(),
 
 		// Where X and Y can be determined statically:
 		// X == Y  where X == Y  ==>  true
@@ -75,6 +127,8 @@ func Simplify(inp tree.Expression) tree.Expression {
 		// false || true   ==>  true
 		// false || false  ==>  false
 		// true  || Y      ==>  true
+		// X || false      ==>  X
+		// X || true       ==>  true
 		// true  && true   ==>  true
 		// true  && false  ==>  false
 		// true  && Y      ==>  Y
@@ -82,6 +136,7 @@ func Simplify(inp tree.Expression) tree.Expression {
 		createBooleanSimplifier(),
 
 		// ~X  ==> X ^ 0xFFFFFFFFFFFFFFFF
+		// ~X  ==> X ^ 0xFFFFFFFF  where X is calculated at runtime with argL or argH
 		createBinaryNegationSimplifier(),
 
 		// Where X can be determined statically (the opposite order is also valid)
@@ -93,13 +148,20 @@ func Simplify(inp tree.Expression) tree.Expression {
 		// arg0 != arg1  ==>  argL0 != argL1 || argH0 != argH1
 		// arg0 > arg1   ==>  argH0 > argH1  || (argH0 == argH1 && argL0 > argL1)
 		// arg0 >= arg1  ==>  argH0 > argH1  || (argH0 == argH1 && argL0 >= argL1)
+		// arg0 & M is split into argL0 & M.low and argH0 & M.high, where a half is 0 if the mask clears it
 		createFullArgumentSplitterSimplifier(),
 
 		// We repeat some of the simplifiers in the hope that the above operations have opened up new avenues of simplification
-		createArithmeticSimplifier(),
+		createArithmeticSimplifierReporting(differences),
 		createComparisonSimplifier(),
 		createBooleanSimplifier(),
 		createBinaryNegationSimplifier(),
+
+		// Where the result has fewer comparisons:
+		// (A && B) || (A && C)  ==>  A && (B || C)
+		// (A || B) && (A || C)  ==>  A || (B && C)
+		// X == Y || (X != Y && C)  ==>  X == Y || C
+		createBooleanMinimizer(),
 	)
 }
 
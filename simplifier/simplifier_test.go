@@ -39,6 +39,14 @@ func (s *SimplifierSuite) Test_simplifyMod(c *C) {
 	c.Assert(tree.ExpressionString(sx), Equals, "1")
 }
 
+func (s *SimplifierSuite) Test_simplifyLeavesDivisionByZeroAlone(c *C) {
+	sx := Simplify(tree.Arithmetic{Op: tree.DIV, Left: tree.NumericLiteral{37}, Right: tree.Arithmetic{Op: tree.MINUS, Left: tree.NumericLiteral{3}, Right: tree.NumericLiteral{3}}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(div 37 0)")
+
+	sx = Simplify(tree.Arithmetic{Op: tree.MOD, Left: tree.NumericLiteral{37}, Right: tree.NumericLiteral{0}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(mod 37 0)")
+}
+
 func (s *SimplifierSuite) Test_simplifyBinAnd(c *C) {
 	sx := Simplify(tree.Arithmetic{Op: tree.BINAND, Left: tree.NumericLiteral{7}, Right: tree.NumericLiteral{4}})
 	c.Assert(tree.ExpressionString(sx), Equals, "4")
@@ -159,6 +167,43 @@ func (s *SimplifierSuite) Test_simplifyBinaryNegation(c *C) {
 	c.Assert(tree.ExpressionString(sx), Equals, "18446744073709551573")
 }
 
+func (s *SimplifierSuite) Test_simplifyCalculationsDoneAtRuntimeWith32Bits(c *C) {
+	low := tree.Argument{Type: tree.Low, Index: 0}
+
+	sx := Simplify(tree.Arithmetic{Op: tree.BINAND, Left: low, Right: tree.BinaryNegation{tree.NumericLiteral{42}}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(binand argL0 4294967253)")
+
+	sx = Simplify(tree.Arithmetic{Op: tree.PLUS, Left: low,
+		Right: tree.Arithmetic{Op: tree.RSH, Left: tree.Arithmetic{Op: tree.PLUS, Left: tree.NumericLiteral{0xFFFFFFFF}, Right: tree.NumericLiteral{1}}, Right: tree.NumericLiteral{1}}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(plus argL0 0)")
+
+	sx = Simplify(tree.Comparison{Op: tree.EQL, Left: low, Right: tree.Arithmetic{Op: tree.RSH, Left: tree.NumericLiteral{0x100000000}, Right: tree.NumericLiteral{1}}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(eq argL0 2147483648)")
+
+	sx = Simplify(tree.Comparison{Op: tree.EQL,
+		Left:  tree.Arithmetic{Op: tree.BINAND, Left: tree.Argument{Type: tree.Full, Index: 0}, Right: tree.BinaryNegation{tree.NumericLiteral{42}}},
+		Right: tree.NumericLiteral{0}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(and (eq (binand argL0 4294967253) 0) (eq (binand argH0 4294967295) 0))")
+}
+
+func (s *SimplifierSuite) Test_reportsCalculationsThatWrapAroundAt32Bits(c *C) {
+	low := tree.Argument{Type: tree.Low, Index: 0}
+
+	c.Assert(WidthDifferences(tree.Comparison{Op: tree.EQL,
+		Left:  tree.Arithmetic{Op: tree.PLUS, Left: low, Right: tree.Arithmetic{Op: tree.PLUS, Left: tree.NumericLiteral{0xFFFFFFFF}, Right: tree.NumericLiteral{1}}},
+		Right: tree.Arithmetic{Op: tree.MINUS, Left: tree.NumericLiteral{0}, Right: tree.NumericLiteral{1}}}), DeepEquals, []string{
+		"Calculation wraps around to 0x0 with the 32 bits BPF uses, instead of 0x100000000: (plus 4294967295 1)",
+	})
+
+	c.Assert(WidthDifferences(tree.Arithmetic{Op: tree.BINAND, Left: low, Right: tree.BinaryNegation{tree.NumericLiteral{0x1FFFFFFFF}}}), DeepEquals, []string{
+		"Calculation wraps around to 0x0 with the 32 bits BPF uses, instead of 0xFFFFFFFE00000000: (binNeg 8589934591)",
+	})
+
+	c.Assert(WidthDifferences(tree.Arithmetic{Op: tree.BINAND, Left: low, Right: tree.BinaryNegation{tree.NumericLiteral{42}}}), HasLen, 0)
+	c.Assert(WidthDifferences(tree.Arithmetic{Op: tree.BINAND, Left: tree.Argument{Type: tree.Full, Index: 0},
+		Right: tree.Arithmetic{Op: tree.PLUS, Left: tree.NumericLiteral{0xFFFFFFFF}, Right: tree.NumericLiteral{1}}}), HasLen, 0)
+}
+
 func (s *SimplifierSuite) Test_simplifyBooleanLiteral(c *C) {
 	sx := Simplify(tree.BooleanLiteral{true})
 	c.Assert(tree.ExpressionString(sx), Equals, "true")
@@ -290,3 +335,143 @@ func (s *SimplifierSuite) Test_NumericLiteral(c *C) {
 	sx := Simplify(t)
 	c.Assert(sx, Equals, t)
 }
+
+func (s *SimplifierSuite) Test_simplifyInclusionWithRanges(c *C) {
+	sx := reduceTransformers(tree.Inclusion{
+		Positive: true,
+		Left:     tree.NumericLiteral{5},
+		Ranges: []tree.Range{
+			tree.Range{Low: tree.NumericLiteral{1}, High: tree.NumericLiteral{3}},
+			tree.Range{Low: tree.NumericLiteral{4}, High: tree.NumericLiteral{6}},
+		}}, inclusionSimplifiers...)
+	c.Assert(tree.ExpressionString(sx), Equals, "true")
+
+	sx = reduceTransformers(tree.Inclusion{
+		Positive: false,
+		Left:     tree.NumericLiteral{5},
+		Ranges: []tree.Range{
+			tree.Range{Low: tree.NumericLiteral{1}, High: tree.NumericLiteral{3}},
+		}}, inclusionSimplifiers...)
+	c.Assert(tree.ExpressionString(sx), Equals, "true")
+
+	sx = reduceTransformers(tree.Inclusion{
+		Positive: true,
+		Left:     tree.NumericLiteral{5},
+		Rights:   []tree.Numeric{tree.Argument{Index: 0}},
+		Ranges: []tree.Range{
+			tree.Range{Low: tree.NumericLiteral{1}, High: tree.NumericLiteral{3}},
+			tree.Range{Low: tree.Argument{Index: 1}, High: tree.NumericLiteral{3}},
+		}}, inclusionSimplifiers...)
+	c.Assert(tree.ExpressionString(sx), Equals, "(in 5 arg0 (range arg1 3))")
+}
+
+func (s *SimplifierSuite) Test_simplifyRangeOnFullArgument(c *C) {
+	sx := Simplify(tree.Inclusion{
+		Positive: true,
+		Left:     tree.Argument{Type: tree.Full, Index: 1},
+		Ranges: []tree.Range{
+			tree.Range{Low: tree.NumericLiteral{0x1000}, High: tree.NumericLiteral{0x100000000}},
+		}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(and (or (gt argH1 0) (and (eq argH1 0) (gte argL1 4096))) (or (gt 1 argH1) (and (eq 1 argH1) (gte 0 argL1))))")
+}
+
+func (s *SimplifierSuite) Test_simplifyMaskedInclusionOnFullArgument(c *C) {
+	sx := Simplify(tree.Inclusion{
+		Positive: true,
+		Left:     tree.Arithmetic{Op: tree.BINAND, Left: tree.Argument{Type: tree.Full, Index: 2}, Right: tree.NumericLiteral{0x300000003}},
+		Rights:   []tree.Numeric{tree.NumericLiteral{0}, tree.NumericLiteral{0x100000002}},
+	})
+	c.Assert(tree.ExpressionString(sx), Equals, "(or (and (eq (binand argL2 3) 0) (eq (binand argH2 3) 0)) (and (eq (binand argL2 3) 2) (eq (binand argH2 3) 1)))")
+}
+
+func (s *SimplifierSuite) Test_simplifyFullArgumentMaskedToOneHalf(c *C) {
+	low := tree.Arithmetic{Op: tree.BINAND, Left: tree.Argument{Type: tree.Full, Index: 0}, Right: tree.NumericLiteral{0xFF}}
+	high := tree.Arithmetic{Op: tree.BINAND, Left: tree.Argument{Type: tree.Full, Index: 0}, Right: tree.NumericLiteral{0xFF00000000}}
+
+	sx := Simplify(tree.Comparison{Op: tree.EQL, Left: low, Right: tree.NumericLiteral{5}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(eq (binand argL0 255) 5)")
+
+	sx = Simplify(tree.Comparison{Op: tree.NEQL, Left: high, Right: tree.NumericLiteral{0x500000000}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(neq (binand argH0 255) 5)")
+
+	sx = Simplify(tree.Comparison{Op: tree.GT, Left: low, Right: tree.NumericLiteral{5}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(gt (binand argL0 255) 5)")
+
+	sx = Simplify(tree.Comparison{Op: tree.GT, Left: high, Right: tree.NumericLiteral{0x500000000}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(gt (binand argH0 255) 5)")
+
+	sx = Simplify(tree.Comparison{Op: tree.SLT, Left: low, Right: tree.NumericLiteral{5}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(gt 5 (binand argL0 255))")
+}
+
+func (s *SimplifierSuite) Test_simplifyBitPredicates(c *C) {
+	sx := reduceTransformers(tree.Comparison{Op: tree.ALLBITS, Left: tree.Argument{Type: tree.Low, Index: 1}, Right: tree.NumericLiteral{3}}, createBitPredicateSimplifier())
+	c.Assert(tree.ExpressionString(sx), Equals, "(eq (binand argL1 3) 3)")
+
+	sx = reduceTransformers(tree.Comparison{Op: tree.ANYBITS, Left: tree.Argument{Type: tree.Full, Index: 1}, Right: tree.NumericLiteral{3}}, createBitPredicateSimplifier())
+	c.Assert(tree.ExpressionString(sx), Equals, "(neq (binand arg1 3) 0)")
+
+	sx = reduceTransformers(tree.Comparison{Op: tree.NOBITS, Left: tree.Argument{Type: tree.Full, Index: 1}, Right: tree.NumericLiteral{3}}, createBitPredicateSimplifier())
+	c.Assert(tree.ExpressionString(sx), Equals, "(eq (binand arg1 3) 0)")
+
+	sx = reduceTransformers(tree.Comparison{Op: tree.ONLYBITS, Left: tree.Argument{Type: tree.Full, Index: 1}, Right: tree.NumericLiteral{3}}, createBitPredicateSimplifier())
+	c.Assert(tree.ExpressionString(sx), Equals, "(eq (binand arg1 (binNeg 3)) 0)")
+}
+
+func (s *SimplifierSuite) Test_simplifyBitPredicatesOn32BitValues(c *C) {
+	sx := Simplify(tree.Comparison{Op: tree.ONLYBITS, Left: tree.Argument{Type: tree.Low, Index: 1}, Right: tree.NumericLiteral{3}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(eq (binand argL1 4294967292) 0)")
+
+	sx = Simplify(tree.Comparison{Op: tree.NOBITS, Left: tree.Argument{Type: tree.Hi, Index: 1}, Right: tree.NumericLiteral{0xFFFFFFFFFFFFFFFF}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(eq (binand argH1 4294967295) 0)")
+}
+
+func (s *SimplifierSuite) Test_simplifyBitPredicatesOnFullArgument(c *C) {
+	sx := Simplify(tree.Comparison{Op: tree.ONLYBITS, Left: tree.Argument{Type: tree.Full, Index: 1}, Right: tree.NumericLiteral{0x100000003}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(and (eq (binand argL1 4294967292) 0) (eq (binand argH1 4294967294) 0))")
+
+	sx = Simplify(tree.Comparison{Op: tree.ALLBITS, Left: tree.Argument{Type: tree.Full, Index: 1}, Right: tree.NumericLiteral{0x100000003}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(and (eq (binand argL1 3) 3) (eq (binand argH1 1) 1))")
+}
+
+func (s *SimplifierSuite) Test_simplifySignedComparisonOfLiterals(c *C) {
+	sx := Simplify(tree.Comparison{Op: tree.SLT, Left: tree.Arithmetic{Op: tree.MINUS, Left: tree.NumericLiteral{0}, Right: tree.NumericLiteral{1}}, Right: tree.NumericLiteral{0}})
+	c.Assert(tree.ExpressionString(sx), Equals, "true")
+
+	sx = Simplify(tree.Comparison{Op: tree.LT, Left: tree.Arithmetic{Op: tree.MINUS, Left: tree.NumericLiteral{0}, Right: tree.NumericLiteral{1}}, Right: tree.NumericLiteral{0}})
+	c.Assert(tree.ExpressionString(sx), Equals, "false")
+
+	sx = Simplify(tree.Comparison{Op: tree.SGTE, Left: tree.NumericLiteral{0x8000000000000000}, Right: tree.NumericLiteral{0x7FFFFFFFFFFFFFFF}})
+	c.Assert(tree.ExpressionString(sx), Equals, "false")
+}
+
+func (s *SimplifierSuite) Test_simplifySignedComparisonOn32BitValues(c *C) {
+	sx := Simplify(tree.Comparison{Op: tree.SLT, Left: tree.Argument{Type: tree.Low, Index: 1}, Right: tree.NumericLiteral{0}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(gt 2147483648 (binxor argL1 2147483648))")
+
+	sx = Simplify(tree.Comparison{Op: tree.SGTE, Left: tree.Argument{Type: tree.Hi, Index: 1}, Right: tree.NumericLiteral{0xFFFFFFFFFFFFFF9C}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(gte (binxor argH1 2147483648) 2147483548)")
+}
+
+func (s *SimplifierSuite) Test_simplifyNegativeValuesComparedWith32BitValues(c *C) {
+	minus := func(x uint64) tree.Numeric {
+		return tree.Arithmetic{Op: tree.MINUS, Left: tree.NumericLiteral{0}, Right: tree.NumericLiteral{x}}
+	}
+
+	sx := Simplify(tree.Comparison{Op: tree.EQL, Left: tree.Argument{Type: tree.Low, Index: 4}, Right: minus(1)})
+	c.Assert(tree.ExpressionString(sx), Equals, "(eq argL4 4294967295)")
+
+	sx = Simplify(tree.Comparison{Op: tree.NEQL, Left: minus(100), Right: tree.Arithmetic{Op: tree.BINAND, Left: tree.Argument{Type: tree.Hi, Index: 0}, Right: tree.NumericLiteral{0xFF}}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(neq 4294967196 (binand argH0 255))")
+
+	sx = Simplify(tree.Comparison{Op: tree.EQL, Left: tree.Argument{Type: tree.Full, Index: 0}, Right: minus(1)})
+	c.Assert(tree.ExpressionString(sx), Equals, "(and (eq argL0 4294967295) (eq argH0 4294967295))")
+}
+
+func (s *SimplifierSuite) Test_simplifySignedComparisonOnFullArgument(c *C) {
+	sx := Simplify(tree.Comparison{Op: tree.SLTE, Left: tree.Argument{Type: tree.Full, Index: 0}, Right: tree.NumericLiteral{0}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(or (gt 2147483648 (binxor argH0 2147483648)) (and (eq 0 argH0) (gte 0 argL0)))")
+
+	sx = Simplify(tree.Comparison{Op: tree.SGT, Left: tree.Argument{Type: tree.Full, Index: 0}, Right: tree.Argument{Type: tree.Full, Index: 1}})
+	c.Assert(tree.ExpressionString(sx), Equals, "(or (gt (binxor argH0 2147483648) (binxor argH1 2147483648)) (and (eq argH0 argH1) (gt argL0 argL1)))")
+}
@@ -3,11 +3,11 @@ package main
 import (
 	"fmt"
 	"os"
-
-	"golang.org/x/sys/unix"
+	"sort"
 
 	"github.com/twtiger/gosecco"
 	"github.com/twtiger/gosecco/asm"
+	"github.com/twtiger/gosecco/parser"
 )
 
 func fileExists(filename string) bool {
@@ -22,6 +22,18 @@ func checkArgs() bool {
 		!fileExists(os.Args[3])
 }
 
+// printMetadata prints the metadata of the policy as comments before the bytecode, sorted by name
+func printMetadata(m map[string]string) {
+	names := []string{}
+	for n := range m {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		fmt.Printf("# @%s: %s\n", n, m[n])
+	}
+}
+
 func main() {
 	if checkArgs() {
 		fmt.Println("Usage: tester [white|black] <enforce> <filename>")
@@ -33,18 +45,19 @@ func main() {
 	filename := os.Args[3]
 
 	gosecco.CheckSupport()
-	var e error
-	var filters []unix.SockFilter
-
-	if whiteList {
-		filters, e = gosecco.Compile(filename, enforce)
-	} else {
-		filters, e = gosecco.CompileBlacklist(filename, enforce)
+	settings := gosecco.CompileSettings(enforce)
+	if !whiteList {
+		settings = gosecco.CompileBlacklistSettings(enforce)
 	}
 
+	p, e := gosecco.PreparePolicy(&parser.FileSource{filename}, settings)
 	if e != nil {
 		fmt.Printf("Had error when compiling: %#v - %s\n", e, e.Error())
 	} else {
-		fmt.Print(asm.Dump(filters))
+		for _, w := range p.Warnings {
+			fmt.Fprintf(os.Stderr, "Warning: %s\n", w)
+		}
+		printMetadata(p.Metadata)
+		fmt.Print(asm.Dump(p.Filters))
 	}
 }
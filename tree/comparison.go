@@ -12,28 +12,52 @@ const (
 	LT
 	LTE
 	BITSET
+	ALLBITS
+	ANYBITS
+	NOBITS
+	ONLYBITS
+	SGT
+	SGTE
+	SLT
+	SLTE
 )
 
 // ComparisonNames maps types to names for presentation
 var ComparisonNames = map[ComparisonType]string{
-	EQL:    "==",
-	NEQL:   "!=",
-	GT:     ">",
-	GTE:    ">=",
-	LT:     "<",
-	LTE:    "<=",
-	BITSET: "&?",
+	EQL:      "==",
+	NEQL:     "!=",
+	GT:       ">",
+	GTE:      ">=",
+	LT:       "<",
+	LTE:      "<=",
+	BITSET:   "&?",
+	ALLBITS:  "allBits",
+	ANYBITS:  "anyBits",
+	NOBITS:   "noBits",
+	ONLYBITS: "onlyBits",
+	SGT:      "s>",
+	SGTE:     "s>=",
+	SLT:      "s<",
+	SLTE:     "s<=",
 }
 
 // ComparisonSymbols maps types to names for symbolic processing
 var ComparisonSymbols = map[ComparisonType]string{
-	EQL:    "eq",
-	NEQL:   "neq",
-	GT:     "gt",
-	GTE:    "gte",
-	LT:     "lt",
-	LTE:    "lte",
-	BITSET: "bitset",
+	EQL:      "eq",
+	NEQL:     "neq",
+	GT:       "gt",
+	GTE:      "gte",
+	LT:       "lt",
+	LTE:      "lte",
+	BITSET:   "bitset",
+	ALLBITS:  "allBits",
+	ANYBITS:  "anyBits",
+	NOBITS:   "noBits",
+	ONLYBITS: "onlyBits",
+	SGT:      "sgt",
+	SGTE:     "sgte",
+	SLT:      "slt",
+	SLTE:     "slte",
 }
 
 // Comparison represents a comparison
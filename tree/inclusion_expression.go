@@ -1,10 +1,18 @@
 package tree
 
-// Inclusion represents either a positive or a negative inclusion operation
+// Inclusion represents either a positive or a negative inclusion operation.
+// The left side is included if it is equal to one of the rights, or falls inside one of the ranges
 type Inclusion struct {
 	Positive bool
 	Left     Numeric
 	Rights   []Numeric
+	Ranges   []Range
+}
+
+// Range represents all values from Low to High, inclusive
+type Range struct {
+	Low  Numeric
+	High Numeric
 }
 
 // Accept implements Expression
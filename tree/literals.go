@@ -19,3 +19,15 @@ type NumericLiteral struct {
 func (v NumericLiteral) Accept(vs Visitor) {
 	vs.AcceptNumericLiteral(v)
 }
+
+// Constant is a numeric literal that was written as the name of a constant, such as O_RDONLY. Visitors see it as the
+// NumericLiteral it stands for, so the name is only available to code that looks for constants explicitly
+type Constant struct {
+	Name  string
+	Value uint64
+}
+
+// Accept implements Expression
+func (v Constant) Accept(vs Visitor) {
+	vs.AcceptNumericLiteral(NumericLiteral{Value: v.Value})
+}
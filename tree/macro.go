@@ -1,8 +1,12 @@
 package tree
 
-// Macro represents either a simple variable or a more complicated macro/func expression
+// Macro represents either a simple variable or a more complicated macro/func expression.
+// Override is set when the macro is allowed to replace a macro with the same name from another source.
+// Position is only set for macros defined in a policy source
 type Macro struct {
 	Name          string
 	ArgumentNames []string
 	Body          Expression
+	Override      bool
+	Position      Position
 }
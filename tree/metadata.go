@@ -0,0 +1,7 @@
+package tree
+
+// Metadata represents a field describing the policy itself, such as its name or version. The name is given without the leading @
+type Metadata struct {
+	Name  string
+	Value string
+}
@@ -5,13 +5,20 @@ type RawPolicy struct {
 	RuleOrMacros []interface{}
 }
 
-// Policy represents a complete policy file. It is possible to combine more than one policy file
+// Policy represents a complete policy file. It is possible to combine more than one policy file.
+// Warnings contains the problems found while processing the policy that didn't stop it from being compiled.
+// RejectRuntimeDivision makes divisions by values only known at runtime errors, instead of guarding them.
+// Arch is the name of the architecture the policy is compiled for - the native architecture if it is empty
 type Policy struct {
+	Arch                  string
 	DefaultPositiveAction string
 	DefaultNegativeAction string
 	DefaultPolicyAction   string
 	ActionOnX32           string
 	ActionOnAuditFailure  string
+	RejectRuntimeDivision bool
+	Metadata              map[string]string
 	Macros                map[string]Macro
 	Rules                 []*Rule
+	Warnings              []Warning
 }
@@ -1,9 +1,37 @@
 package tree
 
-// Rule contains all the information for one specific rule
+import "fmt"
+
+// Rule contains all the information for one specific rule. A rule either has a body that decides between
+// the positive and negative action, or a list of outcomes. If there are outcomes, the body is not used and the
+// negative action will be returned when none of the outcome conditions match
 type Rule struct {
 	Name           string
 	PositiveAction string
 	NegativeAction string
 	Body           Expression
+	Outcomes       []Outcome
+	Position       Position
+}
+
+// Outcome is one possible result of a rule - the action will be returned if the condition is true and
+// none of the outcomes before it matched
+type Outcome struct {
+	Condition Expression
+	Action    string
+}
+
+// Position describes where in the policy sources a rule or macro was defined
+type Position struct {
+	File string
+	Line int
+}
+
+// IsSet returns true if the position refers to an actual place in a source
+func (p Position) IsSet() bool {
+	return p.File != ""
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("%s:%d", p.File, p.Line)
 }
@@ -38,6 +38,10 @@ func (sv *StringVisitor) AcceptArgument(v Argument) {
 			addition = "L"
 		}
 	}
+	if v.Index == InstructionPointer {
+		sv.result += fmt.Sprintf("ip%s", addition)
+		return
+	}
 	sv.result += fmt.Sprintf("arg%s%d", addition, v.Index)
 }
 
@@ -98,6 +102,13 @@ func (sv *StringVisitor) AcceptInclusion(v Inclusion) {
 		sv.result += sep
 		a.Accept(sv)
 	}
+	for _, r := range v.Ranges {
+		sv.result += sep + "(range "
+		r.Low.Accept(sv)
+		sv.result += " "
+		r.High.Accept(sv)
+		sv.result += ")"
+	}
 	sv.result += ")"
 }
 
@@ -0,0 +1,8 @@
+package tree
+
+// SyscallGroup represents a named group of syscalls defined in a policy. The names can be
+// either syscalls or other groups
+type SyscallGroup struct {
+	Name     string
+	Syscalls []string
+}
@@ -75,10 +75,15 @@ func (s *EmptyTransformer) AcceptInclusion(v Inclusion) {
 	for ix, v2 := range v.Rights {
 		result[ix] = s.Transform(v2)
 	}
+	var ranges []Range
+	for _, r := range v.Ranges {
+		ranges = append(ranges, Range{Low: s.Transform(r.Low), High: s.Transform(r.High)})
+	}
 	s.Result = Inclusion{
 		Positive: v.Positive,
 		Left:     s.Transform(v.Left),
-		Rights:   result}
+		Rights:   result,
+		Ranges:   ranges}
 }
 
 // AcceptNegation implements Visitor
@@ -10,6 +10,10 @@ const (
 	Hi
 )
 
+// InstructionPointer is the index used for an argument that refers to the instruction pointer at the time of the syscall.
+// The instruction pointer is stored right before the first argument in the seccomp data, so it can be loaded as if it was an argument.
+const InstructionPointer = -1
+
 // Argument represents an argment given to the syscall
 type Argument struct {
 	Type  ArgumentType
@@ -0,0 +1,14 @@
+package tree
+
+// Warning describes a problem in a policy that doesn't stop it from being compiled, but probably isn't what the author intended
+type Warning struct {
+	Position Position
+	Message  string
+}
+
+func (w Warning) String() string {
+	if w.Position.IsSet() {
+		return w.Position.String() + ": " + w.Message
+	}
+	return w.Message
+}
@@ -0,0 +1,62 @@
+package unifier
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/twtiger/gosecco/constants"
+	"github.com/twtiger/gosecco/tree"
+)
+
+// types32 contain the C types of syscall arguments that are only 32 bits wide on x86_64. The upper half of the register
+// used for such an argument is not defined - a negative int is for example usually sign extended - so only the lower
+// half of these arguments can be compared reliably
+var types32 = map[string]bool{
+	"int":                     true,
+	"unsigned int":            true,
+	"pid_t":                   true,
+	"uid_t":                   true,
+	"gid_t":                   true,
+	"mode_t":                  true,
+	"clockid_t":               true,
+	"socklen_t":               true,
+	"timer_t":                 true,
+	"mqd_t":                   true,
+	"key_t":                   true,
+	"id_t":                    true,
+	"idtype_t":                true,
+	"uint32_t":                true,
+	"key_serial_t":            true,
+	"enum landlock_rule_type": true,
+}
+
+// argumentFor returns the argument to load for an argument of the given C type
+func argumentFor(ix int, cType string) tree.Argument {
+	if types32[strings.TrimPrefix(cType, "const ")] {
+		return tree.Argument{Type: tree.Low, Index: ix}
+	}
+	return tree.Argument{Type: tree.Full, Index: ix}
+}
+
+// argumentNames returns the arguments of the given syscall on the architecture by name. Syscalls without a known
+// signature have no named arguments
+func argumentNames(arch *constants.Arch, syscall string) map[string]tree.Argument {
+	result := make(map[string]tree.Argument)
+	signature, _ := arch.GetSyscallSignature(syscall)
+	for ix, a := range signature {
+		result[a.Name] = argumentFor(ix, a.Type)
+	}
+	return result
+}
+
+// namedArgumentError returns an error if the name is an argument of the syscall on x86_64, but the policy is compiled
+// for another architecture - since the signatures are only known for x86_64
+func namedArgumentError(arch *constants.Arch, syscall, name string) error {
+	if arch == constants.X86_64 {
+		return nil
+	}
+	if _, ok := argumentNames(constants.X86_64, syscall)[name]; !ok {
+		return nil
+	}
+	return fmt.Errorf("Argument '%s' of %s can't be used by name on %s - the names of arguments are only known on x86_64, so use arg0 to arg5 instead", name, syscall, arch.Name)
+}
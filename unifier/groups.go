@@ -0,0 +1,72 @@
+package unifier
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/twtiger/gosecco/constants"
+	"github.com/twtiger/gosecco/tree"
+)
+
+func isGroupName(name string) bool {
+	return strings.HasPrefix(name, "@")
+}
+
+// lookupGroup finds the syscalls in a group, preferring the groups defined in the policy to the predefined ones
+func lookupGroup(arch *constants.Arch, name string, groups map[string][]string) ([]string, error) {
+	if g, ok := groups[strings.ToLower(name)]; ok {
+		return g, nil
+	}
+	if g, ok := arch.GetSyscallGroup(name); ok {
+		return g, nil
+	}
+	return nil, fmt.Errorf("Syscall group '%s' is not defined", name)
+}
+
+// resolveGroup returns all the syscalls of a group defined in a policy. Syscalls that are not defined
+// for the architecture can only be part of a group through one of the predefined groups.
+func resolveGroup(arch *constants.Arch, g tree.SyscallGroup, groups map[string][]string) ([]string, error) {
+	result := []string{}
+	seen := make(map[string]bool)
+	for _, name := range g.Syscalls {
+		names := []string{name}
+		if isGroupName(name) {
+			var err error
+			if names, err = lookupGroup(arch, name, groups); err != nil {
+				return nil, err
+			}
+		} else if _, ok := arch.GetSyscall(name); !ok {
+			return nil, fmt.Errorf("Syscall '%s' in group '%s' is not defined", name, g.Name)
+		}
+		for _, n := range names {
+			n = strings.ToLower(n)
+			if !seen[n] {
+				seen[n] = true
+				result = append(result, n)
+			}
+		}
+	}
+	return result, nil
+}
+
+// ruleNames returns the names of the syscalls a rule should be generated for
+func ruleNames(arch *constants.Arch, name string, groups map[string][]string) ([]string, error) {
+	if isGroupName(name) {
+		return lookupGroup(arch, name, groups)
+	}
+	return []string{name}, nil
+}
+
+// groupNumbers returns the syscall numbers of all syscalls in a group, for use in an inclusion expression
+func groupNumbers(arch *constants.Arch, name string, groups map[string][]string) ([]tree.Numeric, error) {
+	names, err := lookupGroup(arch, name, groups)
+	if err != nil {
+		return nil, err
+	}
+	result := []tree.Numeric{}
+	for _, n := range names {
+		nr, _ := arch.GetSyscall(n)
+		result = append(result, tree.NumericLiteral{Value: uint64(nr)})
+	}
+	return result, nil
+}
@@ -0,0 +1,109 @@
+package unifier
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/twtiger/gosecco/constants"
+	"github.com/twtiger/gosecco/tree"
+)
+
+// maxSuggestions is the largest number of alternatives that will be offered for a name that is not defined
+const maxSuggestions = 3
+
+// editDistance returns the Levenshtein distance between the two strings, ignoring case
+func editDistance(a, b string) int {
+	s, t := []rune(strings.ToLower(a)), []rune(strings.ToLower(b))
+	prev := make([]int, len(t)+1)
+	curr := make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		curr[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			curr[j] = minimum(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(t)]
+}
+
+func minimum(first int, rest ...int) int {
+	for _, v := range rest {
+		if v < first {
+			first = v
+		}
+	}
+	return first
+}
+
+// suggestions returns the candidates that are close enough to the name to probably be what was meant,
+// with the closest ones first. A candidate is close if about a third of the name has to be changed to get it,
+// but never when every character of the name has to be changed
+func suggestions(name string, candidates []string) []string {
+	limit := len(name) / 3
+	if limit < 1 {
+		limit = 1
+	}
+
+	distances := make(map[string]int)
+	result := []string{}
+	for _, c := range candidates {
+		if _, seen := distances[c]; seen {
+			continue
+		}
+		d := editDistance(name, c)
+		if d <= limit && d < len(name) {
+			distances[c] = d
+			result = append(result, c)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		di, dj := distances[result[i]], distances[result[j]]
+		return di < dj || (di == dj && result[i] < result[j])
+	})
+	if len(result) > maxSuggestions {
+		result = result[:maxSuggestions]
+	}
+	return result
+}
+
+// macroNames returns the names of the macros that can be used in the given way - as a call or as a variable
+func macroNames(macros map[string]tree.Macro, call bool) []string {
+	result := []string{}
+	for name, m := range macros {
+		if call || len(m.ArgumentNames) == 0 {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// variableCandidates returns all names that can be used as a variable - the macros without arguments, the named arguments
+// of the syscall and the constants
+func variableCandidates(arch *constants.Arch, macros map[string]tree.Macro, arguments map[string]tree.Argument) []string {
+	result := macroNames(macros, false)
+	for name := range arguments {
+		result = append(result, name)
+	}
+	return append(result, arch.ConstantNames()...)
+}
+
+// notDefined returns an error for a name that is not defined, suggesting similar names if there are any
+func notDefined(kind, name string, candidates []string) error {
+	s := suggestions(name, candidates)
+	switch len(s) {
+	case 0:
+		return fmt.Errorf("%s '%s' is not defined", kind, name)
+	case 1:
+		return fmt.Errorf("%s '%s' is not defined - did you mean %s?", kind, name, s[0])
+	}
+	return fmt.Errorf("%s '%s' is not defined - did you mean %s or %s?", kind, name, strings.Join(s[:len(s)-1], ", "), s[len(s)-1])
+}
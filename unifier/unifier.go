@@ -1,8 +1,12 @@
 package unifier
 
 import (
+	"fmt"
+	"reflect"
 	"strconv"
+	"strings"
 
+	"github.com/twtiger/gosecco/constants"
 	"github.com/twtiger/gosecco/tree"
 )
 
@@ -16,40 +20,108 @@ func getDefaultAction(t tree.Macro) string {
 	panic("shouldn't happen")
 }
 
-func addAllToMap(to, from map[string]tree.Macro) {
+// sameDefinition returns true if the two macros would always be expanded the same way
+func sameDefinition(m1, m2 tree.Macro) bool {
+	return reflect.DeepEqual(m1.ArgumentNames, m2.ArgumentNames) && reflect.DeepEqual(m1.Body, m2.Body)
+}
+
+// checkOverride returns an error if the new macro replaces a different macro with the same name from another source,
+// without being marked with override. The error tells where both definitions are, when that is known
+func checkOverride(existing map[string]tree.Macro, m tree.Macro) error {
+	if old, ok := existing[m.Name]; ok && !m.Override && !sameDefinition(old, m) {
+		return fmt.Errorf("Macro '%s'%s is already defined in another source%s - use override to replace it", m.Name, atPosition(m.Position), atPosition(old.Position))
+	}
+	return nil
+}
+
+func atPosition(p tree.Position) string {
+	if p.IsSet() {
+		return fmt.Sprintf(" at %s", p)
+	}
+	return ""
+}
+
+func addAllToMap(to, from map[string]tree.Macro) error {
 	for k, v := range from {
+		if err := checkOverride(to, v); err != nil {
+			return err
+		}
 		to[k] = v
 	}
+	return nil
 }
 
-func combineMacroMaps(ms []map[string]tree.Macro) map[string]tree.Macro {
+// combineMacroMaps merges the additional macros. A name can only be defined in more than one of the maps if the later
+// definition is marked with override, in which case it replaces the earlier one
+func combineMacroMaps(ms []map[string]tree.Macro) (map[string]tree.Macro, error) {
 	result := make(map[string]tree.Macro)
 
 	for _, mm := range ms {
-		addAllToMap(result, mm)
+		if err := addAllToMap(result, mm); err != nil {
+			return nil, err
+		}
 	}
 
-	return result
+	return result, nil
 }
 
 // Unify will unify all variables and calls in the given rule set with the macros in the same file. The macros in the same file will
 // be evaluated linearly, so it is possible to use the same variable name multiple times. The additionalMacros provide access to
-// variables defined in other files. The list of additional macros will be combined in such a way that the names in later maps override
-// the names in the earlier maps. The default positive and negative actions can be overridden in the files by providing DEFAULT_POSITIVE
+// variables defined in other files. The default positive and negative actions can be overridden in the files by providing DEFAULT_POSITIVE
 // and DEFAULT_NEGATIVE variables anywhere in the files. The default actions can only be defined once in a file, and will be in effect
-// for all rules in that file, unless a specific rule overrides the default actions.
+// for all rules in that file, unless a specific rule overrides the default actions. Warnings about the macros are returned in the policy.
 func Unify(r tree.RawPolicy, additionalMacros []map[string]tree.Macro, defaultPositive, defaultNegative, defaultPolicy string) (tree.Policy, error) {
+	return UnifyForArch(constants.Native, r, additionalMacros, defaultPositive, defaultNegative, defaultPolicy)
+}
+
+// UnifyForArch works like Unify, but resolves syscalls and constants for the given architecture instead of the native one
+func UnifyForArch(arch *constants.Arch, r tree.RawPolicy, additionalMacros []map[string]tree.Macro, defaultPositive, defaultNegative, defaultPolicy string) (tree.Policy, error) {
 	var rules []*tree.Rule
-	macros := combineMacroMaps(additionalMacros)
+	macros, err := combineMacroMaps(additionalMacros)
+	if err != nil {
+		return tree.Policy{}, err
+	}
+	additional := make(map[string]tree.Macro)
+	for k, v := range macros {
+		additional[k] = v
+	}
 	collectedMacros := make(map[string]tree.Macro)
+	var defined []tree.Macro
+	var warnings []tree.Warning
+	used := make(map[macroKey]bool)
+	groups := make(map[string][]string)
+	var metadata map[string]string
 	for _, e := range r.RuleOrMacros {
 		switch v := e.(type) {
 		case tree.Rule:
-			r, err := replaceFreeNames(v, macros)
+			// A rule for several syscalls is unified once for each of them, since the names of the arguments can differ
+			names, err := ruleNames(arch, v.Name, groups)
+			if err != nil {
+				return tree.Policy{}, err
+			}
+			for _, name := range names {
+				v.Name = name
+				nr, err := replaceFreeNames(arch, v, macros, groups, used)
+				if err != nil {
+					return tree.Policy{}, err
+				}
+				rules = append(rules, &nr)
+			}
+		case tree.Metadata:
+			// Metadata fields such as @name are collected in the policy, and can't be given different values
+			if old, ok := metadata[v.Name]; ok && old != v.Value {
+				return tree.Policy{}, fmt.Errorf("Metadata field '@%s' is defined more than once", v.Name)
+			}
+			if metadata == nil {
+				metadata = make(map[string]string)
+			}
+			metadata[v.Name] = v.Value
+		case tree.SyscallGroup:
+			g, err := resolveGroup(arch, v, groups)
 			if err != nil {
 				return tree.Policy{}, err
 			}
-			rules = append(rules, &r)
+			groups[strings.ToLower(v.Name)] = g
 		case tree.Macro:
 			switch v.Name {
 			case "DEFAULT_POSITIVE":
@@ -59,30 +131,79 @@ func Unify(r tree.RawPolicy, additionalMacros []map[string]tree.Macro, defaultPo
 			case "DEFAULT_POLICY":
 				defaultPolicy = getDefaultAction(v)
 			default:
+				if err := checkOverride(additional, v); err != nil {
+					return tree.Policy{}, err
+				}
+				if w, ok := shadowWarning(collectedMacros, v); ok {
+					warnings = append(warnings, w)
+				}
+				delete(additional, v.Name)
 				macros[v.Name] = v
+				defined = append(defined, v)
 				collectedMacros[v.Name] = v
 			}
 		}
 	}
-	return tree.Policy{DefaultPositiveAction: defaultPositive, DefaultNegativeAction: defaultNegative, DefaultPolicyAction: defaultPolicy, Macros: collectedMacros, Rules: rules}, nil
+	warnings = append(warnings, unusedWarnings(defined, used)...)
+	return tree.Policy{Arch: arch.Name, DefaultPositiveAction: defaultPositive, DefaultNegativeAction: defaultNegative, DefaultPolicyAction: defaultPolicy, Metadata: metadata, Macros: collectedMacros, Rules: rules, Warnings: warnings}, nil
+}
+
+// shadowWarning returns a warning if the macro replaces a different definition from earlier in the same policy without being marked with override
+func shadowWarning(earlier map[string]tree.Macro, m tree.Macro) (tree.Warning, bool) {
+	old, ok := earlier[m.Name]
+	if !ok || m.Override || sameDefinition(old, m) {
+		return tree.Warning{}, false
+	}
+	if old.Position.IsSet() {
+		return tree.Warning{Position: m.Position, Message: fmt.Sprintf("Macro '%s' shadows the earlier definition at %s", m.Name, old.Position)}, true
+	}
+	return tree.Warning{Position: m.Position, Message: fmt.Sprintf("Macro '%s' shadows an earlier definition", m.Name)}, true
+}
+
+// unusedWarnings returns warnings for all the macros that were never used by any rule. Imported macros are not reported, since they
+// are meant to be used as libraries
+func unusedWarnings(defined []tree.Macro, used map[macroKey]bool) []tree.Warning {
+	var result []tree.Warning
+	for _, m := range defined {
+		if !used[keyOf(m)] && !strings.Contains(m.Name, ".") {
+			result = append(result, tree.Warning{Position: m.Position, Message: fmt.Sprintf("Macro '%s' is never used", m.Name)})
+		}
+	}
+	return result
 }
 
-func replaceFreeNames(r tree.Rule, macros map[string]tree.Macro) (tree.Rule, error) {
-	body, err := replace(r.Body, macros)
+// replaceFreeNames expands the macros in the rule. The rule can refer to the arguments of its syscall by the names in
+// its signature, unless a macro with the same name is defined
+func replaceFreeNames(arch *constants.Arch, r tree.Rule, macros map[string]tree.Macro, groups map[string][]string, used map[macroKey]bool) (tree.Rule, error) {
+	rp := &replacer{arch: arch, syscall: r.Name, groups: groups, used: used, arguments: argumentNames(arch, r.Name)}
 	rule := tree.Rule{
 		Name:           r.Name,
 		PositiveAction: r.PositiveAction,
 		NegativeAction: r.NegativeAction,
-		Body:           body,
+		Position:       r.Position,
 	}
+
+	if len(r.Outcomes) > 0 {
+		for _, o := range r.Outcomes {
+			cond, err := rp.replace(o.Condition, macros)
+			if err != nil {
+				return rule, err
+			}
+			rule.Outcomes = append(rule.Outcomes, tree.Outcome{Condition: cond, Action: o.Action})
+		}
+		return rule, nil
+	}
+
+	body, err := rp.replace(r.Body, macros)
+	rule.Body = body
 	return rule, err
 }
 
-func replace(x tree.Expression, macros map[string]tree.Macro) (tree.Expression, error) {
-	r := &replacer{expression: x, macros: macros, err: nil}
-	x.Accept(r)
-	if r.err != nil {
-		return nil, r.err
+func (r *replacer) replace(x tree.Expression, macros map[string]tree.Macro) (tree.Expression, error) {
+	nr := &replacer{expression: x, macros: macros, arch: r.arch, syscall: r.syscall, groups: r.groups, used: r.used, arguments: r.arguments, err: nil}
+	x.Accept(nr)
+	if nr.err != nil {
+		return nil, nr.err
 	}
-	return r.expression, nil
+	return nr.expression, nil
 }
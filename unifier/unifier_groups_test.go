@@ -0,0 +1,145 @@
+package unifier
+
+import (
+	"github.com/twtiger/gosecco/constants"
+	"github.com/twtiger/gosecco/tree"
+
+	. "gopkg.in/check.v1"
+)
+
+type UnifierGroupsSuite struct{}
+
+var _ = Suite(&UnifierGroupsSuite{})
+
+func ruleNamesOf(p tree.Policy) []string {
+	result := []string{}
+	for _, r := range p.Rules {
+		result = append(result, r.Name)
+	}
+	return result
+}
+
+func (s *UnifierGroupsSuite) Test_Unify_expandsPredefinedGroupInRuleHead(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Rule{Name: "@io-event", PositiveAction: "trace", Body: tree.BooleanLiteral{true}},
+		},
+	}
+
+	output, e := Unify(input, nil, "", "", "")
+	c.Assert(e, IsNil)
+	c.Assert(ruleNamesOf(output), DeepEquals, []string{"epoll_create", "epoll_create1", "epoll_ctl", "epoll_ctl_old",
+		"epoll_pwait", "epoll_wait", "epoll_wait_old", "eventfd", "eventfd2", "poll", "ppoll", "pselect6", "select"})
+	c.Assert(output.Rules[0].PositiveAction, Equals, "trace")
+}
+
+func (s *UnifierGroupsSuite) Test_Unify_onlyIncludesSyscallsForCurrentArchitecture(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Rule{Name: "@network-io", Body: tree.BooleanLiteral{true}},
+		},
+	}
+
+	output, e := Unify(input, nil, "", "", "")
+	c.Assert(e, IsNil)
+	names := ruleNamesOf(output)
+	c.Assert(names, Not(HasLen), 0)
+	for _, n := range names {
+		c.Check(n, Not(Equals), "socketcall")
+		c.Check(n, Not(Equals), "recv")
+	}
+}
+
+func (s *UnifierGroupsSuite) Test_UnifyForArch_expandsGroupsForTheArchitecture(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.SyscallGroup{Name: "@mine", Syscalls: []string{"read", "@basic-io"}},
+			tree.Rule{Name: "@mine", Body: tree.BooleanLiteral{true}},
+			tree.Rule{Name: "ptrace", Body: tree.Inclusion{Positive: true,
+				Left:   tree.Argument{Index: 0, Type: tree.Low},
+				Rights: []tree.Numeric{tree.Variable{"@mine"}},
+			}},
+		},
+	}
+
+	output, e := UnifyForArch(constants.I386, input, nil, "", "", "")
+	c.Assert(e, IsNil)
+	c.Assert(ruleNamesOf(output), DeepEquals, []string{"read", "_llseek", "close", "dup", "dup2", "dup3", "lseek",
+		"pread64", "preadv", "pwrite64", "pwritev", "readv", "write", "writev", "ptrace"})
+	c.Assert(tree.ExpressionString(output.Rules[14].Body), Equals, "(in argL0 3 140 6 41 63 330 19 180 333 181 334 145 4 146)")
+
+	input = tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.SyscallGroup{Name: "@mine", Syscalls: []string{"socketcall"}},
+		},
+	}
+
+	_, e = UnifyForArch(constants.I386, input, nil, "", "", "")
+	c.Assert(e, IsNil)
+	_, e = Unify(input, nil, "", "", "")
+	c.Assert(e, ErrorMatches, "Syscall 'socketcall' in group '@mine' is not defined")
+}
+
+func (s *UnifierGroupsSuite) Test_Unify_expandsGroupDefinedInPolicy(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.SyscallGroup{Name: "@mine", Syscalls: []string{"read", "write", "@basic-io"}},
+			tree.Rule{Name: "@MINE", Body: tree.BooleanLiteral{true}},
+		},
+	}
+
+	output, e := Unify(input, nil, "", "", "")
+	c.Assert(e, IsNil)
+	c.Assert(ruleNamesOf(output), DeepEquals, []string{"read", "write", "close", "dup", "dup2", "dup3", "lseek",
+		"pread64", "preadv", "pwrite64", "pwritev", "readv", "writev"})
+}
+
+func (s *UnifierGroupsSuite) Test_Unify_expandsGroupInInclusion(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.SyscallGroup{Name: "@mine", Syscalls: []string{"read", "write"}},
+			tree.Rule{Name: "ptrace", Body: tree.Inclusion{Positive: true,
+				Left:   tree.Argument{Index: 0, Type: tree.Low},
+				Rights: []tree.Numeric{tree.NumericLiteral{42}, tree.Variable{"@mine"}},
+			}},
+		},
+	}
+
+	output, e := Unify(input, nil, "", "", "")
+	c.Assert(e, IsNil)
+	c.Assert(tree.ExpressionString(output.Rules[0].Body), Equals, "(in argL0 42 0 1)")
+}
+
+func (s *UnifierGroupsSuite) Test_Unify_returnsErrorForUndefinedGroup(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Rule{Name: "@nope", Body: tree.BooleanLiteral{true}},
+		},
+	}
+
+	_, e := Unify(input, nil, "", "", "")
+	c.Assert(e, ErrorMatches, "Syscall group '@nope' is not defined")
+
+	input = tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Rule{Name: "read", Body: tree.Inclusion{Positive: true,
+				Left:   tree.Argument{Index: 0},
+				Rights: []tree.Numeric{tree.Variable{"@nope"}},
+			}},
+		},
+	}
+
+	_, e = Unify(input, nil, "", "", "")
+	c.Assert(e, ErrorMatches, "Syscall group '@nope' is not defined")
+}
+
+func (s *UnifierGroupsSuite) Test_Unify_returnsErrorForUnknownSyscallInGroup(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.SyscallGroup{Name: "@mine", Syscalls: []string{"read", "blarg"}},
+		},
+	}
+
+	_, e := Unify(input, nil, "", "", "")
+	c.Assert(e, ErrorMatches, "Syscall 'blarg' in group '@mine' is not defined")
+}
@@ -5,6 +5,7 @@ import (
 	"syscall"
 	"testing"
 
+	"github.com/twtiger/gosecco/constants"
 	"github.com/twtiger/gosecco/tree"
 
 	. "gopkg.in/check.v1"
@@ -43,7 +44,7 @@ func (s *UnifierSuite) Test_Unify_withRuleToUnify(c *C) {
 
 	c.Assert(len(output.Macros), Equals, 0)
 	c.Assert(len(output.Rules), Equals, 1)
-	c.Assert(*(output.Rules[0]), Equals, rule)
+	c.Assert(*(output.Rules[0]), DeepEquals, rule)
 }
 
 func (s *UnifierSuite) Test_Unify_withRuleAndMacroThatDoesntUnify(c *C) {
@@ -392,7 +393,7 @@ func (s *UnifierSuite) Test_Unify_withCallExpressionWhereVariableIsNotDefinedRai
 	}
 
 	_, error := Unify(input, nil, "", "", "")
-	c.Assert(error, ErrorMatches, "Variable 'var2' is not defined")
+	c.Assert(error, ErrorMatches, "Variable 'var2' is not defined - did you mean var5\\?")
 }
 
 func (s *UnifierSuite) Test_Unify_withUndefinedCallExpressionRaisesVariableUndefinedError(c *C) {
@@ -715,5 +716,317 @@ func (s *UnifierSuite) Test_Unify_generatesErrorWithAMissingDependentVariable(c
 
 	_, e := Unify(input, nil, "", "", "")
 	c.Assert(e, Not(IsNil))
-	c.Assert(e, ErrorMatches, "Variable 'var2' is not defined")
+	c.Assert(e, ErrorMatches, "Variable 'var2' is not defined - did you mean var1\\?")
+}
+
+func (s *UnifierSuite) Test_Unify_withOutcomesToUnify(c *C) {
+	rule := tree.Rule{
+		Name: "write",
+		Outcomes: []tree.Outcome{
+			tree.Outcome{Condition: tree.Comparison{Left: tree.Argument{Index: 0}, Op: tree.EQL, Right: tree.Variable{"var1"}}, Action: "EACCES"},
+			tree.Outcome{Condition: tree.BooleanLiteral{true}, Action: "allow"},
+		},
+	}
+
+	macro := tree.Macro{
+		Name: "var1",
+		Body: tree.NumericLiteral{42},
+	}
+
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			macro,
+			rule,
+		},
+	}
+
+	output, e := Unify(input, nil, "", "", "")
+	c.Assert(e, IsNil)
+	c.Assert(len(output.Rules), Equals, 1)
+	c.Assert(output.Rules[0].Body, IsNil)
+	c.Assert(output.Rules[0].Outcomes, HasLen, 2)
+	c.Assert(tree.ExpressionString(output.Rules[0].Outcomes[0].Condition), Equals, "(eq arg0 42)")
+	c.Assert(output.Rules[0].Outcomes[0].Action, Equals, "EACCES")
+	c.Assert(output.Rules[0].Outcomes[1], DeepEquals, rule.Outcomes[1])
+}
+
+func (s *UnifierSuite) Test_Unify_collectsMetadata(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Metadata{Name: "name", Value: "webserver"},
+			tree.Rule{Name: "read", Body: tree.BooleanLiteral{true}},
+			tree.Metadata{Name: "version", Value: "1.2"},
+			tree.Metadata{Name: "name", Value: "webserver"},
+		},
+	}
+
+	output, e := Unify(input, nil, "", "", "")
+	c.Assert(e, IsNil)
+	c.Assert(output.Metadata, DeepEquals, map[string]string{"name": "webserver", "version": "1.2"})
+}
+
+func (s *UnifierSuite) Test_Unify_returnsErrorForConflictingMetadata(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Metadata{Name: "name", Value: "webserver"},
+			tree.Metadata{Name: "name", Value: "database"},
+		},
+	}
+
+	_, e := Unify(input, nil, "", "", "")
+	c.Assert(e, ErrorMatches, "Metadata field '@name' is defined more than once")
+}
+
+func (s *UnifierSuite) Test_Unify_returnsErrorForMacroDefinedInTwoSources(c *C) {
+	first := map[string]tree.Macro{"VAL": tree.Macro{Name: "VAL", Body: tree.NumericLiteral{1}}}
+	second := map[string]tree.Macro{"VAL": tree.Macro{Name: "VAL", Body: tree.NumericLiteral{2}}}
+
+	_, e := Unify(tree.RawPolicy{}, []map[string]tree.Macro{first, second}, "", "", "")
+	c.Assert(e, ErrorMatches, "Macro 'VAL' is already defined in another source - use override to replace it")
+
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Macro{Name: "VAL", Body: tree.NumericLiteral{3}},
+		},
+	}
+	_, e = Unify(input, []map[string]tree.Macro{first}, "", "", "")
+	c.Assert(e, ErrorMatches, "Macro 'VAL' is already defined in another source - use override to replace it")
+}
+
+func (s *UnifierSuite) Test_Unify_reportsWhereBothDefinitionsOfAConflictingMacroAre(c *C) {
+	first := map[string]tree.Macro{"VAL": tree.Macro{Name: "VAL", Body: tree.NumericLiteral{1}, Position: tree.Position{File: "extra", Line: 2}}}
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Macro{Name: "VAL", Body: tree.NumericLiteral{3}, Position: tree.Position{File: "policy", Line: 4}},
+		},
+	}
+
+	_, e := Unify(input, []map[string]tree.Macro{first}, "", "", "")
+	c.Assert(e, ErrorMatches, "Macro 'VAL' at policy:4 is already defined in another source at extra:2 - use override to replace it")
+}
+
+func (s *UnifierSuite) Test_Unify_allowsOverriddenAndEqualMacros(c *C) {
+	first := map[string]tree.Macro{"VAL": tree.Macro{Name: "VAL", Body: tree.NumericLiteral{1}}}
+	same := map[string]tree.Macro{"VAL": tree.Macro{Name: "VAL", Body: tree.NumericLiteral{1}}}
+	second := map[string]tree.Macro{"VAL": tree.Macro{Name: "VAL", Body: tree.NumericLiteral{2}, Override: true}}
+
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Rule{Name: "read", Body: tree.Comparison{Op: tree.EQL, Left: tree.Argument{Index: 0}, Right: tree.Variable{"VAL"}}},
+			tree.Macro{Name: "VAL", Body: tree.NumericLiteral{3}, Override: true},
+			tree.Macro{Name: "VAL", Body: tree.NumericLiteral{4}},
+			tree.Rule{Name: "write", Body: tree.Comparison{Op: tree.EQL, Left: tree.Argument{Index: 0}, Right: tree.Variable{"VAL"}}},
+		},
+	}
+
+	output, e := Unify(input, []map[string]tree.Macro{first, same, second}, "", "", "")
+	c.Assert(e, IsNil)
+	c.Assert(tree.ExpressionString(output.Rules[0].Body), Equals, "(eq arg0 2)")
+	c.Assert(tree.ExpressionString(output.Rules[1].Body), Equals, "(eq arg0 4)")
+}
+
+func (s *UnifierSuite) Test_Unify_suggestsSimilarNamesForUndefinedVariables(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Rule{Name: "open", Body: tree.Comparison{Op: tree.EQL, Left: tree.Argument{Index: 1}, Right: tree.Variable{"O_RDONY"}}},
+		},
+	}
+
+	_, e := Unify(input, nil, "", "", "")
+	c.Assert(e, ErrorMatches, "Variable 'O_RDONY' is not defined - did you mean O_RDONLY\\?")
+
+	input = tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Macro{Name: "flags", Body: tree.Argument{Index: 1}},
+			tree.Macro{Name: "flag", Body: tree.Argument{Index: 2}},
+			tree.Macro{Name: "flagged", ArgumentNames: []string{"a"}, Body: tree.Variable{"a"}},
+			tree.Rule{Name: "open", Body: tree.Comparison{Op: tree.EQL, Left: tree.Variable{"flagz"}, Right: tree.NumericLiteral{0}}},
+		},
+	}
+
+	_, e = Unify(input, nil, "", "", "")
+	c.Assert(e, ErrorMatches, "Variable 'flagz' is not defined - did you mean flag or flags\\?")
+}
+
+func (s *UnifierSuite) Test_Unify_suggestsSimilarNamesForUndefinedMacros(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Macro{Name: "isRead", ArgumentNames: []string{"a"}, Body: tree.Variable{"a"}},
+			tree.Rule{Name: "open", Body: tree.Call{Name: "isReed", Args: []tree.Any{tree.Argument{Index: 1}}}},
+		},
+	}
+
+	_, e := Unify(input, nil, "", "", "")
+	c.Assert(e, ErrorMatches, "Macro 'isReed' is not defined - did you mean isRead\\?")
+}
+
+func (s *UnifierSuite) Test_Unify_returnsErrorForWrongNumberOfArguments(c *C) {
+	macro := tree.Macro{Name: "both", ArgumentNames: []string{"a", "b"}, Body: tree.And{Left: tree.Variable{"a"}, Right: tree.Variable{"b"}}}
+
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			macro,
+			tree.Rule{Name: "read", Body: tree.Call{Name: "both", Args: []tree.Any{tree.BooleanLiteral{true}}}},
+		},
+	}
+	_, e := Unify(input, nil, "", "", "")
+	c.Assert(e, ErrorMatches, "Macro 'both' takes 2 arguments, but was given 1")
+
+	input = tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			macro,
+			tree.Rule{Name: "read", Body: tree.Call{Name: "both", Args: []tree.Any{tree.BooleanLiteral{true}, tree.BooleanLiteral{true}, tree.BooleanLiteral{false}}}},
+		},
+	}
+	_, e = Unify(input, nil, "", "", "")
+	c.Assert(e, ErrorMatches, "Macro 'both' takes 2 arguments, but was given 3")
+
+	input = tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			macro,
+			tree.Rule{Name: "read", Body: tree.Variable{"both"}},
+		},
+	}
+	_, e = Unify(input, nil, "", "", "")
+	c.Assert(e, ErrorMatches, "Macro 'both' takes 2 arguments, but was used without calling it")
+}
+
+func (s *UnifierSuite) Test_Unify_warnsAboutShadowedMacros(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Macro{Name: "VAL", Body: tree.NumericLiteral{1}, Position: tree.Position{File: "policy", Line: 1}},
+			tree.Rule{Name: "read", Body: tree.Comparison{Op: tree.EQL, Left: tree.Argument{Index: 0}, Right: tree.Variable{"VAL"}}},
+			tree.Macro{Name: "VAL", Body: tree.NumericLiteral{2}, Position: tree.Position{File: "policy", Line: 3}},
+			tree.Macro{Name: "VAL", Body: tree.NumericLiteral{2}, Position: tree.Position{File: "policy", Line: 4}},
+			tree.Macro{Name: "VAL", Body: tree.NumericLiteral{3}, Override: true, Position: tree.Position{File: "policy", Line: 5}},
+			tree.Rule{Name: "write", Body: tree.Comparison{Op: tree.EQL, Left: tree.Argument{Index: 0}, Right: tree.Variable{"VAL"}}},
+		},
+	}
+
+	output, e := Unify(input, nil, "", "", "")
+	c.Assert(e, IsNil)
+	c.Assert(output.Warnings, DeepEquals, []tree.Warning{
+		tree.Warning{Position: tree.Position{File: "policy", Line: 3}, Message: "Macro 'VAL' shadows the earlier definition at policy:1"},
+		tree.Warning{Position: tree.Position{File: "policy", Line: 3}, Message: "Macro 'VAL' is never used"},
+		tree.Warning{Position: tree.Position{File: "policy", Line: 4}, Message: "Macro 'VAL' is never used"},
+	})
+	c.Assert(output.Warnings[0].String(), Equals, "policy:3: Macro 'VAL' shadows the earlier definition at policy:1")
+}
+
+func (s *UnifierSuite) Test_Unify_warnsAboutUnusedMacros(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Macro{Name: "DEFAULT_POSITIVE", Body: tree.Variable{"allow"}},
+			tree.Macro{Name: "lib.VAL", Body: tree.NumericLiteral{1}},
+			tree.Macro{Name: "inner", Body: tree.NumericLiteral{1}},
+			tree.Macro{Name: "outer", ArgumentNames: []string{"a"}, Body: tree.Comparison{Op: tree.EQL, Left: tree.Variable{"a"}, Right: tree.Variable{"inner"}}},
+			tree.Macro{Name: "unused", Body: tree.NumericLiteral{2}, Position: tree.Position{File: "policy", Line: 4}},
+			tree.Rule{Name: "read", Body: tree.Call{Name: "outer", Args: []tree.Any{tree.Argument{Index: 0}}}},
+		},
+	}
+
+	output, e := Unify(input, nil, "", "", "")
+	c.Assert(e, IsNil)
+	c.Assert(output.Warnings, DeepEquals, []tree.Warning{
+		tree.Warning{Position: tree.Position{File: "policy", Line: 4}, Message: "Macro 'unused' is never used"},
+	})
+}
+
+func (s *UnifierSuite) Test_Unify_resolvesNamedArgumentsForTheSyscallOfTheRule(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Rule{Name: "openat", Body: tree.And{
+				Left:  tree.Comparison{Op: tree.BITSET, Left: tree.Variable{"flags"}, Right: tree.Variable{"O_CREAT"}},
+				Right: tree.Comparison{Op: tree.EQL, Left: tree.Variable{"dirfd"}, Right: tree.NumericLiteral{3}}}},
+			tree.Rule{Name: "mmap", Body: tree.Comparison{Op: tree.EQL, Left: tree.Variable{"addr"}, Right: tree.NumericLiteral{0}}},
+		},
+	}
+
+	output, e := Unify(input, nil, "", "", "")
+	c.Assert(e, IsNil)
+	c.Assert(tree.ExpressionString(output.Rules[0].Body), Equals, "(and (bitset argL2 64) (eq argL0 3))")
+	c.Assert(tree.ExpressionString(output.Rules[1].Body), Equals, "(eq arg0 0)")
+}
+
+func (s *UnifierSuite) Test_Unify_resolvesNamedArgumentsSeparatelyForEachSyscall(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Macro{Name: "isStdout", Body: tree.Comparison{Op: tree.EQL, Left: tree.Variable{"fd"}, Right: tree.NumericLiteral{1}}},
+			tree.SyscallGroup{Name: "@output", Syscalls: []string{"write", "pwrite64", "fstat"}},
+			tree.Rule{Name: "@output", Body: tree.Variable{"isStdout"}},
+			tree.Rule{Name: "dup2", Body: tree.Comparison{Op: tree.EQL, Left: tree.Variable{"newfd"}, Right: tree.NumericLiteral{2}}},
+		},
+	}
+
+	output, e := Unify(input, nil, "", "", "")
+	c.Assert(e, IsNil)
+	c.Assert(len(output.Rules), Equals, 4)
+	c.Assert(output.Rules[0].Name, Equals, "write")
+	c.Assert(tree.ExpressionString(output.Rules[0].Body), Equals, "(eq argL0 1)")
+	c.Assert(output.Rules[1].Name, Equals, "pwrite64")
+	c.Assert(tree.ExpressionString(output.Rules[3].Body), Equals, "(eq argL1 2)")
+}
+
+func (s *UnifierSuite) Test_Unify_resolvesNegativeConstantsAsThirtyTwoBitValues(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Rule{Name: "openat2", Body: tree.Comparison{Op: tree.EQL, Left: tree.Variable{"dirfd"}, Right: tree.Variable{"AT_FDCWD"}}},
+			tree.Rule{Name: "close_range", Body: tree.Comparison{Op: tree.BITSET, Left: tree.Variable{"flags"}, Right: tree.Variable{"CLOSE_RANGE_CLOEXEC"}}},
+		},
+	}
+
+	output, e := Unify(input, nil, "", "", "")
+	c.Assert(e, IsNil)
+	c.Assert(tree.ExpressionString(output.Rules[0].Body), Equals, "(eq argL0 4294967196)")
+	c.Assert(tree.ExpressionString(output.Rules[1].Body), Equals, "(bitset argL2 4)")
+}
+
+func (s *UnifierSuite) Test_Unify_prefersMacrosToNamedArguments(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Macro{Name: "fd", Body: tree.NumericLiteral{42}},
+			tree.Macro{Name: "is", ArgumentNames: []string{"count"}, Body: tree.Comparison{Op: tree.EQL, Left: tree.Variable{"count"}, Right: tree.Variable{"fd"}}},
+			tree.Rule{Name: "read", Body: tree.Call{Name: "is", Args: []tree.Any{tree.NumericLiteral{1}}}},
+		},
+	}
+
+	output, e := Unify(input, nil, "", "", "")
+	c.Assert(e, IsNil)
+	c.Assert(tree.ExpressionString(output.Rules[0].Body), Equals, "(eq 1 42)")
+}
+
+func (s *UnifierSuite) Test_Unify_suggestsNamedArgumentsForUnknownNames(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Rule{Name: "openat", Body: tree.Comparison{Op: tree.BITSET, Left: tree.Variable{"flag"}, Right: tree.NumericLiteral{1}}},
+		},
+	}
+
+	_, e := Unify(input, nil, "", "", "")
+	c.Assert(e, ErrorMatches, "Variable 'flag' is not defined - did you mean flags\\?")
+
+	input = tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.SyscallGroup{Name: "@mine", Syscalls: []string{"read", "getpid"}},
+			tree.Rule{Name: "@mine", Body: tree.Comparison{Op: tree.EQL, Left: tree.Variable{"fd"}, Right: tree.NumericLiteral{1}}},
+		},
+	}
+
+	_, e = Unify(input, nil, "", "", "")
+	c.Assert(e, ErrorMatches, "Variable 'fd' is not defined")
+}
+
+func (s *UnifierSuite) Test_UnifyForArch_onlyResolvesNamedArgumentsOnX86_64(c *C) {
+	input := tree.RawPolicy{
+		RuleOrMacros: []interface{}{
+			tree.Rule{Name: "clone", Body: tree.Comparison{Op: tree.BITSET, Left: tree.Variable{"flags"}, Right: tree.Variable{"CLONE_THREAD"}}},
+		},
+	}
+
+	_, e := UnifyForArch(constants.I386, input, nil, "", "", "")
+	c.Assert(e, ErrorMatches, "Argument 'flags' of clone can't be used by name on i386 - the names of arguments are only known on x86_64, so use arg0 to arg5 instead")
+
+	output, e := UnifyForArch(constants.X86_64, input, nil, "", "", "")
+	c.Assert(e, IsNil)
+	c.Assert(tree.ExpressionString(output.Rules[0].Body), Equals, "(bitset arg0 65536)")
 }
@@ -10,15 +10,38 @@ import (
 type replacer struct {
 	expression tree.Expression
 	macros     map[string]tree.Macro
+	arch       *constants.Arch
+	syscall    string
+	groups     map[string][]string
+	used       map[macroKey]bool
+	arguments  map[string]tree.Argument
 	err        error
 }
 
+// macroKey identifies one definition of a macro, since the same name can be defined more than once
+type macroKey struct {
+	name     string
+	position tree.Position
+}
+
+func keyOf(m tree.Macro) macroKey {
+	return macroKey{m.Name, m.Position}
+}
+
+// arguments returns a description of the given number of arguments
+func arguments(n int) string {
+	if n == 1 {
+		return "1 argument"
+	}
+	return fmt.Sprintf("%d arguments", n)
+}
+
 func (r *replacer) AcceptAnd(b tree.And) {
 	var left tree.Boolean
 	var right tree.Boolean
-	left, r.err = replace(b.Left, r.macros)
+	left, r.err = r.replace(b.Left, r.macros)
 	if r.err == nil {
-		right, r.err = replace(b.Right, r.macros)
+		right, r.err = r.replace(b.Right, r.macros)
 		r.expression = tree.And{Left: left, Right: right}
 	}
 }
@@ -28,16 +51,16 @@ func (r *replacer) AcceptArgument(tree.Argument) {}
 func (r *replacer) AcceptArithmetic(b tree.Arithmetic) {
 	var left tree.Numeric
 	var right tree.Numeric
-	left, r.err = replace(b.Left, r.macros)
+	left, r.err = r.replace(b.Left, r.macros)
 	if r.err == nil {
-		right, r.err = replace(b.Right, r.macros)
+		right, r.err = r.replace(b.Right, r.macros)
 		r.expression = tree.Arithmetic{Left: left, Op: b.Op, Right: right}
 	}
 }
 
 func (r *replacer) AcceptBinaryNegation(b tree.BinaryNegation) {
 	var op tree.Numeric
-	op, r.err = replace(b.Operand, r.macros)
+	op, r.err = r.replace(b.Operand, r.macros)
 	r.expression = tree.BinaryNegation{op}
 }
 
@@ -47,14 +70,20 @@ func (r *replacer) AcceptCall(b tree.Call) {
 	v, ok := r.macros[b.Name] // we get the name of the macro
 
 	if !ok {
-		r.err = fmt.Errorf("Macro '%s' is not defined", b.Name)
+		r.err = notDefined("Macro", b.Name, macroNames(r.macros, true))
 		return
 	}
 
+	if len(b.Args) != len(v.ArgumentNames) {
+		r.err = fmt.Errorf("Macro '%s' takes %s, but was given %d", b.Name, arguments(len(v.ArgumentNames)), len(b.Args))
+		return
+	}
+	r.used[keyOf(v)] = true
+
 	nm := make(map[string]tree.Macro)
 	for i, k := range b.Args {
 		var e tree.Expression
-		e, r.err = replace(k, r.macros)
+		e, r.err = r.replace(k, r.macros)
 		if r.err == nil {
 			m := tree.Macro{Name: v.ArgumentNames[i], Body: e}
 			nm[v.ArgumentNames[i]] = m
@@ -68,7 +97,7 @@ func (r *replacer) AcceptCall(b tree.Call) {
 	}
 
 	if r.err == nil {
-		r.expression, r.err = replace(v.Body, nm)
+		r.expression, r.err = r.replace(v.Body, nm)
 	}
 }
 
@@ -76,10 +105,10 @@ func (r *replacer) AcceptComparison(b tree.Comparison) {
 	var left tree.Numeric
 	var right tree.Numeric
 
-	left, r.err = replace(b.Left, r.macros)
+	left, r.err = r.replace(b.Left, r.macros)
 
 	if r.err == nil {
-		right, r.err = replace(b.Right, r.macros)
+		right, r.err = r.replace(b.Right, r.macros)
 		r.expression = tree.Comparison{
 			Left:  left,
 			Op:    b.Op,
@@ -92,23 +121,43 @@ func (r *replacer) AcceptComparison(b tree.Comparison) {
 func (r *replacer) AcceptInclusion(b tree.Inclusion) {
 	var rights []tree.Numeric
 	for _, e := range b.Rights {
-		right, err := replace(e, r.macros)
+		if v, ok := e.(tree.Variable); ok && isGroupName(v.Name) {
+			nums, err := groupNumbers(r.arch, v.Name, r.groups)
+			if err != nil {
+				r.err = err
+			}
+			rights = append(rights, nums...)
+			continue
+		}
+		right, err := r.replace(e, r.macros)
 		if err != nil {
 			r.err = err
 		}
 		rights = append(rights, right)
 	}
-	left, err := replace(b.Left, r.macros)
+	var ranges []tree.Range
+	for _, e := range b.Ranges {
+		low, err := r.replace(e.Low, r.macros)
+		if err != nil {
+			r.err = err
+		}
+		high, err := r.replace(e.High, r.macros)
+		if err != nil {
+			r.err = err
+		}
+		ranges = append(ranges, tree.Range{Low: low, High: high})
+	}
+	left, err := r.replace(b.Left, r.macros)
 	if err != nil {
 		r.err = err
 	}
 
-	r.expression = tree.Inclusion{Positive: b.Positive, Left: left, Rights: rights}
+	r.expression = tree.Inclusion{Positive: b.Positive, Left: left, Rights: rights, Ranges: ranges}
 }
 
 func (r *replacer) AcceptNegation(b tree.Negation) {
 	var op tree.Numeric
-	op, r.err = replace(b.Operand, r.macros)
+	op, r.err = r.replace(b.Operand, r.macros)
 
 	r.expression = tree.Negation{Operand: op}
 }
@@ -119,10 +168,10 @@ func (r *replacer) AcceptOr(b tree.Or) {
 	var left tree.Boolean
 	var right tree.Boolean
 
-	left, r.err = replace(b.Left, r.macros)
+	left, r.err = r.replace(b.Left, r.macros)
 
 	if r.err == nil {
-		right, r.err = replace(b.Right, r.macros)
+		right, r.err = r.replace(b.Right, r.macros)
 		r.expression = tree.And{Left: left, Right: right}
 		r.expression = tree.Or{Left: left, Right: right}
 	}
@@ -131,17 +180,26 @@ func (r *replacer) AcceptOr(b tree.Or) {
 func (r *replacer) AcceptVariable(b tree.Variable) {
 	expr, ok := r.macros[b.Name]
 	if ok {
-		x, ee := replace(expr.Body, r.macros)
+		if len(expr.ArgumentNames) > 0 {
+			r.err = fmt.Errorf("Macro '%s' takes %s, but was used without calling it", b.Name, arguments(len(expr.ArgumentNames)))
+			return
+		}
+		r.used[keyOf(expr)] = true
+		x, ee := r.replace(expr.Body, r.macros)
 		if ee != nil {
 			r.err = ee
 		}
 		r.expression = x
+	} else if arg, ok := r.arguments[b.Name]; ok {
+		r.expression = arg
 	} else {
-		value, ok2 := constants.GetConstant(b.Name)
+		value, ok2 := r.arch.GetConstant(b.Name)
 		if ok2 {
-			r.expression = tree.NumericLiteral{Value: uint64(value)}
+			r.expression = tree.Constant{Name: b.Name, Value: value}
+		} else if err := namedArgumentError(r.arch, r.syscall, b.Name); err != nil {
+			r.err = err
 		} else {
-			r.err = fmt.Errorf("Variable '%s' is not defined", b.Name)
+			r.err = notDefined("Variable", b.Name, variableCandidates(r.arch, r.macros, r.arguments))
 		}
 	}
 }